@@ -0,0 +1,199 @@
+// Package boardingpass issues and verifies signed boarding pass tokens.
+//
+// A token is small enough for a QR code and carries everything a conductor needs to check
+// a passenger: ticket, journey, section, seat and name. It is signed with Ed25519, so it
+// can be verified offline with nothing but the issuer's public keys. Tokens look like
+//
+//	TB1.<base64url payload>.<base64url signature>
+//
+// where the signature covers "TB1." followed by the encoded payload.
+package boardingpass
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// prefix identifies the token format and version.
+const prefix = "TB1."
+
+var (
+	ErrMalformed    = errors.New("boardingpass: malformed token")
+	ErrUnknownKey   = errors.New("boardingpass: token signed with an unknown key")
+	ErrBadSignature = errors.New("boardingpass: invalid signature")
+)
+
+// Pass is the information carried by a boarding pass.
+type Pass struct {
+	KeyID         string
+	TicketID      string
+	From          string
+	To            string
+	Section       string
+	Seat          int32
+	CompanionSeat int32 // 0 when no companion seat was booked
+	Passenger     string
+	IssuedAt      time.Time
+}
+
+// payload is the wire form of a Pass, with short field names to keep QR codes small.
+type payload struct {
+	KeyID         string `json:"k"`
+	TicketID      string `json:"t"`
+	From          string `json:"f"`
+	To            string `json:"o"`
+	Section       string `json:"s"`
+	Seat          int32  `json:"n"`
+	CompanionSeat int32  `json:"c,omitempty"`
+	Passenger     string `json:"p"`
+	IssuedAt      int64  `json:"i"`
+}
+
+// KeyID derives the identifier tokens use to name the key that signed them.
+func KeyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Signer issues tokens with one private key.
+type Signer struct {
+	keyID string
+	key   ed25519.PrivateKey
+}
+
+func NewSigner(key ed25519.PrivateKey) *Signer {
+	return &Signer{keyID: KeyID(key.Public().(ed25519.PublicKey)), key: key}
+}
+
+// KeyID returns the identifier of the signing key.
+func (s *Signer) KeyID() string { return s.keyID }
+
+// PublicKey returns the key that verifies this signer's tokens.
+func (s *Signer) PublicKey() ed25519.PublicKey { return s.key.Public().(ed25519.PublicKey) }
+
+// Sign encodes and signs p. The KeyID of p is ignored and set to the signer's.
+func (s *Signer) Sign(p Pass) string {
+	// Marshalling a struct of strings and integers can not fail.
+	body, _ := json.Marshal(payload{
+		KeyID:         s.keyID,
+		TicketID:      p.TicketID,
+		From:          p.From,
+		To:            p.To,
+		Section:       p.Section,
+		Seat:          p.Seat,
+		CompanionSeat: p.CompanionSeat,
+		Passenger:     p.Passenger,
+		IssuedAt:      p.IssuedAt.Unix(),
+	})
+	signed := prefix + base64.RawURLEncoding.EncodeToString(body)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(ed25519.Sign(s.key, []byte(signed)))
+}
+
+// Verifier checks tokens against a set of trusted public keys, which lets passes signed
+// before a key rotation stay valid while the old public key is kept.
+type Verifier struct {
+	keys map[string]ed25519.PublicKey
+}
+
+func NewVerifier(keys ...ed25519.PublicKey) *Verifier {
+	v := &Verifier{keys: make(map[string]ed25519.PublicKey, len(keys))}
+	for _, key := range keys {
+		v.keys[KeyID(key)] = key
+	}
+	return v
+}
+
+// Keys returns the trusted public keys by key ID.
+func (v *Verifier) Keys() map[string]ed25519.PublicKey {
+	keys := make(map[string]ed25519.PublicKey, len(v.keys))
+	for id, key := range v.keys {
+		keys[id] = key
+	}
+	return keys
+}
+
+// Verify checks the token's signature and returns the pass it carries.
+func (v *Verifier) Verify(token string) (Pass, error) {
+	token = strings.TrimSpace(token)
+	if !strings.HasPrefix(token, prefix) {
+		return Pass{}, ErrMalformed
+	}
+	dot := strings.LastIndexByte(token, '.')
+	if dot < len(prefix) {
+		return Pass{}, ErrMalformed
+	}
+	signed, encodedSig := token[:dot], token[dot+1:]
+	body, err := base64.RawURLEncoding.DecodeString(signed[len(prefix):])
+	if err != nil {
+		return Pass{}, ErrMalformed
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(encodedSig)
+	if err != nil || len(sig) != ed25519.SignatureSize {
+		return Pass{}, ErrMalformed
+	}
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return Pass{}, ErrMalformed
+	}
+	key, ok := v.keys[p.KeyID]
+	if !ok {
+		return Pass{}, ErrUnknownKey
+	}
+	if !ed25519.Verify(key, []byte(signed), sig) {
+		return Pass{}, ErrBadSignature
+	}
+	return Pass{
+		KeyID:         p.KeyID,
+		TicketID:      p.TicketID,
+		From:          p.From,
+		To:            p.To,
+		Section:       p.Section,
+		Seat:          p.Seat,
+		CompanionSeat: p.CompanionSeat,
+		Passenger:     p.Passenger,
+		IssuedAt:      time.Unix(p.IssuedAt, 0).UTC(),
+	}, nil
+}
+
+// ParsePrivateKey reads a PEM encoded PKCS #8 Ed25519 private key.
+func ParsePrivateKey(data []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("boardingpass: no PEM block found")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	priv, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("boardingpass: %T is not an Ed25519 private key", key)
+	}
+	return priv, nil
+}
+
+// ParsePublicKey reads a PEM encoded PKIX Ed25519 public key.
+func ParsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("boardingpass: no PEM block found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	pub, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("boardingpass: %T is not an Ed25519 public key", key)
+	}
+	return pub, nil
+}
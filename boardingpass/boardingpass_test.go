@@ -0,0 +1,109 @@
+package boardingpass
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newKey(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return priv
+}
+
+var pass = Pass{
+	TicketID:  "4f7c2e1a-6d0b-4a57-9a0e-2b1f6f1c9d11",
+	From:      "London",
+	To:        "Manchester",
+	Section:   "A",
+	Seat:      12,
+	Passenger: "Aman jain",
+	IssuedAt:  time.Date(2026, 5, 1, 9, 30, 0, 0, time.UTC),
+}
+
+func TestSignAndVerify(t *testing.T) {
+	signer := NewSigner(newKey(t))
+	token := signer.Sign(pass)
+	got, err := NewVerifier(signer.PublicKey()).Verify(token)
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	want := pass
+	want.KeyID = signer.KeyID()
+	if got != want {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+}
+
+func TestVerifyRejects(t *testing.T) {
+	signer := NewSigner(newKey(t))
+	token := signer.Sign(pass)
+	verifier := NewVerifier(signer.PublicKey())
+
+	parts := strings.Split(token, ".")
+	body, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	tampered := strings.Replace(string(body), `"n":12`, `"n":13`, 1)
+	forged := parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(tampered)) + "." + parts[2]
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"Tampered", forged, ErrBadSignature},
+		{"Truncated", token[:len(token)-4], ErrMalformed},
+		{"WrongPrefix", "TB2" + token[3:], ErrMalformed},
+		{"Garbage", "TB1.!!!.???", ErrMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := verifier.Verify(tt.token); !errors.Is(err, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	other := NewSigner(newKey(t))
+	otherToken := other.Sign(pass)
+	if _, err := verifier.Verify(otherToken); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Expected ErrUnknownKey for another issuer, got %v", err)
+	}
+}
+
+func TestKeyRotation(t *testing.T) {
+	old, current := NewSigner(newKey(t)), NewSigner(newKey(t))
+	oldToken, newToken := old.Sign(pass), current.Sign(pass)
+	verifier := NewVerifier(current.PublicKey(), old.PublicKey())
+	for _, token := range []string{oldToken, newToken} {
+		if _, err := verifier.Verify(token); err != nil {
+			t.Errorf("Expected tokens from both keys to verify, got %v", err)
+		}
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	priv := newKey(t)
+	der, _ := x509.MarshalPKCS8PrivateKey(priv)
+	parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	if err != nil || !parsed.Equal(priv) {
+		t.Fatalf("ParsePrivateKey failed: %v", err)
+	}
+	der, _ = x509.MarshalPKIXPublicKey(priv.Public())
+	pub, err := ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	if err != nil || !pub.Equal(priv.Public()) {
+		t.Fatalf("ParsePublicKey failed: %v", err)
+	}
+	if _, err := ParsePrivateKey([]byte("not a key")); err == nil {
+		t.Errorf("Expected an error for input without a PEM block")
+	}
+}
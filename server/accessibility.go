@@ -0,0 +1,161 @@
+// accessibility.go
+
+package main
+
+import (
+	"errors"
+	"maps"
+	"sort"
+	"strings"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// normalizeSeatNumbers validates seat numbers against the section size and returns them sorted without duplicates.
+func normalizeSeatNumbers(seats []int32, totalSeats int32) ([]int32, error) {
+	seen := map[int32]bool{}
+	out := []int32{}
+	for _, seat := range seats {
+		if seat < 1 || seat > totalSeats {
+			return nil, errors.New("Seat number can not be more than total seats")
+		}
+		if !seen[seat] {
+			seen[seat] = true
+			out = append(out, seat)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
+
+func parseDeparture(value string) (string, error) {
+	departure, err := parseOptionalTime(value, "Departure")
+	if err != nil || departure.IsZero() {
+		return "", err
+	}
+	return departure.Format(time.RFC3339), nil
+}
+
+func isAccessibleSeat(section *pb.Section, seat int32) bool {
+	for _, s := range section.AccessibleSeats {
+		if s == seat {
+			return true
+		}
+	}
+	return false
+}
+
+// accessibleReleased reports whether a section's accessibility-reserved seats are on general
+// sale, which happens once departure is within the configured cutoff.
+func (t *trainServer) accessibleReleased(section *pb.Section, now time.Time) bool {
+	if section.Departure == "" {
+		return false
+	}
+	departure, err := time.Parse(time.RFC3339, section.Departure)
+	if err != nil {
+		return false
+	}
+	return !now.Before(departure.Add(-t.accessibleReleaseCutoff))
+}
+
+// seatOffered reports whether a seat may go to a passenger: seats reserved for accessibility
+// needs only go to passengers with one until they are released for general sale. Companions
+// count as passengers without a need. Callers hold t.mu.
+func (t *trainServer) seatOffered(section *pb.Section, seat int32, need bool, now time.Time) bool {
+	return need || !isAccessibleSeat(section, seat) || t.accessibleReleased(section, now)
+}
+
+// seatOpen reports whether a seat is neither booked, blocked, held, already picked nor in a
+// cancelled section. Callers hold t.mu.
+func (t *trainServer) seatOpen(sectionID string, seat int32, now time.Time, taken map[string]bool) bool {
+	key := seatKey(sectionID, seat)
+	if taken[key] || t.seatBlocked(key, now) || t.seatHeld(key, now) {
+		return false
+	}
+	if section, ok := t.sections[sectionID]; ok && section.Cancelled {
+		return false
+	}
+	_, allocated := t.allocatedSeats[key]
+	return !allocated
+}
+
+// findSeat picks a seat in a section for a passenger, keeping accessibility-reserved seats
+// for passengers who need them and, when companion is set, an adjacent seat for the
+// companion. Seats above maxSeat or in taken are skipped. Callers hold t.mu.
+func (t *trainServer) findSeat(section *pb.Section, need, companion bool, maxSeat int32, now time.Time, taken map[string]bool) (seatRef, bool) {
+	candidates := t.seats[section.SectionID]
+	if need {
+		// Offer reserved seats first so general seats stay free for everyone else.
+		candidates = append(append([]int32{}, section.AccessibleSeats...), candidates...)
+	}
+	for _, seat := range candidates {
+		if seat > maxSeat || !t.seatOpen(section.SectionID, seat, now, taken) || !t.seatOffered(section, seat, need, now) {
+			continue
+		}
+		if !companion {
+			return seatRef{section: section.SectionID, seat: seat}, true
+		}
+		for _, next := range []int32{seat + 1, seat - 1} {
+			if next >= 1 && next <= maxSeat && next <= section.TotalSeats && t.seatOpen(section.SectionID, next, now, taken) && t.seatOffered(section, next, false, now) {
+				return seatRef{section: section.SectionID, seat: seat, companion: next}, true
+			}
+		}
+	}
+	return seatRef{}, false
+}
+
+// pickSeat is findSeat for a booking by userID that also claims the seats found in a backend
+// shared with other servers, trying further seats while another server has allocated or is
+// allocating the ones found. Callers hold t.mu.
+func (t *trainServer) pickSeat(section *pb.Section, need, companion bool, maxSeat int32, now time.Time, taken map[string]bool, userID string) (seatRef, bool) {
+	tried := map[string]bool{}
+	maps.Copy(tried, taken)
+	for {
+		ref, ok := t.findSeat(section, need, companion, maxSeat, now, tried)
+		if !ok || t.claimSeats(ref, userID) {
+			return ref, ok
+		}
+		for _, seat := range ref.seats() {
+			tried[seatKey(ref.section, seat)] = true
+		}
+	}
+}
+
+// claimSeats claims the seats of ref for userID in a backend that arbitrates seat allocation
+// between servers, reporting false if another server allocated or is allocating one of them.
+// It always succeeds with other backends. Callers hold t.mu.
+func (t *trainServer) claimSeats(ref seatRef, userID string) bool {
+	if t.store == nil {
+		return true
+	}
+	claimer, ok := t.store.backend.(seatClaimer)
+	return !ok || claimer.claimSeats(ref.section, ref.seats(), userID)
+}
+
+func validateAccessibleSeats(accessible []int32, totalSeats int32, departure string) ([]int32, string, error) {
+	seats, err := normalizeSeatNumbers(accessible, totalSeats)
+	if err != nil {
+		return nil, "", err
+	}
+	departure, err = parseDeparture(strings.TrimSpace(departure))
+	if err != nil {
+		return nil, "", err
+	}
+	return seats, departure, nil
+}
+
+// companionSeatNear finds a seat next to seat that is free or already held by userid and not
+// reserved for accessibility needs. Callers hold t.mu.
+func (t *trainServer) companionSeatNear(section *pb.Section, seat int32, userid string, now time.Time) int32 {
+	for _, next := range []int32{seat + 1, seat - 1} {
+		if next < 1 || next > section.TotalSeats {
+			continue
+		}
+		key := seatKey(section.SectionID, next)
+		if holder, allocated := t.allocatedSeats[key]; (!allocated || holder == userid) && !t.seatBlocked(key, now) && !t.seatHeld(key, now) && t.seatOffered(section, next, false, now) {
+			return next
+		}
+	}
+	return 0
+}
@@ -0,0 +1,170 @@
+// blocks.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// blockActive reports whether a seat block applies at the given time.
+func blockActive(b *pb.SeatBlock, now time.Time) bool {
+	if b.From != "" {
+		if from, err := time.Parse(time.RFC3339, b.From); err == nil && now.Before(from) {
+			return false
+		}
+	}
+	if b.Until != "" {
+		if until, err := time.Parse(time.RFC3339, b.Until); err == nil && !now.Before(until) {
+			return false
+		}
+	}
+	return true
+}
+
+// seatBlocked reports whether the seat behind key is out of service at now. Callers hold t.mu.
+func (t *trainServer) seatBlocked(key string, now time.Time) bool {
+	b, ok := t.blockedSeats[key]
+	return ok && blockActive(b, now)
+}
+
+// refreshSectionAvailability recounts free seats, excluding booked, blocked and held seats;
+// a cancelled section has none. Callers hold t.mu.
+func (t *trainServer) refreshSectionAvailability(section *pb.Section, now time.Time) {
+	available := int32(0)
+	for _, seat := range t.seats[section.SectionID] {
+		key := seatKey(section.SectionID, seat)
+		if _, allocated := t.allocatedSeats[key]; !allocated && !section.Cancelled && !t.seatBlocked(key, now) && !t.seatHeld(key, now) {
+			available++
+		}
+	}
+	section.AvailableSeats = available
+}
+
+// refreshAvailability releases lapsed holds and recounts every section while time-windowed
+// blocks may have started or ended since the counters were last updated. Callers hold t.mu.
+func (t *trainServer) refreshAvailability(now time.Time) {
+	t.expireHolds(now)
+	if len(t.blockedSeats) == 0 {
+		return
+	}
+	for _, section := range t.sections {
+		t.refreshSectionAvailability(section, now)
+	}
+}
+
+// removeSeatBlocks drops blocks in a section whose seat matches drop. Callers hold t.mu.
+func (t *trainServer) removeSeatBlocks(sectionID string, drop func(seat int32) bool) {
+	for key, b := range t.blockedSeats {
+		if b.SectionID == sectionID && drop(b.SeatNumber) {
+			delete(t.blockedSeats, key)
+			t.touch(tableBlock, key)
+		}
+	}
+}
+
+func parseOptionalTime(value, field string) (time.Time, error) {
+	if strings.TrimSpace(value) == "" {
+		return time.Time{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}, errors.New(field + " must be an RFC 3339 timestamp")
+	}
+	return parsed, nil
+}
+
+func (t *trainServer) BlockSeats(ctx context.Context, req *pb.BlockSeatsRequest) (*pb.BlockSeatsResponse, error) {
+	sectionID := strings.TrimSpace(req.SectionID)
+	if sectionID == "" {
+		return nil, errors.New("Provide section id")
+	} else if len(req.SeatNumbers) == 0 {
+		return nil, errors.New("Provide seat numbers")
+	} else if strings.TrimSpace(req.Reason) == "" {
+		return nil, errors.New("Provide reason")
+	}
+	from, err := parseOptionalTime(req.From, "From")
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTime(req.Until, "Until")
+	if err != nil {
+		return nil, err
+	}
+	if !from.IsZero() && !until.IsZero() && !until.After(from) {
+		return nil, errors.New("Until must be after from")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	section, ok := t.sections[sectionID]
+	if !ok {
+		return nil, errors.New("Invalid Section")
+	}
+	seats, err := normalizeSeatNumbers(req.SeatNumbers, section.TotalSeats)
+	if err != nil {
+		return nil, err
+	}
+	timenow := time.Now()
+	resp := &pb.BlockSeatsResponse{}
+	blocked := map[string]bool{}
+	conflicted := map[string]bool{}
+	for _, seat := range seats {
+		block := &pb.SeatBlock{
+			SectionID:  sectionID,
+			SeatNumber: seat,
+			Reason:     strings.TrimSpace(req.Reason),
+			From:       strings.TrimSpace(req.From),
+			Until:      strings.TrimSpace(req.Until),
+			CreatedOn:  timenow.String(),
+		}
+		key := seatKey(sectionID, seat)
+		t.blockedSeats[key] = block
+		t.touch(tableBlock, key)
+		blocked[key] = true
+		resp.Blocks = append(resp.Blocks, block)
+		// A ticket whose seat and companion seat are both blocked is reported once.
+		if userid, allocated := t.allocatedSeats[key]; allocated && !conflicted[userid] {
+			conflicted[userid] = true
+			resp.Conflicts = append(resp.Conflicts, t.tickets[userid])
+		}
+	}
+	sort.Slice(resp.Conflicts, func(i, j int) bool { return resp.Conflicts[i].SeatNumber < resp.Conflicts[j].SeatNumber })
+	// A hold on a blocked seat could still be turned into a ticket, so it is released.
+	t.releaseHolds(func(h *pb.Hold) bool {
+		return blocked[seatKey(h.Section, h.SeatNumber)] || (h.CompanionSeat != 0 && blocked[seatKey(h.Section, h.CompanionSeat)])
+	}, timenow)
+	t.refreshSectionAvailability(section, timenow)
+	return resp, nil
+}
+
+func (t *trainServer) UnblockSeats(ctx context.Context, req *pb.UnblockSeatsRequest) (*pb.EmptyResponse, error) {
+	sectionID := strings.TrimSpace(req.SectionID)
+	if sectionID == "" {
+		return nil, errors.New("Provide section id")
+	} else if len(req.SeatNumbers) == 0 {
+		return nil, errors.New("Provide seat numbers")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	section, ok := t.sections[sectionID]
+	if !ok {
+		return nil, errors.New("Invalid Section")
+	}
+	for _, seat := range req.SeatNumbers {
+		if _, blocked := t.blockedSeats[seatKey(sectionID, seat)]; !blocked {
+			return nil, errors.New("Seat is not blocked")
+		}
+	}
+	for _, seat := range req.SeatNumbers {
+		delete(t.blockedSeats, seatKey(sectionID, seat))
+		t.touch(tableBlock, seatKey(sectionID, seat))
+	}
+	t.refreshSectionAvailability(section, time.Now())
+	return &pb.EmptyResponse{}, nil
+}
@@ -0,0 +1,203 @@
+// boarding.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// scannedTicket finds the ticket a boarding pass, receipt QR payload or ticket ID refers to.
+// Callers hold t.mu.
+func (t *trainServer) scannedTicket(code string) (*pb.Ticket, error) {
+	ticketID := code
+	if strings.HasPrefix(code, "TB1.") {
+		pass, err := t.passVerifier.Verify(code)
+		if err != nil {
+			return nil, errors.New("Boarding pass is not valid")
+		}
+		ticketID = pass.TicketID
+	} else if len(code) > len("TICKETBOOK:") && strings.EqualFold(code[:len("TICKETBOOK:")], "TICKETBOOK:") {
+		ticketID = code[len("TICKETBOOK:"):]
+	}
+	for _, ticket := range t.tickets {
+		if strings.EqualFold(ticket.TicketId, ticketID) {
+			return ticket, nil
+		}
+	}
+	return nil, errors.New("Ticket not found")
+}
+
+// passengerName is the name printed for a ticket holder. Callers hold t.mu.
+func (t *trainServer) passengerName(userID string) string {
+	if user, ok := t.users[userID]; ok {
+		return strings.TrimSpace(user.FirstName + " " + user.LastName)
+	}
+	return ""
+}
+
+// seatLabel describes a ticket's seat for conductors, e.g. "section A seat 4". Callers hold t.mu.
+func (t *trainServer) seatLabel(ticket *pb.Ticket) string {
+	name := ticket.Section
+	if section, ok := t.sections[ticket.Section]; ok {
+		name = section.Section
+	}
+	return fmt.Sprintf("section %s seat %d", name, ticket.SeatNumber)
+}
+
+// trainDeparture parses the departure of the train being boarded: a date matches any
+// departure on that day, a time only that departure. Both are zero when it is not given.
+func trainDeparture(value string) (date string, at time.Time, err error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", time.Time{}, nil
+	}
+	if day, err := time.Parse(time.DateOnly, value); err == nil {
+		return day.Format(time.DateOnly), time.Time{}, nil
+	}
+	if at, err = time.Parse(time.RFC3339, value); err != nil {
+		return "", time.Time{}, errors.New("Departure must be an RFC 3339 time or a YYYY-MM-DD date")
+	}
+	return "", at, nil
+}
+
+// wrongTrain explains why a ticket is not valid on the train being boarded, or returns "" when
+// it is. The journey must match and, for a scheduled section, so must its departure and that
+// of the section the passenger was found in. Callers hold t.mu.
+func (t *trainServer) wrongTrain(ticket *pb.Ticket, req *pb.BoardRequest, date string, at time.Time) string {
+	journey := "Ticket is for " + ticket.From + " to " + ticket.To
+	if !strings.EqualFold(ticket.From, strings.TrimSpace(req.From)) || !strings.EqualFold(ticket.To, strings.TrimSpace(req.To)) {
+		return journey
+	}
+	section, ok := t.sections[ticket.Section]
+	if !ok || section.Departure == "" {
+		return ""
+	}
+	departure, err := time.Parse(time.RFC3339, section.Departure)
+	if err != nil {
+		return ""
+	}
+	journey += " departing " + section.Departure
+	if (date != "" && departure.Format(time.DateOnly) != date) || (!at.IsZero() && !departure.Equal(at)) {
+		return journey
+	}
+	if found, ok := t.sections[strings.TrimSpace(req.SectionID)]; ok && found.Departure != "" && found.Departure != section.Departure {
+		return journey
+	}
+	return ""
+}
+
+// Board records a conductor's scan. The first scan on the right train marks the ticket as
+// boarded, even when the passenger is found in the wrong seat; later scans are reported
+// as duplicates and keep the first scan's time and location. Tickets for another journey or
+// departure, or for a section cancelled by a disruption, are not boarded.
+func (t *trainServer) Board(ctx context.Context, req *pb.BoardRequest) (*pb.BoardingResult, error) {
+	code := strings.TrimSpace(req.Code)
+	if code == "" {
+		return nil, errors.New("Provide ticket or boarding pass code")
+	} else if strings.TrimSpace(req.From) == "" || strings.TrimSpace(req.To) == "" {
+		return nil, errors.New("Provide from and to of the train")
+	}
+	date, at, err := trainDeparture(req.Departure)
+	if err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ticket, err := t.scannedTicket(code)
+	if err != nil {
+		return &pb.BoardingResult{Outcome: pb.BoardingOutcome_NOT_RECOGNISED, Message: err.Error()}, nil
+	}
+	result := &pb.BoardingResult{Ticket: ticket, Passenger: t.passengerName(ticket.UserID)}
+	if message := t.wrongTrain(ticket, req, date, at); message != "" {
+		result.Outcome = pb.BoardingOutcome_WRONG_TRAIN
+		result.Message = message
+		return result, nil
+	}
+	section := t.sections[ticket.Section]
+	switch {
+	case section != nil && section.Cancelled:
+		result.Outcome = pb.BoardingOutcome_SECTION_CANCELLED
+		result.Message = "Section " + section.Section + " has been cancelled"
+		return result, nil
+	case ticket.BoardedAt != "":
+		result.Outcome = pb.BoardingOutcome_ALREADY_BOARDED
+		result.Boarded = true
+		result.Message = "Already boarded at " + ticket.BoardedAt
+		if ticket.BoardedLocation != "" {
+			result.Message += " in " + ticket.BoardedLocation
+		}
+		return result, nil
+	}
+	now := time.Now()
+	boarded := proto.Clone(ticket).(*pb.Ticket)
+	boarded.BoardedAt = now.Format(time.RFC3339)
+	boarded.BoardedLocation = strings.TrimSpace(req.Location)
+	boarded.ModifiedOn = now.String()
+	boarded.Version++
+	t.recordTicketEvent(domainTicketBoarded, boarded, nil)
+	result.Boarded = true
+	sectionID := strings.TrimSpace(req.SectionID)
+	switch {
+	case sectionID != "" && sectionID != ticket.Section:
+		result.Outcome = pb.BoardingOutcome_WRONG_SECTION
+		result.Message = "Ticket is for " + t.seatLabel(ticket)
+	case req.SeatNumber != 0 && req.SeatNumber != ticket.SeatNumber && req.SeatNumber != ticket.CompanionSeat:
+		result.Outcome = pb.BoardingOutcome_WRONG_SEAT
+		result.Message = "Ticket is for " + t.seatLabel(ticket)
+	default:
+		result.Outcome = pb.BoardingOutcome_BOARDED
+		result.Message = "Boarded"
+	}
+	return result, nil
+}
+
+// ViewManifest lists the passengers booked into a section in seat order.
+func (t *trainServer) ViewManifest(ctx context.Context, req *pb.ManifestRequest) (*pb.Manifest, error) {
+	sectionID := strings.TrimSpace(req.SectionID)
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	section, ok := t.sections[sectionID]
+	if !ok {
+		return nil, errors.New("Invalid section")
+	}
+	manifest := &pb.Manifest{SectionID: section.SectionID, Section: section.Section}
+	for _, ticket := range t.tickets {
+		if ticket.Section != sectionID {
+			continue
+		}
+		manifest.Expected++
+		boarded := ticket.BoardedAt != ""
+		if boarded {
+			manifest.Boarded++
+			if req.PendingOnly {
+				continue
+			}
+		}
+		manifest.Passengers = append(manifest.Passengers, &pb.ManifestEntry{
+			TicketId:          ticket.TicketId,
+			UserID:            ticket.UserID,
+			Passenger:         t.passengerName(ticket.UserID),
+			SeatNumber:        ticket.SeatNumber,
+			CompanionSeat:     ticket.CompanionSeat,
+			From:              ticket.From,
+			To:                ticket.To,
+			Boarded:           boarded,
+			BoardedAt:         ticket.BoardedAt,
+			BoardedLocation:   ticket.BoardedLocation,
+			AccessibilityNeed: ticket.AccessibilityNeed,
+		})
+	}
+	sort.Slice(manifest.Passengers, func(i, j int) bool {
+		return manifest.Passengers[i].SeatNumber < manifest.Passengers[j].SeatNumber
+	})
+	return manifest, nil
+}
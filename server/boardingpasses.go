@@ -0,0 +1,142 @@
+// boardingpasses.go
+
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"project/ticketbook/boardingpass"
+	pb "project/ticketbook/ticket/generated"
+)
+
+type boardingPassConfig struct {
+	// SigningKeyFile is a PEM PKCS #8 Ed25519 private key. Without one the server signs with
+	// a key generated at startup, so passes stop verifying after a restart.
+	SigningKeyFile string `json:"signing_key_file"`
+	// PreviousPublicKeyFiles are PEM public keys of retired signing keys whose passes are still valid.
+	PreviousPublicKeyFiles []string `json:"previous_public_key_files"`
+}
+
+// load reads the configured keys. ephemeral reports whether a startup key was generated.
+func (c boardingPassConfig) load() (signer *boardingpass.Signer, verifier *boardingpass.Verifier, ephemeral bool, err error) {
+	var key ed25519.PrivateKey
+	if c.SigningKeyFile == "" {
+		if _, key, err = ed25519.GenerateKey(rand.Reader); err != nil {
+			return nil, nil, false, err
+		}
+		ephemeral = true
+	} else {
+		data, err := os.ReadFile(c.SigningKeyFile)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if key, err = boardingpass.ParsePrivateKey(data); err != nil {
+			return nil, nil, false, fmt.Errorf("%s: %w", c.SigningKeyFile, err)
+		}
+	}
+	signer = boardingpass.NewSigner(key)
+	keys := []ed25519.PublicKey{signer.PublicKey()}
+	for _, path := range c.PreviousPublicKeyFiles {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		pub, err := boardingpass.ParsePublicKey(data)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, pub)
+	}
+	return signer, boardingpass.NewVerifier(keys...), ephemeral, nil
+}
+
+// issueBoardingPass signs a pass for the ticket's current seat and passenger. Callers hold t.mu.
+func (t *trainServer) issueBoardingPass(ticket *pb.Ticket, now time.Time) {
+	pass := boardingpass.Pass{
+		TicketID:      ticket.TicketId,
+		From:          ticket.From,
+		To:            ticket.To,
+		Section:       ticket.Section,
+		Seat:          ticket.SeatNumber,
+		CompanionSeat: ticket.CompanionSeat,
+		IssuedAt:      now,
+	}
+	if section, ok := t.sections[ticket.Section]; ok {
+		pass.Section = section.Section
+	}
+	if user, ok := t.users[ticket.UserID]; ok {
+		pass.Passenger = strings.TrimSpace(user.FirstName + " " + user.LastName)
+	}
+	ticket.BoardingPass = t.passSigner.Sign(pass)
+}
+
+// reissueBoardingPasses re-signs the passes of tickets whose printed details changed.
+// Callers hold t.mu.
+func (t *trainServer) reissueBoardingPasses(affected func(*pb.Ticket) bool) {
+	now := time.Now()
+	for _, ticket := range t.tickets {
+		if affected(ticket) {
+			reissued := proto.Clone(ticket).(*pb.Ticket)
+			t.issueBoardingPass(reissued, now)
+			t.recordTicketEvent(domainBoardingPassReissued, reissued, nil)
+		}
+	}
+}
+
+func (t *trainServer) GetBoardingPassKeys(ctx context.Context, req *pb.EmptyResponse) (*pb.BoardingPassKeys, error) {
+	keys := &pb.BoardingPassKeys{}
+	for id, key := range t.passVerifier.Keys() {
+		keys.Keys = append(keys.Keys, &pb.BoardingPassKey{
+			KeyID:     id,
+			Algorithm: "Ed25519",
+			PublicKey: key,
+			Current:   id == t.passSigner.KeyID(),
+		})
+	}
+	sort.Slice(keys.Keys, func(i, j int) bool {
+		if keys.Keys[i].Current != keys.Keys[j].Current {
+			return keys.Keys[i].Current
+		}
+		return keys.Keys[i].KeyID < keys.Keys[j].KeyID
+	})
+	return keys, nil
+}
+
+// VerifyBoardingPass checks a pass signature only; like a conductor's offline reader it
+// does not look the ticket up, so it keeps working without the ticket store.
+func (t *trainServer) VerifyBoardingPass(ctx context.Context, req *pb.VerifyBoardingPassRequest) (*pb.BoardingPassVerification, error) {
+	if strings.TrimSpace(req.Token) == "" {
+		return nil, errors.New("Provide boarding pass token")
+	}
+	pass, err := t.passVerifier.Verify(req.Token)
+	switch {
+	case errors.Is(err, boardingpass.ErrMalformed):
+		return &pb.BoardingPassVerification{Reason: "Boarding pass is not readable"}, nil
+	case errors.Is(err, boardingpass.ErrUnknownKey):
+		return &pb.BoardingPassVerification{Reason: "Boarding pass was signed by an unknown key"}, nil
+	case err != nil:
+		return &pb.BoardingPassVerification{Reason: "Boarding pass signature is invalid"}, nil
+	}
+	return &pb.BoardingPassVerification{
+		Valid:         true,
+		KeyID:         pass.KeyID,
+		TicketID:      pass.TicketID,
+		From:          pass.From,
+		To:            pass.To,
+		Section:       pass.Section,
+		SeatNumber:    pass.Seat,
+		CompanionSeat: pass.CompanionSeat,
+		Passenger:     pass.Passenger,
+		IssuedAt:      pass.IssuedAt.Format(time.RFC3339),
+	}, nil
+}
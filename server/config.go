@@ -0,0 +1,543 @@
+// config.go
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// envPrefix namespaces every environment variable read by the server.
+const envPrefix = "TICKETBOOK_"
+
+// config holds every setting of the server binary. Values are resolved in the
+// order defaults < config file < environment < command-line flags.
+type config struct {
+	ListenAddr        string         `json:"listen_addr"`
+	TLS               tlsConfig      `json:"tls"`
+	Storage           storageConfig  `json:"storage"`
+	HoldTTL           duration       `json:"hold_ttl"`
+	IdempotencyWindow duration       `json:"idempotency_window"`
+	ShutdownTimeout   duration       `json:"shutdown_timeout"`
+	LogLevel          string         `json:"log_level"`
+	Features          featuresConfig `json:"features"`
+	// Currency is the ISO 4217 code fares, fixed promotions and loyalty amounts are given in.
+	Currency string `json:"currency"`
+	// Fares maps a seat class name such as "FIRST" to its base fare in minor units of Currency.
+	Fares map[string]int64 `json:"fares"`
+	// Concessions maps a passenger category name such as "CHILD" to its discount rule.
+	Concessions map[string]concessionRule `json:"concessions"`
+	Loyalty     loyaltyConfig             `json:"loyalty"`
+	// Pricing lists dynamic pricing strategies; every rule matching a journey adjusts its fare.
+	Pricing []pricingRule `json:"pricing"`
+	// Invoicing sets the seller details and the taxes included in fares.
+	Invoicing invoiceConfig `json:"invoicing"`
+	// ReceiptTemplateDir holds operator overrides of receipt.html.tmpl and receipt.txt.tmpl.
+	ReceiptTemplateDir string `json:"receipt_template_dir"`
+	// BoardingPass holds the keys boarding passes are signed and verified with.
+	BoardingPass boardingPassConfig `json:"boarding_pass"`
+	// Notifications configures where booking confirmations and changes are sent.
+	Notifications notificationConfig `json:"notifications"`
+	// Webhooks tunes delivery to partner webhook subscriptions.
+	Webhooks webhookConfig `json:"webhooks"`
+	// AccessibleReleaseCutoff is how long before departure accessibility-reserved seats go on general sale.
+	AccessibleReleaseCutoff duration `json:"accessible_release_cutoff"`
+}
+
+type tlsConfig struct {
+	CertFile       string   `json:"cert_file"`
+	KeyFile        string   `json:"key_file"`
+	ClientCAFile   string   `json:"client_ca_file"`
+	ClientAuth     string   `json:"client_auth"`
+	ReloadInterval duration `json:"reload_interval"`
+}
+
+type storageConfig struct {
+	Backend string `json:"backend"`
+	DSN     string `json:"dsn"`
+	// SnapshotInterval is how many events pass between snapshots of the booking state; 0 disables them.
+	SnapshotInterval int `json:"snapshot_interval"`
+	// RetainEvents is how many of the latest events are kept for event streams and time travel.
+	RetainEvents int `json:"retain_events"`
+	// Dir holds the write-ahead log and its snapshots for the wal backend.
+	Dir string `json:"dir"`
+	// CompactEvery is how many records the wal backend appends before compacting the log into a snapshot.
+	CompactEvery int `json:"compact_every"`
+}
+
+type notificationConfig struct {
+	// Channels lists the channels every notification is sent through: log, smtp and webhook.
+	Channels     []string   `json:"channels"`
+	SMTP         smtpConfig `json:"smtp"`
+	WebhookURL   string     `json:"webhook_url"`
+	MaxAttempts  int        `json:"max_attempts"`
+	RetryBackoff duration   `json:"retry_backoff"`
+	// TemplateDir holds an operator override of notifications.txt.tmpl.
+	TemplateDir string `json:"template_dir"`
+}
+
+type webhookConfig struct {
+	MaxAttempts    int      `json:"max_attempts"`
+	InitialBackoff duration `json:"initial_backoff"`
+	MaxBackoff     duration `json:"max_backoff"`
+	Timeout        duration `json:"timeout"`
+	// AllowPrivateAddresses lets subscriptions post to loopback, private and link-local
+	// addresses, which are refused by default.
+	AllowPrivateAddresses bool `json:"allow_private_addresses"`
+}
+
+type smtpConfig struct {
+	Addr     string `json:"addr"`
+	From     string `json:"from"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type featuresConfig struct {
+	Reflection bool `json:"reflection"`
+}
+
+// duration is a time.Duration that reads and writes as a string such as "30s" in config files.
+type duration time.Duration
+
+func (d duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return errors.New("duration must be a string such as \"30s\"")
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(v)
+	return nil
+}
+
+func defaultConfig() *config {
+	return &config{
+		ListenAddr:        ":8080",
+		TLS:               tlsConfig{ClientAuth: "require", ReloadInterval: duration(30 * time.Second)},
+		Storage:           storageConfig{Backend: "memory", SnapshotInterval: 1000, RetainEvents: 100000, Dir: "data", CompactEvery: 10000},
+		HoldTTL:           duration(10 * time.Minute),
+		IdempotencyWindow: duration(24 * time.Hour),
+		ShutdownTimeout:   duration(30 * time.Second),
+		LogLevel:          "info",
+
+		AccessibleReleaseCutoff: duration(2 * time.Hour),
+		Currency:                "USD",
+		Concessions: map[string]concessionRule{
+			"CHILD":    {PercentOff: 5000, MaxAge: 15},
+			"SENIOR":   {PercentOff: 3000, MinAge: 60},
+			"STUDENT":  {PercentOff: 2500, RequiresCard: true},
+			"DISABLED": {PercentOff: 3300, RequiresCard: true},
+		},
+		Loyalty:   loyaltyConfig{PointsPerUnit: 1, PointValue: 1, SilverSpend: 50000, GoldSpend: 200000},
+		Invoicing: invoiceConfig{InvoicePrefix: "INV-", CreditNotePrefix: "CN-"},
+		Notifications: notificationConfig{
+			Channels:     []string{"log"},
+			MaxAttempts:  3,
+			RetryBackoff: duration(2 * time.Second),
+		},
+		Webhooks: webhookConfig{
+			MaxAttempts:    8,
+			InitialBackoff: duration(time.Second),
+			MaxBackoff:     duration(10 * time.Minute),
+			Timeout:        duration(10 * time.Second),
+		},
+	}
+}
+
+// setting binds one config value to a command-line flag and an environment variable.
+type setting struct {
+	name   string
+	usage  string
+	isBool bool
+	set    func(string) error
+}
+
+func (c *config) settings() []setting {
+	str := func(p *string) func(string) error {
+		return func(v string) error { *p = v; return nil }
+	}
+	dur := func(p *duration) func(string) error {
+		return func(v string) error {
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return err
+			}
+			*p = duration(d)
+			return nil
+		}
+	}
+	integer := func(p *int) func(string) error {
+		return func(v string) error {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return err
+			}
+			*p = n
+			return nil
+		}
+	}
+	boolean := func(p *bool) func(string) error {
+		return func(v string) error {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			*p = b
+			return nil
+		}
+	}
+	return []setting{
+		{name: "listen-addr", usage: "Address the gRPC server listens on", set: str(&c.ListenAddr)},
+		{name: "tls-cert-file", usage: "PEM certificate presented by the server", set: str(&c.TLS.CertFile)},
+		{name: "tls-key-file", usage: "PEM private key for the server certificate", set: str(&c.TLS.KeyFile)},
+		{name: "tls-client-ca-file", usage: "PEM CA bundle used to verify client certificates (enables mTLS)", set: str(&c.TLS.ClientCAFile)},
+		{name: "tls-client-auth", usage: "Client certificate policy when a client CA is set (optional, require)", set: str(&c.TLS.ClientAuth)},
+		{name: "tls-reload-interval", usage: "How often certificate files are checked for changes", set: dur(&c.TLS.ReloadInterval)},
+		{name: "storage-backend", usage: "Storage backend (memory, wal or postgres)", set: str(&c.Storage.Backend)},
+		{name: "storage-dsn", usage: "Connection string for the storage backend", set: str(&c.Storage.DSN)},
+		{name: "storage-snapshot-interval", usage: "Events between snapshots of the booking state, 0 to disable", set: integer(&c.Storage.SnapshotInterval)},
+		{name: "storage-retain-events", usage: "Latest events kept for event streams and time travel", set: integer(&c.Storage.RetainEvents)},
+		{name: "storage-dir", usage: "Directory of the write-ahead log for the wal backend", set: str(&c.Storage.Dir)},
+		{name: "storage-compact-every", usage: "Log records between compactions for the wal backend", set: integer(&c.Storage.CompactEvery)},
+		{name: "hold-ttl", usage: "How long a seat or price hold stays valid", set: dur(&c.HoldTTL)},
+		{name: "idempotency-window", usage: "How long responses are replayed for a repeated idempotency key", set: dur(&c.IdempotencyWindow)},
+		{name: "accessible-release-cutoff", usage: "How long before departure accessibility-reserved seats go on general sale", set: dur(&c.AccessibleReleaseCutoff)},
+		{name: "shutdown-timeout", usage: "Time allowed for in-flight RPCs to finish on shutdown", set: dur(&c.ShutdownTimeout)},
+		{name: "receipt-template-dir", usage: "Directory of receipt templates overriding the built-in ones", set: str(&c.ReceiptTemplateDir)},
+		{name: "boarding-pass-signing-key-file", usage: "PEM Ed25519 private key boarding passes are signed with", set: str(&c.BoardingPass.SigningKeyFile)},
+		{name: "notification-channels", usage: "Comma separated notification channels (log, smtp, webhook)", set: func(v string) error {
+			c.Notifications.Channels = nil
+			for _, name := range strings.Split(v, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					c.Notifications.Channels = append(c.Notifications.Channels, name)
+				}
+			}
+			return nil
+		}},
+		{name: "notification-webhook-url", usage: "URL the webhook notification channel posts to", set: str(&c.Notifications.WebhookURL)},
+		{name: "smtp-addr", usage: "host:port of the SMTP server for email notifications", set: str(&c.Notifications.SMTP.Addr)},
+		{name: "smtp-from", usage: "Sender address of email notifications", set: str(&c.Notifications.SMTP.From)},
+		{name: "smtp-username", usage: "SMTP username, empty to send without authentication", set: str(&c.Notifications.SMTP.Username)},
+		{name: "smtp-password", usage: "SMTP password", set: str(&c.Notifications.SMTP.Password)},
+		{name: "currency", usage: "ISO 4217 base currency of configured fares", set: str(&c.Currency)},
+		{name: "log-level", usage: "Log level (debug, info, warn, error)", set: str(&c.LogLevel)},
+		{name: "reflection", usage: "Register the gRPC server reflection service", isBool: true, set: boolean(&c.Features.Reflection)},
+	}
+}
+
+// envName maps a setting name such as "tls-cert-file" to TICKETBOOK_TLS_CERT_FILE.
+func envName(name string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// loadConfig resolves the configuration from the config file, the environment and args.
+// The returned bool reports whether --print-config was requested.
+func loadConfig(args []string, lookupEnv func(string) (string, bool)) (*config, bool, error) {
+	cfg := defaultConfig()
+	settings := cfg.settings()
+
+	fs := flag.NewFlagSet("ticketbook", flag.ContinueOnError)
+	configFile := fs.String("config", "", "Path to a JSON config file (env "+envName("config")+")")
+	printConfig := fs.Bool("print-config", false, "Print the resolved configuration and exit")
+	type override struct {
+		set   func(string) error
+		value string
+	}
+	overrides := []override{}
+	for _, s := range settings {
+		s := s
+		record := func(v string) error {
+			overrides = append(overrides, override{set: s.set, value: v})
+			return nil
+		}
+		usage := s.usage + " (env " + envName(s.name) + ")"
+		if s.isBool {
+			fs.BoolFunc(s.name, usage, record)
+		} else {
+			fs.Func(s.name, usage, record)
+		}
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, false, err
+	}
+
+	path := *configFile
+	if path == "" {
+		path, _ = lookupEnv(envName("config"))
+	}
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, false, fmt.Errorf("config file %s: %w", path, err)
+		}
+	}
+	for _, s := range settings {
+		if v, ok := lookupEnv(envName(s.name)); ok {
+			if err := s.set(v); err != nil {
+				return nil, false, fmt.Errorf("%s: %w", envName(s.name), err)
+			}
+		}
+	}
+	for _, o := range overrides {
+		if err := o.set(o.value); err != nil {
+			return nil, false, err
+		}
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, false, err
+	}
+	return cfg, *printConfig, nil
+}
+
+func (c *config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	return dec.Decode(c)
+}
+
+func (c *config) validate() error {
+	var errs []error
+	if _, _, err := net.SplitHostPort(c.ListenAddr); err != nil {
+		errs = append(errs, fmt.Errorf("listen_addr: %w", err))
+	}
+	if (c.TLS.CertFile == "") != (c.TLS.KeyFile == "") {
+		errs = append(errs, errors.New("tls: cert_file and key_file must be set together"))
+	}
+	if c.TLS.ClientCAFile != "" && c.TLS.CertFile == "" {
+		errs = append(errs, errors.New("tls: client_ca_file requires cert_file and key_file"))
+	}
+	if c.TLS.ClientAuth != "optional" && c.TLS.ClientAuth != "require" {
+		errs = append(errs, fmt.Errorf("tls: unknown client_auth %q", c.TLS.ClientAuth))
+	}
+	if c.TLS.ReloadInterval <= 0 {
+		errs = append(errs, errors.New("tls: reload_interval must be greater than 0"))
+	}
+	switch c.Storage.Backend {
+	case "memory":
+	case "wal":
+		if strings.TrimSpace(c.Storage.Dir) == "" {
+			errs = append(errs, errors.New("storage: dir is required for the wal backend"))
+		}
+		if c.Storage.CompactEvery <= 0 {
+			errs = append(errs, errors.New("storage: compact_every must be greater than 0"))
+		}
+	case "postgres":
+		if strings.TrimSpace(c.Storage.DSN) == "" {
+			errs = append(errs, errors.New("storage: dsn is required for the postgres backend"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("storage: unknown backend %q", c.Storage.Backend))
+	}
+	if c.Storage.SnapshotInterval < 0 {
+		errs = append(errs, errors.New("storage: snapshot_interval can not be negative"))
+	}
+	if c.Storage.RetainEvents <= 0 {
+		errs = append(errs, errors.New("storage: retain_events must be greater than 0"))
+	}
+	if c.HoldTTL <= 0 {
+		errs = append(errs, errors.New("hold_ttl must be greater than 0"))
+	}
+	if c.IdempotencyWindow <= 0 {
+		errs = append(errs, errors.New("idempotency_window must be greater than 0"))
+	}
+	if c.AccessibleReleaseCutoff < 0 {
+		errs = append(errs, errors.New("accessible_release_cutoff can not be negative"))
+	}
+	if c.ShutdownTimeout < 0 {
+		errs = append(errs, errors.New("shutdown_timeout can not be negative"))
+	}
+	if !validCurrency(c.Currency) {
+		errs = append(errs, fmt.Errorf("currency: unsupported currency %q", c.Currency))
+	}
+	for class, fare := range c.Fares {
+		if _, ok := pb.SeatClass_value[class]; !ok {
+			errs = append(errs, fmt.Errorf("fares: unknown seat class %q", class))
+		} else if fare < 0 {
+			errs = append(errs, fmt.Errorf("fares: %s fare can not be less than 0", class))
+		}
+	}
+	for category, rule := range c.Concessions {
+		if _, ok := pb.PassengerCategory_value[category]; !ok || category == "ADULT" {
+			errs = append(errs, fmt.Errorf("concessions: unknown passenger category %q", category))
+		} else if rule.PercentOff < 0 || rule.PercentOff > 10000 {
+			errs = append(errs, fmt.Errorf("concessions: %s percent_off must be between 0 and 100", category))
+		} else if rule.MaxAge != 0 && rule.MaxAge < rule.MinAge {
+			errs = append(errs, fmt.Errorf("concessions: %s max_age is below min_age", category))
+		}
+	}
+	if c.Loyalty.PointsPerUnit < 0 || c.Loyalty.PointValue < 0 || c.Loyalty.SilverSpend < 0 || c.Loyalty.GoldSpend < 0 {
+		errs = append(errs, errors.New("loyalty: rates and tier thresholds can not be negative"))
+	} else if c.Loyalty.GoldSpend < c.Loyalty.SilverSpend {
+		errs = append(errs, errors.New("loyalty: gold_spend can not be below silver_spend"))
+	}
+	for i, rule := range c.Pricing {
+		if rule.Class != "" {
+			if _, ok := pb.SeatClass_value[rule.Class]; !ok {
+				errs = append(errs, fmt.Errorf("pricing[%d]: unknown seat class %q", i, rule.Class))
+			}
+		}
+		if (len(rule.Occupancy) == 0) == (len(rule.Departure) == 0) {
+			errs = append(errs, fmt.Errorf("pricing[%d]: set exactly one of occupancy_bands or departure_bands", i))
+		}
+		for _, band := range rule.Occupancy {
+			if band.MinOccupancy < 0 || band.MinOccupancy > 1 || band.Multiplier <= 0 {
+				errs = append(errs, fmt.Errorf("pricing[%d]: occupancy bands need min_occupancy between 0 and 1 and a positive multiplier", i))
+			}
+		}
+		for _, band := range rule.Departure {
+			if band.Within <= 0 || band.Multiplier <= 0 {
+				errs = append(errs, fmt.Errorf("pricing[%d]: departure bands need a positive within and multiplier", i))
+			}
+		}
+	}
+	for _, name := range c.Notifications.Channels {
+		switch name {
+		case "log":
+		case "smtp":
+			if _, _, err := net.SplitHostPort(c.Notifications.SMTP.Addr); err != nil {
+				errs = append(errs, fmt.Errorf("notifications: smtp.addr: %w", err))
+			}
+			if !IsValidEmail(c.Notifications.SMTP.From) {
+				errs = append(errs, errors.New("notifications: smtp.from must be an email address"))
+			}
+		case "webhook":
+			if u, err := url.Parse(c.Notifications.WebhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				errs = append(errs, errors.New("notifications: webhook_url must be an http or https URL"))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("notifications: unknown channel %q", name))
+		}
+	}
+	if c.Notifications.MaxAttempts < 1 {
+		errs = append(errs, errors.New("notifications: max_attempts must be at least 1"))
+	}
+	if c.Notifications.RetryBackoff < 0 {
+		errs = append(errs, errors.New("notifications: retry_backoff can not be negative"))
+	}
+	if c.Webhooks.MaxAttempts < 1 {
+		errs = append(errs, errors.New("webhooks: max_attempts must be at least 1"))
+	}
+	if c.Webhooks.InitialBackoff <= 0 || c.Webhooks.MaxBackoff < c.Webhooks.InitialBackoff {
+		errs = append(errs, errors.New("webhooks: initial_backoff must be greater than 0 and no more than max_backoff"))
+	}
+	if c.Webhooks.Timeout <= 0 {
+		errs = append(errs, errors.New("webhooks: timeout must be greater than 0"))
+	}
+	for i, path := range c.BoardingPass.PreviousPublicKeyFiles {
+		if strings.TrimSpace(path) == "" {
+			errs = append(errs, fmt.Errorf("boarding_pass: previous_public_key_files[%d] is blank", i))
+		}
+	}
+	if c.Invoicing.InvoicePrefix == c.Invoicing.CreditNotePrefix {
+		errs = append(errs, errors.New("invoicing: invoice_prefix and credit_note_prefix must differ"))
+	}
+	for i, rule := range c.Invoicing.TaxRules {
+		if strings.TrimSpace(rule.Name) == "" {
+			errs = append(errs, fmt.Errorf("invoicing: tax_rules[%d] needs a name", i))
+		} else if rule.Rate < 0 || rule.Rate > 10000 {
+			errs = append(errs, fmt.Errorf("invoicing: %s rate_percent must be between 0 and 100", rule.Name))
+		} else if _, ok := pb.SeatClass_value[rule.Class]; rule.Class != "" && !ok {
+			errs = append(errs, fmt.Errorf("invoicing: %s applies to unknown seat class %q", rule.Name, rule.Class))
+		}
+	}
+	if _, err := c.slogLevel(); err != nil {
+		errs = append(errs, fmt.Errorf("log_level: %w", err))
+	}
+	return errors.Join(errs...)
+}
+
+func (c *config) slogLevel() (slog.Level, error) {
+	var level slog.Level
+	err := level.UnmarshalText([]byte(c.LogLevel))
+	return level, err
+}
+
+func (c *config) fareTable() map[pb.SeatClass]*pb.Money {
+	fares := make(map[pb.SeatClass]*pb.Money, len(c.Fares))
+	for class, fare := range c.Fares {
+		fares[pb.SeatClass(pb.SeatClass_value[class])] = newMoney(c.Currency, fare)
+	}
+	return fares
+}
+
+func (c *config) concessionRules() map[pb.PassengerCategory]concessionRule {
+	rules := make(map[pb.PassengerCategory]concessionRule, len(c.Concessions))
+	for category, rule := range c.Concessions {
+		rules[pb.PassengerCategory(pb.PassengerCategory_value[category])] = rule
+	}
+	return rules
+}
+
+func (c *config) pricingRules() []routePricing {
+	rules := make([]routePricing, 0, len(c.Pricing))
+	for _, rule := range c.Pricing {
+		route := routePricing{from: rule.From, to: rule.To, class: rule.Class}
+		if len(rule.Occupancy) > 0 {
+			route.strategy = newOccupancyStrategy(rule.Occupancy)
+		} else {
+			route.strategy = newDepartureStrategy(rule.Departure)
+		}
+		rules = append(rules, route)
+	}
+	return rules
+}
+
+// redacted returns a copy of the config that is safe to print.
+func (c *config) redacted() *config {
+	out := *c
+	if out.Notifications.SMTP.Password != "" {
+		out.Notifications.SMTP.Password = "REDACTED"
+	}
+	out.Storage.DSN = redactDSN(out.Storage.DSN)
+	return &out
+}
+
+// dsnPassword matches the password of a key=value connection string such as
+// "host=db user=app password='secret'".
+var dsnPassword = regexp.MustCompile(`(?i)(\bpassword\s*=\s*)('(?:[^'\\]|\\.)*'|\S+)`)
+
+// redactDSN hides the password of a connection string, given either as a URL, in its user
+// info or a password query parameter, or as key=value pairs.
+func redactDSN(dsn string) string {
+	if u, err := url.Parse(dsn); err == nil && u.Scheme != "" && u.Host != "" {
+		if _, ok := u.User.Password(); ok {
+			u.User = url.UserPassword(u.User.Username(), "REDACTED")
+		}
+		if query := u.Query(); query.Has("password") {
+			query.Set("password", "REDACTED")
+			u.RawQuery = query.Encode()
+		}
+		return u.String()
+	}
+	return dsnPassword.ReplaceAllString(dsn, "${1}REDACTED")
+}
+
+func (c *config) print(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c.redacted())
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+func envFrom(m map[string]string) func(string) (string, bool) {
+	return func(k string) (string, bool) {
+		v, ok := m[k]
+		return v, ok
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Run("Defaults", testLoadConfigDefaults)
+	t.Run("Precedence", testLoadConfigPrecedence)
+	t.Run("Validation", testLoadConfigValidation)
+	t.Run("Redaction", testConfigRedaction)
+	t.Run("ExactRates", testLoadConfigExactRates)
+}
+func testLoadConfigDefaults(t *testing.T) {
+	cfg, printConfig, err := loadConfig(nil, envFrom(nil))
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if printConfig {
+		t.Errorf("Expected print-config to be off by default")
+	}
+	if cfg.ListenAddr != ":8080" || cfg.Storage.Backend != "memory" {
+		t.Errorf("Unexpected defaults: %+v", cfg)
+	}
+}
+func testLoadConfigPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	file := `{"listen_addr": ":9000", "hold_ttl": "5m", "log_level": "debug"}`
+	if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	env := envFrom(map[string]string{
+		"TICKETBOOK_CONFIG":      path,
+		"TICKETBOOK_HOLD_TTL":    "7m",
+		"TICKETBOOK_REFLECTION":  "true",
+		"TICKETBOOK_LISTEN_ADDR": ":9100",
+	})
+
+	cfg, printConfig, err := loadConfig([]string{"-listen-addr", ":9200", "--print-config"}, env)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if !printConfig {
+		t.Errorf("Expected print-config to be requested")
+	}
+	if cfg.ListenAddr != ":9200" {
+		t.Errorf("Expected flag to win, got listen addr %q", cfg.ListenAddr)
+	}
+	if time.Duration(cfg.HoldTTL) != 7*time.Minute {
+		t.Errorf("Expected env to override file, got hold ttl %v", time.Duration(cfg.HoldTTL))
+	}
+	if cfg.LogLevel != "debug" || !cfg.Features.Reflection {
+		t.Errorf("Expected file and env values to be applied, got %+v", cfg)
+	}
+}
+func testLoadConfigValidation(t *testing.T) {
+	args := []string{"-tls-cert-file", "server.pem", "-storage-backend", "floppy", "-log-level", "loud"}
+	_, _, err := loadConfig(args, envFrom(nil))
+	if err == nil {
+		t.Fatalf("Expected validation to fail")
+	}
+	for _, want := range []string{"key_file", "floppy", "log_level"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to mention %q, got %v", want, err)
+		}
+	}
+}
+func testLoadConfigExactRates(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	load := func(file string) (*config, error) {
+		if err := os.WriteFile(path, []byte(file), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		cfg, _, err := loadConfig(nil, envFrom(map[string]string{"TICKETBOOK_CONFIG": path}))
+		return cfg, err
+	}
+	cfg, err := load(`{"fares": {"FIRST": 1250}, "concessions": {"CHILD": {"percent_off": 12.5}},
+		"invoicing": {"invoice_prefix": "INV-", "tax_rules": [{"name": "VAT", "rate_percent": 7.75}]},
+		"pricing": [{"occupancy_bands": [{"min_occupancy": 0.5, "multiplier": 1.1}]}]}`)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if fare := cfg.fareTable()[pb.SeatClass_FIRST]; fare.MinorUnits != 1250 {
+		t.Errorf("Expected the fare in minor units, got %v", fare)
+	}
+	if got := cfg.Concessions["CHILD"].PercentOff; got != 1250 || got.of(999) != 125 {
+		t.Errorf("Expected 12.5%% as 1250 basis points, got %d", got)
+	}
+	if got := cfg.Invoicing.TaxRules[0].Rate; got != 775 || got.String() != "7.75%" {
+		t.Errorf("Expected 7.75%% as 775 basis points, got %d", got)
+	}
+	if got := cfg.Pricing[0].Occupancy[0].Multiplier; got != 11000 || got.String() != "1.1" {
+		t.Errorf("Expected a multiplier of 1.1 as 11000, got %d", got)
+	}
+	if _, err := load(`{"concessions": {"CHILD": {"percent_off": 12.345}}}`); err == nil || !strings.Contains(err.Error(), "12.345") {
+		t.Errorf("Expected a percentage finer than a basis point to be rejected, got %v", err)
+	}
+}
+func testConfigRedaction(t *testing.T) {
+	for _, dsn := range []string{
+		"postgres://app:hunter2@db/ticketbook",
+		"postgres://app@db/ticketbook?sslmode=require&password=hunter2",
+		"host=db user=app password=hunter2 dbname=ticketbook",
+		"host=db user=app password = 'hunter2 with spaces' dbname=ticketbook",
+	} {
+		cfg := defaultConfig()
+		cfg.Storage.DSN = dsn
+		cfg.Notifications.SMTP.Password = "swordfish"
+
+		var out strings.Builder
+		if err := cfg.print(&out); err != nil {
+			t.Fatalf("print failed: %v", err)
+		}
+		if strings.Contains(out.String(), "hunter2") || strings.Contains(out.String(), "swordfish") {
+			t.Errorf("Expected secrets to be redacted, got %s", out.String())
+		}
+		if !strings.Contains(out.String(), "dbname=ticketbook") && !strings.Contains(out.String(), "db/ticketbook") {
+			t.Errorf("Expected the rest of the dsn to be kept, got %s", out.String())
+		}
+	}
+}
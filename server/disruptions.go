@@ -0,0 +1,250 @@
+// disruptions.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// disruptedSections resolves a disruption request to the sections it covers, in id order.
+// Callers hold t.mu.
+func (t *trainServer) disruptedSections(req *pb.DisruptionRequest) ([]*pb.Section, error) {
+	sectionID := strings.TrimSpace(req.SectionID)
+	if (sectionID == "") == (strings.TrimSpace(req.Departure) == "") {
+		return nil, errors.New("Provide either section id or departure")
+	}
+	if sectionID != "" {
+		section, ok := t.sections[sectionID]
+		if !ok {
+			return nil, errors.New("Invalid Section")
+		} else if section.Cancelled {
+			return nil, errors.New("Section is already cancelled")
+		}
+		return []*pb.Section{section}, nil
+	}
+	departure, err := parseDeparture(req.Departure)
+	if err != nil {
+		return nil, err
+	}
+	sections := []*pb.Section{}
+	for _, id := range t.sortedSectionIDs() {
+		if section := t.sections[id]; section.Departure == departure && !section.Cancelled {
+			sections = append(sections, section)
+		}
+	}
+	if len(sections) == 0 {
+		return nil, errors.New("No sections depart at " + departure)
+	}
+	return sections, nil
+}
+
+// rebookingCandidates lists the sections a passenger from a cancelled section may be moved
+// to: those departing no earlier than it, earliest first, preferring the same class on each
+// departure. Callers hold t.mu.
+func (t *trainServer) rebookingCandidates(from *pb.Section, policy pb.RebookingPolicy, disrupted map[string]bool) []*pb.Section {
+	if policy == pb.RebookingPolicy_REFUND_ONLY {
+		return nil
+	}
+	departure, _ := time.Parse(time.RFC3339, from.Departure)
+	candidates := []*pb.Section{}
+	for _, id := range t.sortedSectionIDs() {
+		section := t.sections[id]
+		if disrupted[id] || section.Cancelled || (policy == pb.RebookingPolicy_SAME_CLASS && section.Class != from.Class) {
+			continue
+		}
+		if from.Departure != "" {
+			next, err := time.Parse(time.RFC3339, section.Departure)
+			if err != nil || next.Before(departure) {
+				continue
+			}
+		}
+		candidates = append(candidates, section)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Departure != candidates[j].Departure {
+			a, _ := time.Parse(time.RFC3339, candidates[i].Departure)
+			b, _ := time.Parse(time.RFC3339, candidates[j].Departure)
+			return a.Before(b)
+		}
+		return candidates[i].Class == from.Class && candidates[j].Class != from.Class
+	})
+	return candidates
+}
+
+// routeKey identifies a journey regardless of case.
+func routeKey(from, to string) string {
+	return strings.ToLower(strings.TrimSpace(from)) + "\x00" + strings.ToLower(strings.TrimSpace(to))
+}
+
+// bookedRoutes lists the journeys booked on each departure outside the disrupted sections,
+// which is all that is known of the route a train runs. Callers hold t.mu.
+func (t *trainServer) bookedRoutes(disrupted map[string]bool) map[string]map[string]bool {
+	routes := map[string]map[string]bool{}
+	for _, ticket := range t.tickets {
+		section, ok := t.sections[ticket.Section]
+		if !ok || disrupted[section.SectionID] {
+			continue
+		}
+		if routes[section.Departure] == nil {
+			routes[section.Departure] = map[string]bool{}
+		}
+		routes[section.Departure][routeKey(ticket.From, ticket.To)] = true
+	}
+	return routes
+}
+
+// onRoute keeps the candidate sections whose departure serves the ticket's journey: those
+// with passengers booked on the same journey, or with no passengers yet.
+func onRoute(candidates []*pb.Section, ticket *pb.Ticket, routes map[string]map[string]bool) []*pb.Section {
+	key := routeKey(ticket.From, ticket.To)
+	kept := []*pb.Section{}
+	for _, section := range candidates {
+		if booked := routes[section.Departure]; len(booked) == 0 || booked[key] {
+			kept = append(kept, section)
+		}
+	}
+	return kept
+}
+
+// ReportDisruption records that a train run or a section is delayed or cancelled. A delay
+// moves the departure; a cancellation withdraws the sections from sale and rebooks each
+// passenger onto the next suitable departure according to the policy, refunding those that
+// can not be placed. Rebooked passengers keep the fare they paid and its invoice, even when
+// moved to another class, and are only moved to departures serving their journey. Each passenger gets one notification of the outcome.
+func (t *trainServer) ReportDisruption(ctx context.Context, req *pb.DisruptionRequest) (*pb.DisruptionReport, error) {
+	if _, ok := pb.DisruptionType_name[int32(req.Type)]; !ok {
+		return nil, errors.New("Invalid disruption type")
+	} else if _, ok := pb.RebookingPolicy_name[int32(req.Policy)]; !ok {
+		return nil, errors.New("Invalid rebooking policy")
+	} else if req.Type == pb.DisruptionType_DELAY && req.DelayMinutes <= 0 {
+		return nil, errors.New("Delay minutes must be greater than 0")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sections, err := t.disruptedSections(req)
+	if err != nil {
+		return nil, err
+	}
+	reason := strings.TrimSpace(req.Reason)
+	report := &pb.DisruptionReport{Type: req.Type, Reason: reason, Sections: sections}
+	disrupted := map[string]bool{}
+	for _, section := range sections {
+		if req.Type == pb.DisruptionType_DELAY && section.Departure == "" {
+			return nil, errors.New("Section " + section.Section + " has no departure to delay")
+		}
+		disrupted[section.SectionID] = true
+	}
+
+	now := time.Now()
+	routes := t.bookedRoutes(disrupted)
+	for _, section := range sections {
+		affected := t.ticketsInSection(section.SectionID, func(int32) bool { return true })
+		// Passengers with an accessibility need pick first so reserved seats go to them.
+		sort.SliceStable(affected, func(i, j int) bool { return affected[i].AccessibilityNeed && !affected[j].AccessibilityNeed })
+		// The section is disrupted before its passengers are told and moved.
+		scheduled := section.Departure
+		disruptedSection := proto.Clone(section).(*pb.Section)
+		if req.Type == pb.DisruptionType_DELAY {
+			departure, _ := time.Parse(time.RFC3339, scheduled)
+			disruptedSection.Departure = departure.Add(time.Duration(req.DelayMinutes) * time.Minute).Format(time.RFC3339)
+			disruptedSection.DelayMinutes += req.DelayMinutes
+		} else {
+			disruptedSection.Cancelled = true
+		}
+		disruptedSection.DisruptionReason = reason
+		disruptedSection.ModifiedOn = now.String()
+		disruptedSection.Version++
+		t.recordSectionEvent(sectionDisrupted, disruptedSection)
+
+		if req.Type == pb.DisruptionType_DELAY {
+			detail := fmt.Sprintf("Departure is delayed by %d minutes to %s.", req.DelayMinutes, section.Departure)
+			for _, ticket := range affected {
+				entry := t.disruptionEntry(ticket, section)
+				entry.Outcome = pb.DisruptionOutcome_DELAYED
+				entry.FromDeparture = scheduled
+				entry.ToSection, entry.ToSeat, entry.ToDeparture = section.SectionID, ticket.SeatNumber, section.Departure
+				report.Passengers = append(report.Passengers, entry)
+				report.Delayed++
+				t.notify(t.newBookingEvent(eventDisrupted, ticket, disruptionDetail(detail, reason)))
+			}
+		} else {
+			candidates := t.rebookingCandidates(section, req.Policy, disrupted)
+			for _, ticket := range affected {
+				entry := t.disruptionEntry(ticket, section)
+				var detail string
+				var refund *pb.Money
+				if to, ok := t.rebook(ticket, onRoute(candidates, ticket, routes), now); ok {
+					entry.Outcome = pb.DisruptionOutcome_REBOOKED
+					entry.ToSection, entry.ToSeat, entry.ToDeparture = to.SectionID, ticket.SeatNumber, to.Departure
+					report.Rebooked++
+					detail = fmt.Sprintf("Your departure has been cancelled. You have been moved to section %s, seat %d", to.Section, ticket.SeatNumber)
+					if to.Departure != "" {
+						detail += ", departing " + to.Departure
+					}
+					detail += "."
+				} else {
+					entry.Outcome = pb.DisruptionOutcome_REFUNDED
+					refund = t.removeTicket(ticket)
+					entry.Refund = refund
+					report.Refunded++
+					detail = "Your departure has been cancelled and no other seat was available, so your ticket has been refunded."
+				}
+				report.Passengers = append(report.Passengers, entry)
+				disruption := t.newBookingEvent(eventDisrupted, ticket, disruptionDetail(detail, reason))
+				disruption.Refund = refund
+				t.notify(disruption)
+			}
+			t.releaseHolds(func(h *pb.Hold) bool { return h.Section == section.SectionID }, now)
+			t.refreshSectionAvailability(section, now)
+		}
+		t.publishSectionEvent(eventSectionDisrupted, section)
+	}
+	return report, nil
+}
+
+// disruptionDetail adds the operator's reason, if any, to a passenger message.
+func disruptionDetail(detail, reason string) string {
+	if reason == "" {
+		return detail
+	}
+	return detail + " Reason: " + reason + "."
+}
+
+// disruptionEntry starts a report line for a ticket in a disrupted section. Callers hold t.mu.
+func (t *trainServer) disruptionEntry(ticket *pb.Ticket, section *pb.Section) *pb.PassengerDisruption {
+	entry := &pb.PassengerDisruption{
+		TicketId:      ticket.TicketId,
+		UserID:        ticket.UserID,
+		Passenger:     t.passengerName(ticket.UserID),
+		FromSection:   ticket.Section,
+		FromSeat:      ticket.SeatNumber,
+		FromDeparture: section.Departure,
+	}
+	if user, ok := t.users[ticket.UserID]; ok {
+		entry.Email = user.Email
+	}
+	return entry
+}
+
+// rebook moves a ticket to the first candidate section with a suitable free seat. The
+// ticket must be boarded again on its new train. Callers hold t.mu.
+func (t *trainServer) rebook(ticket *pb.Ticket, candidates []*pb.Section, now time.Time) (*pb.Section, bool) {
+	for _, section := range candidates {
+		ref, ok := t.pickSeat(section, ticket.AccessibilityNeed, ticket.CompanionSeat != 0, section.TotalSeats, now, nil, ticket.UserID)
+		if !ok {
+			continue
+		}
+		t.moveTicket(ticket, ref, true)
+		return section, true
+	}
+	return nil, false
+}
@@ -0,0 +1,139 @@
+// dynamicpricing.go
+
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// pricingStrategy adjusts fares for the state of a section at the time of sale.
+type pricingStrategy interface {
+	// multiplier returns the factor to apply to the base fare of a seat in section, which has
+	// sold seats booked, and a description for the fare breakdown. The unchanged factor leaves
+	// the fare as it is.
+	multiplier(section *pb.Section, sold int32, now time.Time) (factor, string)
+}
+
+// unchanged is the factor of 1, leaving a fare as it is.
+const unchanged factor = 10000
+
+// occupancyBand raises fares once a section is at least MinOccupancy (0 to 1) full.
+type occupancyBand struct {
+	MinOccupancy float64 `json:"min_occupancy"`
+	Multiplier   factor  `json:"multiplier"`
+}
+
+// departureBand adjusts fares for seats sold within the given time of departure.
+type departureBand struct {
+	Within     duration `json:"within"`
+	Multiplier factor   `json:"multiplier"`
+}
+
+// occupancyStrategy applies the highest band the section's occupancy has reached. Only sold
+// seats count, so seats that are blocked or held do not raise the price.
+type occupancyStrategy struct {
+	bands []occupancyBand // highest MinOccupancy first
+}
+
+func newOccupancyStrategy(bands []occupancyBand) occupancyStrategy {
+	sorted := append([]occupancyBand{}, bands...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].MinOccupancy > sorted[j].MinOccupancy })
+	return occupancyStrategy{bands: sorted}
+}
+
+func (s occupancyStrategy) multiplier(section *pb.Section, sold int32, now time.Time) (factor, string) {
+	if section.TotalSeats == 0 {
+		return unchanged, ""
+	}
+	occupancy := float64(sold) / float64(section.TotalSeats)
+	for _, band := range s.bands {
+		if occupancy >= band.MinOccupancy {
+			return band.Multiplier, fmt.Sprintf("Demand pricing (%.0f%% full, x%s)", occupancy*100, band.Multiplier)
+		}
+	}
+	return unchanged, ""
+}
+
+// departureStrategy applies the tightest band that departure falls within. Sections
+// without a departure time are priced normally.
+type departureStrategy struct {
+	bands []departureBand // shortest Within first
+}
+
+func newDepartureStrategy(bands []departureBand) departureStrategy {
+	sorted := append([]departureBand{}, bands...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Within < sorted[j].Within })
+	return departureStrategy{bands: sorted}
+}
+
+func (s departureStrategy) multiplier(section *pb.Section, sold int32, now time.Time) (factor, string) {
+	departure, err := time.Parse(time.RFC3339, section.Departure)
+	if err != nil {
+		return unchanged, ""
+	}
+	remaining := departure.Sub(now)
+	for _, band := range s.bands {
+		if remaining <= time.Duration(band.Within) {
+			return band.Multiplier, fmt.Sprintf("Departure pricing (within %s, x%s)", time.Duration(band.Within), band.Multiplier)
+		}
+	}
+	return unchanged, ""
+}
+
+// pricingRule selects the journeys a strategy applies to; empty fields match anything.
+type pricingRule struct {
+	From      string          `json:"from"`
+	To        string          `json:"to"`
+	Class     string          `json:"class"`
+	Occupancy []occupancyBand `json:"occupancy_bands"`
+	Departure []departureBand `json:"departure_bands"`
+}
+
+// routePricing is a pricingRule resolved for use by the server.
+type routePricing struct {
+	from, to string
+	class    string // SeatClass name, empty for every class
+	strategy pricingStrategy
+}
+
+func (r routePricing) matches(req *pb.TicketRequest) bool {
+	return (r.from == "" || strings.EqualFold(r.from, strings.TrimSpace(req.From))) &&
+		(r.to == "" || strings.EqualFold(r.to, strings.TrimSpace(req.To))) &&
+		(r.class == "" || r.class == req.Class.String())
+}
+
+// applyDynamicPricing adds a surcharge or discount line for every strategy matching the
+// journey. Each is relative to the base fare so the order of rules does not matter.
+// Callers hold t.mu.
+func (t *trainServer) applyDynamicPricing(quote *fareQuote, req *pb.TicketRequest, section *pb.Section, now time.Time) {
+	base := quote.total
+	sold := t.soldSeats(section.SectionID)
+	for _, rule := range t.pricing {
+		if !rule.matches(req) {
+			continue
+		}
+		if m, description := rule.strategy.multiplier(section, sold, now); m != unchanged {
+			quote.add(description, basisPoints(m-unchanged).of(base))
+		}
+	}
+}
+
+// soldSeats counts the seats of a section booked by tickets, companion seats included.
+// Callers hold t.mu.
+func (t *trainServer) soldSeats(sectionID string) int32 {
+	sold := int32(0)
+	for _, ticket := range t.tickets {
+		if ticket.Section == sectionID {
+			sold++
+			if ticket.CompanionSeat != 0 {
+				sold++
+			}
+		}
+	}
+	return sold
+}
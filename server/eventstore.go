@@ -0,0 +1,412 @@
+// eventstore.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// bookingProjection is the booking state derived from the event log: the users, sections and
+// tickets as of the last applied event, and the seats allocated to the tickets. The server's
+// live projection is the booking state it serves, changed only by recording events; it keeps
+// its own copies of the payloads and updates them in place, so handlers can go on using the
+// values they looked up. Projections rebuilt for time-travel queries share the payloads,
+// which are never modified once recorded.
+type bookingProjection struct {
+	sequence       int64
+	occurredOn     string // of the event at sequence
+	live           bool
+	users          map[string]*pb.User
+	sections       map[string]*pb.Section
+	tickets        map[string]*pb.Ticket // by user id
+	allocatedSeats map[string]string     // user id by seat key
+	ticketSeats    map[string][]string   // seat keys of each user's ticket
+}
+
+func newBookingProjection() *bookingProjection {
+	return &bookingProjection{
+		users:          make(map[string]*pb.User),
+		sections:       make(map[string]*pb.Section),
+		tickets:        make(map[string]*pb.Ticket),
+		allocatedSeats: make(map[string]string),
+		ticketSeats:    make(map[string][]string),
+	}
+}
+
+// keep returns the value a projection holds for an event payload: the payload itself, or for
+// the live projection a copy, written over the current value when there is one.
+func keep[M proto.Message](p *bookingProjection, current M, found bool, payload M) M {
+	if !p.live {
+		return payload
+	}
+	if found {
+		proto.Reset(current)
+		proto.Merge(current, payload)
+		return current
+	}
+	return proto.Clone(payload).(M)
+}
+
+// apply folds one event into the projection.
+func (p *bookingProjection) apply(e *pb.DomainEvent) {
+	p.sequence, p.occurredOn = e.Sequence, e.OccurredOn
+	switch e.Type {
+	case domainUserCreated, domainUserUpdated:
+		user, ok := p.users[e.User.UserID]
+		p.users[e.User.UserID] = keep(p, user, ok, e.User)
+	case domainUserRemoved:
+		delete(p.users, e.User.UserID)
+	case domainTicketPurchased, domainSeatModified, domainTicketBoarded, domainBoardingPassReissued:
+		userID := e.Ticket.UserID
+		p.releaseSeats(userID)
+		ticket, ok := p.tickets[userID]
+		p.tickets[userID] = keep(p, ticket, ok, e.Ticket)
+		p.allocateSeats(e.Ticket)
+	case domainTicketCancelled:
+		p.releaseSeats(e.Ticket.UserID)
+		delete(p.tickets, e.Ticket.UserID)
+	case domainSectionChanged:
+		if e.Change == sectionDeleted {
+			delete(p.sections, e.Section.SectionID)
+		} else {
+			section, ok := p.sections[e.Section.SectionID]
+			p.sections[e.Section.SectionID] = keep(p, section, ok, e.Section)
+		}
+	}
+}
+
+// allocateSeats allocates a ticket's seat and companion seat to its holder.
+func (p *bookingProjection) allocateSeats(ticket *pb.Ticket) {
+	p.ticketSeats[ticket.UserID] = []string{seatKey(ticket.Section, ticket.SeatNumber)}
+	if ticket.CompanionSeat != 0 {
+		p.ticketSeats[ticket.UserID] = append(p.ticketSeats[ticket.UserID], seatKey(ticket.Section, ticket.CompanionSeat))
+	}
+	for _, key := range p.ticketSeats[ticket.UserID] {
+		p.allocatedSeats[key] = ticket.UserID
+	}
+}
+
+// releaseSeats frees the seats allocated to a user's ticket.
+func (p *bookingProjection) releaseSeats(userID string) {
+	for _, key := range p.ticketSeats[userID] {
+		if p.allocatedSeats[key] == userID {
+			delete(p.allocatedSeats, key)
+		}
+	}
+	delete(p.ticketSeats, userID)
+}
+
+// clone copies a projection that is not live. Copies of the live projection get their own
+// values, as those keep changing; other projections share them.
+func (p *bookingProjection) clone() *bookingProjection {
+	c := newBookingProjection()
+	c.sequence, c.occurredOn = p.sequence, p.occurredOn
+	for k, v := range p.users {
+		c.users[k] = v
+		if p.live {
+			c.users[k] = proto.Clone(v).(*pb.User)
+		}
+	}
+	for k, v := range p.sections {
+		c.sections[k] = v
+		if p.live {
+			c.sections[k] = proto.Clone(v).(*pb.Section)
+		}
+	}
+	for k, v := range p.tickets {
+		c.tickets[k] = v
+		if p.live {
+			c.tickets[k] = proto.Clone(v).(*pb.Ticket)
+		}
+	}
+	for k, v := range p.allocatedSeats {
+		c.allocatedSeats[k] = v
+	}
+	for k, v := range p.ticketSeats {
+		c.ticketSeats[k] = v
+	}
+	return c
+}
+
+// projectionFromSnapshot builds a projection holding the values of a stored snapshot.
+func projectionFromSnapshot(snapshot *pb.BookingSnapshot) *bookingProjection {
+	p := newBookingProjection()
+	p.sequence, p.occurredOn = snapshot.Sequence, snapshot.OccurredOn
+	for _, user := range snapshot.Users {
+		p.users[user.UserID] = user
+	}
+	for _, section := range snapshot.Sections {
+		p.sections[section.SectionID] = section
+	}
+	for _, ticket := range snapshot.Tickets {
+		p.tickets[ticket.UserID] = ticket
+		p.allocateSeats(ticket)
+	}
+	return p
+}
+
+// snapshotRecords stores the projection as records of at most snapshotChunk users, sections
+// and tickets each, to be restored in place of the events it was built from.
+func (p *bookingProjection) snapshotRecords() []*pb.WALRecord {
+	records := []*pb.WALRecord{}
+	n := 0
+	next := func() *pb.BookingSnapshot {
+		if n%snapshotChunk == 0 {
+			records = append(records, &pb.WALRecord{Base: &pb.BookingSnapshot{Sequence: p.sequence, OccurredOn: p.occurredOn}})
+		}
+		n++
+		return records[len(records)-1].Base
+	}
+	for _, user := range p.users {
+		base := next()
+		base.Users = append(base.Users, user)
+	}
+	for _, section := range p.sections {
+		base := next()
+		base.Sections = append(base.Sections, section)
+	}
+	for _, ticket := range p.tickets {
+		base := next()
+		base.Tickets = append(base.Tickets, ticket)
+	}
+	if len(records) == 0 {
+		records = append(records, &pb.WALRecord{Base: &pb.BookingSnapshot{Sequence: p.sequence, OccurredOn: p.occurredOn}})
+	}
+	return records
+}
+
+// sectionView copies a section with its seats available according to the projection.
+func (p *bookingProjection) sectionView(section *pb.Section) *pb.Section {
+	view := proto.Clone(section).(*pb.Section)
+	view.AvailableSeats = 0
+	for seat := int32(1); seat <= section.TotalSeats && !section.Cancelled; seat++ {
+		if _, allocated := p.allocatedSeats[seatKey(section.SectionID, seat)]; !allocated {
+			view.AvailableSeats++
+		}
+	}
+	return view
+}
+
+// maxSnapshots bounds the projection snapshots kept in memory for time-travel queries.
+const maxSnapshots = 64
+
+// applyEvent folds a newly recorded event into the live projection and snapshots the
+// projection every snapshotInterval events. When there are more than maxSnapshots, every other
+// one is dropped, so older history is replayed from further back. Callers hold t.mu for writing.
+func (t *trainServer) applyEvent(e *pb.DomainEvent) {
+	t.bookingProjection.apply(e)
+	if t.snapshotInterval <= 0 || e.Sequence%int64(t.snapshotInterval) != 0 {
+		return
+	}
+	t.snapshots = append(t.snapshots, t.bookingProjection.clone())
+	if len(t.snapshots) > maxSnapshots {
+		kept := t.snapshots[:0]
+		for i := 1; i < len(t.snapshots); i += 2 {
+			kept = append(kept, t.snapshots[i])
+		}
+		t.snapshots = kept
+	}
+}
+
+// projectionAt rebuilds the projection as it was right after the event with the given
+// sequence, replaying from the nearest earlier snapshot. Callers hold t.mu.
+func (t *trainServer) projectionAt(sequence int64) *bookingProjection {
+	if sequence == t.latestSequence() {
+		return t.bookingProjection
+	}
+	i := sort.Search(len(t.snapshots), func(i int) bool { return t.snapshots[i].sequence > sequence })
+	p := t.baseProjection.clone()
+	if i > 0 {
+		p = t.snapshots[i-1].clone()
+	}
+	for _, e := range t.outbox[p.sequence-t.outboxBase : sequence-t.outboxBase] {
+		p.apply(e)
+	}
+	return p
+}
+
+// latestSequence is the sequence of the last event recorded. Callers hold t.mu.
+func (t *trainServer) latestSequence() int64 {
+	return t.outboxBase + int64(len(t.outbox))
+}
+
+// trimOutbox drops the durable events older than the latest retainEvents, folding them into
+// the base projection the retained events start from, along with the snapshots they cover.
+// It waits until a tenth of retainEvents can go, so the outbox is not copied for every event.
+// Callers hold t.mu for writing.
+func (t *trainServer) trimOutbox() {
+	base := min(t.durableSequence(), t.latestSequence()-int64(t.retainEvents))
+	if t.retainEvents <= 0 || base-t.outboxBase <= int64(t.retainEvents/10) {
+		return
+	}
+	t.baseProjection = t.projectionAt(base)
+	t.outbox = slices.Clone(t.outbox[base-t.outboxBase:])
+	t.outboxBase = base
+	i := sort.Search(len(t.snapshots), func(i int) bool { return t.snapshots[i].sequence > base })
+	t.snapshots = slices.Clone(t.snapshots[i:])
+}
+
+// errNotRetained reports a request for events that have been trimmed from the outbox.
+func (t *trainServer) errNotRetained() error {
+	return status.Errorf(codes.OutOfRange, "Events up to sequence %d are no longer retained", t.outboxBase)
+}
+
+// sequenceAt returns the sequence of the last event recorded at or before at. Callers hold t.mu.
+func (t *trainServer) sequenceAt(at time.Time) int64 {
+	return t.outboxBase + int64(sort.Search(len(t.outbox), func(i int) bool {
+		occurred, _ := time.Parse(time.RFC3339Nano, t.outbox[i].OccurredOn)
+		return occurred.After(at)
+	}))
+}
+
+// resolveSequence turns a time-travel request into an event sequence. Callers hold t.mu.
+func (t *trainServer) resolveSequence(at string, sequence int64) (int64, error) {
+	at = strings.TrimSpace(at)
+	switch {
+	case at != "" && sequence != 0:
+		return 0, errors.New("Provide either at or sequence")
+	case sequence < 0 || sequence > t.latestSequence():
+		return 0, errors.New("Sequence has not been recorded")
+	case sequence > 0 && sequence < t.outboxBase:
+		return 0, t.errNotRetained()
+	case sequence > 0:
+		return sequence, nil
+	case at != "":
+		when, err := time.Parse(time.RFC3339, at)
+		if err != nil {
+			return 0, errors.New("At must be an RFC 3339 time such as 2025-06-01T10:00:00Z")
+		}
+		sequence = t.sequenceAt(when)
+		if occurred, _ := time.Parse(time.RFC3339Nano, t.baseProjection.occurredOn); t.outboxBase > 0 && sequence == t.outboxBase && occurred.After(when) {
+			return 0, t.errNotRetained()
+		}
+		return sequence, nil
+	}
+	return t.latestSequence(), nil
+}
+
+// eventTime reports when the event with the given sequence occurred, empty for 0. Callers hold t.mu.
+func (t *trainServer) eventTime(sequence int64) string {
+	if sequence == t.outboxBase {
+		return t.baseProjection.occurredOn
+	}
+	return t.outbox[sequence-t.outboxBase-1].OccurredOn
+}
+
+// ViewStateAt returns the users, sections and tickets as they were at a point in the past,
+// replayed from the event log.
+func (t *trainServer) ViewStateAt(ctx context.Context, req *pb.StateAtRequest) (*pb.BookingState, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	sequence, err := t.resolveSequence(req.At, req.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	p := t.projectionAt(sequence)
+	state := &pb.BookingState{Sequence: sequence, At: t.eventTime(sequence)}
+	for _, user := range p.users {
+		state.Users = append(state.Users, proto.Clone(user).(*pb.User))
+	}
+	for _, section := range p.sections {
+		state.Sections = append(state.Sections, p.sectionView(section))
+	}
+	for _, ticket := range p.tickets {
+		state.Tickets = append(state.Tickets, proto.Clone(ticket).(*pb.Ticket))
+	}
+	sort.Slice(state.Users, func(i, j int) bool { return state.Users[i].UserID < state.Users[j].UserID })
+	sort.Slice(state.Sections, func(i, j int) bool { return state.Sections[i].SectionID < state.Sections[j].SectionID })
+	sort.Slice(state.Tickets, func(i, j int) bool { return state.Tickets[i].TicketId < state.Tickets[j].TicketId })
+	return state, nil
+}
+
+// ViewSeatOccupancy answers who was in a seat, or in every seat of a section, at a given time.
+func (t *trainServer) ViewSeatOccupancy(ctx context.Context, req *pb.SeatOccupancyRequest) (*pb.SeatOccupancy, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	sequence, err := t.resolveSequence(req.At, 0)
+	if err != nil {
+		return nil, err
+	}
+	p := t.projectionAt(sequence)
+	sectionID := strings.TrimSpace(req.SectionID)
+	if _, ok := p.sections[sectionID]; sectionID != "" && !ok {
+		return nil, errors.New("Section did not exist at that time")
+	}
+	occupancy := &pb.SeatOccupancy{Sequence: sequence, At: t.eventTime(sequence)}
+	for _, ticket := range p.tickets {
+		for _, seat := range []int32{ticket.SeatNumber, ticket.CompanionSeat} {
+			if seat == 0 || (sectionID != "" && ticket.Section != sectionID) || (req.SeatNumber != 0 && seat != req.SeatNumber) {
+				continue
+			}
+			occupant := &pb.SeatOccupant{
+				SectionID:  ticket.Section,
+				SeatNumber: seat,
+				UserID:     ticket.UserID,
+				TicketId:   ticket.TicketId,
+				Companion:  seat == ticket.CompanionSeat,
+			}
+			if section, ok := p.sections[ticket.Section]; ok {
+				occupant.Section = section.Section
+			}
+			if user, ok := p.users[ticket.UserID]; ok {
+				occupant.Passenger = user.FirstName + " " + user.LastName
+			}
+			occupancy.Occupants = append(occupancy.Occupants, occupant)
+		}
+	}
+	sort.Slice(occupancy.Occupants, func(i, j int) bool {
+		a, b := occupancy.Occupants[i], occupancy.Occupants[j]
+		if a.SectionID != b.SectionID {
+			return a.SectionID < b.SectionID
+		}
+		return a.SeatNumber < b.SeatNumber
+	})
+	return occupancy, nil
+}
+
+// replayLog rebuilds the users, sections, tickets and seat allocations of a new server by
+// replaying an event log into its live projection, starting from the stored base snapshot of
+// the events no longer retained, if any, and keeps the log so that sequences carry on from it.
+// State the log does not describe, such as holds, seat blocks and the loyalty ledger, is
+// restored separately. Callers hold t.mu.
+func (t *trainServer) replayLog(base *pb.BookingSnapshot, events []*pb.DomainEvent) error {
+	if t.latestSequence() > 0 {
+		return errors.New("Event log can only be replayed into an empty server")
+	}
+	if base != nil {
+		t.baseProjection = projectionFromSnapshot(base)
+		t.bookingProjection = projectionFromSnapshot(proto.Clone(base).(*pb.BookingSnapshot))
+		t.bookingProjection.live = true
+		t.outboxBase = base.Sequence
+	}
+	for _, e := range events {
+		if e.Sequence != t.latestSequence()+1 {
+			return fmt.Errorf("Event log has a gap at sequence %d", t.latestSequence()+1)
+		}
+		t.outbox = append(t.outbox, e)
+		t.applyEvent(e)
+	}
+	now := time.Now()
+	for id, section := range t.sections {
+		seats := []int32{}
+		for seat := int32(1); seat <= section.TotalSeats; seat++ {
+			seats = append(seats, seat)
+		}
+		t.seats[id] = seats
+		t.refreshSectionAvailability(section, now)
+	}
+	t.trimOutbox()
+	return nil
+}
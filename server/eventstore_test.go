@@ -0,0 +1,222 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+func TestEventStore(t *testing.T) {
+	t.Run("Replay", testEventStoreReplay)
+	t.Run("TimeTravel", testEventStoreTimeTravel)
+	t.Run("Snapshots", testEventStoreSnapshots)
+}
+
+// checkSameBookings fails unless both servers hold the same users, sections, tickets and seats.
+func checkSameBookings(t *testing.T, want, got *trainServer) {
+	t.Helper()
+	same := func(name string, a, b map[string]proto.Message) {
+		if len(a) != len(b) {
+			t.Errorf("Expected %d %s, got %d", len(a), name, len(b))
+		}
+		for id, v := range a {
+			if !proto.Equal(v, b[id]) {
+				t.Errorf("Expected %s %s to be\n%v\ngot\n%v", name, id, v, b[id])
+			}
+		}
+	}
+	messages := func(m any) map[string]proto.Message {
+		out := map[string]proto.Message{}
+		switch m := m.(type) {
+		case map[string]*pb.User:
+			for k, v := range m {
+				out[k] = v
+			}
+		case map[string]*pb.Section:
+			for k, v := range m {
+				out[k] = v
+			}
+		case map[string]*pb.Ticket:
+			for k, v := range m {
+				out[k] = v
+			}
+		}
+		return out
+	}
+	same("users", messages(want.users), messages(got.users))
+	same("sections", messages(want.sections), messages(got.sections))
+	same("tickets", messages(want.tickets), messages(got.tickets))
+	if len(want.allocatedSeats) != len(got.allocatedSeats) {
+		t.Errorf("Expected %d allocated seats, got %d", len(want.allocatedSeats), len(got.allocatedSeats))
+	}
+	for key, userID := range want.allocatedSeats {
+		if got.allocatedSeats[key] != userID {
+			t.Errorf("Expected seat %s allocated to %s, got %q", key, userID, got.allocatedSeats[key])
+		}
+	}
+}
+
+func testEventStoreReplay(t *testing.T) {
+	s := setupTestServer()
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	other := createPassenger(t, s, "other@gmail.com")
+	leaving := createPassenger(t, s, "leaving@gmail.com")
+	ticket, _ := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: other.UserID, PricePaid: usd(1000)})
+	s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: user.UserID, Section: section.SectionID, SeatNumber: 5, Version: ticket.Version})
+	renamed := proto.Clone(user).(*pb.User)
+	renamed.FirstName = "Amit"
+	if _, err := s.ModifyUser(context.Background(), renamed); err != nil {
+		t.Fatalf("ModifyUser failed: %v", err)
+	}
+	pass, _ := s.ViewReceipt(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	s.Board(context.Background(), &pb.BoardRequest{Code: pass.BoardingPass, From: "Location 1", To: "Location 2"})
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: other.UserID})
+	s.RemoveUser(context.Background(), &pb.UseRequest{UserID: leaving.UserID})
+	s.ResizeSection(context.Background(), &pb.ResizeSectionRequest{SectionID: section.SectionID, TotalSeats: 8, Version: 1})
+	delayed, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "C", TotalSeats: 4, Departure: "2030-01-01T10:00:00Z"})
+	if _, err := s.ReportDisruption(context.Background(), &pb.DisruptionRequest{SectionID: delayed.SectionID, Type: pb.DisruptionType_DELAY, DelayMinutes: 30}); err != nil {
+		t.Fatalf("ReportDisruption failed: %v", err)
+	}
+
+	recorded := map[string]bool{}
+	for _, e := range s.outbox {
+		recorded[e.Type+e.Change] = true
+	}
+	for _, want := range []string{"TicketBoarded", "BoardingPassReissued", "SectionChangedresized", "SectionChangeddisrupted", "UserRemoved"} {
+		if !recorded[want] {
+			t.Fatalf("Expected a %s event in the log", want)
+		}
+	}
+
+	restarted := setupTestServer()
+	restarted.mu.Lock()
+	err := restarted.replayLog(nil, s.outbox)
+	restarted.mu.Unlock()
+	if err != nil {
+		t.Fatalf("replayLog failed: %v", err)
+	}
+	checkSameBookings(t, s, restarted)
+
+	// The restored server carries on from the log.
+	newcomer := createPassenger(t, restarted, "newcomer@gmail.com")
+	if _, err := restarted.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: newcomer.UserID, PricePaid: usd(1000)}); err != nil {
+		t.Fatalf("PurchaseTicket after replay failed: %v", err)
+	}
+	if n := len(restarted.outbox); restarted.outbox[n-1].Sequence != int64(len(s.outbox))+2 {
+		t.Errorf("Expected sequences to continue after the replayed log")
+	}
+	if restarted.allocatedSeats[seatKey(section.SectionID, 5)] != user.UserID {
+		t.Errorf("Expected the replayed seat to stay taken")
+	}
+	if err := restarted.replayLog(nil, s.outbox); err == nil {
+		t.Errorf("Expected replaying into a server with events to fail")
+	}
+}
+func testEventStoreTimeTravel(t *testing.T) {
+	s := setupTestServer()
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 20})
+	alice := createPassenger(t, s, "alice@gmail.com")
+	bob := createPassenger(t, s, "bob@gmail.com")
+	ticket, _ := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: alice.UserID, PricePaid: usd(1000)})
+	beforeMove := time.Now().Format(time.RFC3339Nano)
+	s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: alice.UserID, Section: section.SectionID, SeatNumber: 12, Version: ticket.Version})
+	afterMove := time.Now().Format(time.RFC3339Nano)
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: bob.UserID, PricePaid: usd(1000)})
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: alice.UserID})
+
+	tests := []struct {
+		name string
+		at   string
+		seat int32
+		want string
+	}{
+		{"SeatOneBeforeMove", beforeMove, 1, alice.UserID},
+		{"SeatTwelveBeforeMove", beforeMove, 12, ""},
+		{"SeatTwelveAfterMove", afterMove, 12, alice.UserID},
+		{"SeatTwelveAfterCancel", "", 12, ""},
+		{"SeatOneNow", "", 1, bob.UserID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			occupancy, err := s.ViewSeatOccupancy(context.Background(), &pb.SeatOccupancyRequest{At: tt.at, SectionID: section.SectionID, SeatNumber: tt.seat})
+			if err != nil {
+				t.Fatalf("ViewSeatOccupancy failed: %v", err)
+			}
+			got := ""
+			if len(occupancy.Occupants) == 1 {
+				got = occupancy.Occupants[0].UserID
+			}
+			if got != tt.want || len(occupancy.Occupants) > 1 {
+				t.Errorf("Expected seat %d occupied by %q, got %v", tt.seat, tt.want, occupancy.Occupants)
+			}
+		})
+	}
+
+	state, err := s.ViewStateAt(context.Background(), &pb.StateAtRequest{At: afterMove})
+	if err != nil {
+		t.Fatalf("ViewStateAt failed: %v", err)
+	}
+	if len(state.Tickets) != 1 || state.Tickets[0].SeatNumber != 12 || state.Sequence != 5 || len(state.Users) != 2 || state.Sections[0].AvailableSeats != 19 {
+		t.Errorf("Expected alice alone in seat 12 after event 5, got %v", state)
+	}
+	if early, _ := s.ViewStateAt(context.Background(), &pb.StateAtRequest{At: "2000-01-01T00:00:00Z"}); early.Sequence != 0 || len(early.Sections) != 0 {
+		t.Errorf("Expected nothing before the first event, got %v", early)
+	}
+	if _, err := s.ViewStateAt(context.Background(), &pb.StateAtRequest{At: afterMove, Sequence: 2}); err == nil {
+		t.Errorf("Expected an error when both at and sequence are given")
+	}
+	if _, err := s.ViewSeatOccupancy(context.Background(), &pb.SeatOccupancyRequest{At: "yesterday"}); err == nil {
+		t.Errorf("Expected an error for an invalid time")
+	}
+}
+func testEventStoreSnapshots(t *testing.T) {
+	s := setupTestServer()
+	s.snapshotInterval = 3
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	for _, email := range []string{"a@gmail.com", "b@gmail.com", "c@gmail.com", "d@gmail.com"} {
+		user := createPassenger(t, s, email)
+		s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	}
+	if len(s.outbox) != 9 || len(s.snapshots) != 3 || s.snapshots[1].sequence != 6 {
+		t.Fatalf("Expected snapshots after events 3, 6 and 9, got %d for %d events", len(s.snapshots), len(s.outbox))
+	}
+	for sequence := int64(1); sequence <= 9; sequence++ {
+		replayed := newBookingProjection()
+		for _, e := range s.outbox[:sequence] {
+			replayed.apply(e)
+		}
+		state, err := s.ViewStateAt(context.Background(), &pb.StateAtRequest{Sequence: sequence})
+		if err != nil {
+			t.Fatalf("ViewStateAt(%d) failed: %v", sequence, err)
+		}
+		if len(state.Users) != len(replayed.users) || len(state.Tickets) != len(replayed.tickets) {
+			t.Errorf("Expected the state after event %d to match a full replay, got %d users and %d tickets", sequence, len(state.Users), len(state.Tickets))
+		}
+	}
+	if _, err := s.ViewStateAt(context.Background(), &pb.StateAtRequest{Sequence: 10}); err == nil {
+		t.Errorf("Expected an error for a sequence not yet recorded")
+	}
+
+	// The live projection is changed in place, which must not reach the snapshots.
+	ticket := s.outbox[8].Ticket
+	if _, err := s.Board(context.Background(), &pb.BoardRequest{Code: ticket.TicketId, From: "Location 1", To: "Location 2"}); err != nil {
+		t.Fatalf("Board failed: %v", err)
+	}
+	if s.snapshots[2].tickets[ticket.UserID].BoardedAt != "" || s.tickets[ticket.UserID].BoardedAt == "" {
+		t.Errorf("Expected only the live projection to see the boarding")
+	}
+	for len(s.outbox) < s.snapshotInterval*(maxSnapshots+1) {
+		createPassenger(t, s, "more"+strconv.Itoa(len(s.outbox))+"@gmail.com")
+	}
+	if len(s.snapshots) > maxSnapshots {
+		t.Errorf("Expected at most %d snapshots, got %d", maxSnapshots, len(s.snapshots))
+	}
+}
@@ -0,0 +1,106 @@
+// health.go
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// healthInterval is how often watchHealth checks readiness, and how long one check may take.
+const healthInterval = 5 * time.Second
+
+// notificationsService is the health service name reporting whether notifications are being
+// delivered. It is kept out of readiness, so a slow mail or webhook endpoint is reported
+// without taking every replica out of the load balancer.
+const notificationsService = "train_ticketing.Notifications"
+
+// ready returns why the server can not serve requests, nil if it can: its store must not have
+// stopped and its backend must be able to store records.
+func (t *trainServer) ready(ctx context.Context) error {
+	if t.store != nil {
+		if err := t.store.broken(); err != nil {
+			return fmt.Errorf("storage has failed: %w", err)
+		}
+		if err := t.store.backend.healthy(ctx); err != nil {
+			return fmt.Errorf("storage: %w", err)
+		}
+	}
+	return nil
+}
+
+// watchHealth publishes readiness and notification health, then checks them every
+// healthInterval and as soon as the store stops, publishing them again whenever they change,
+// until stop is closed.
+func (t *trainServer) watchHealth(h *health.Server, stop <-chan struct{}) {
+	failed := t.storeFailed()
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+	serving, delivering := false, false
+	for checked := false; ; checked = true {
+		if err := t.notifier.healthy(time.Now()); !checked || delivering != (err == nil) {
+			delivering = err == nil
+			h.SetServingStatus(notificationsService, servingStatus(delivering))
+			if err != nil {
+				slog.Warn("notifications are not being delivered", "error", err)
+			} else if checked {
+				slog.Info("notifications are being delivered again")
+			}
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), healthInterval)
+		err := t.ready(ctx)
+		cancel()
+		if !checked || serving != (err == nil) {
+			serving = err == nil
+			setServingStatus(h, serving)
+			if err != nil {
+				slog.Warn("not ready to serve requests", "error", err)
+			} else if checked {
+				slog.Info("ready to serve requests again")
+			}
+		}
+		select {
+		case <-stop:
+			return
+		case <-failed:
+			failed = nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// setServingStatus publishes readiness for both the overall server ("") and the ticketing service.
+func setServingStatus(h *health.Server, ready bool) {
+	h.SetServingStatus("", servingStatus(ready))
+	h.SetServingStatus(pb.TrainTicketing_ServiceDesc.ServiceName, servingStatus(ready))
+}
+
+func servingStatus(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// gracefulStop waits for in-flight RPCs to complete, forcing the server down once timeout elapses.
+func gracefulStop(s *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		slog.Warn("graceful shutdown did not finish in time, forcing stop", "timeout", timeout)
+		s.Stop()
+	}
+}
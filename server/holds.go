@@ -0,0 +1,177 @@
+// holds.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+func holdExpired(h *pb.Hold, now time.Time) bool {
+	expires, err := time.Parse(time.RFC3339Nano, h.ExpiresAt)
+	return err != nil || !now.Before(expires)
+}
+
+// seatHeld reports whether the seat behind key is held by an unexpired hold. Callers hold t.mu.
+func (t *trainServer) seatHeld(key string, now time.Time) bool {
+	h, ok := t.holds[t.heldSeats[key]]
+	return ok && !holdExpired(h, now)
+}
+
+// releaseHold frees a hold's seats without recounting the section. Callers hold t.mu.
+func (t *trainServer) releaseHold(h *pb.Hold) {
+	delete(t.heldSeats, seatKey(h.Section, h.SeatNumber))
+	if h.CompanionSeat != 0 {
+		delete(t.heldSeats, seatKey(h.Section, h.CompanionSeat))
+	}
+	delete(t.holds, h.HoldID)
+	t.touch(tableHold, h.HoldID)
+}
+
+// releaseHolds drops the holds matching drop and recounts their sections. Callers hold t.mu.
+func (t *trainServer) releaseHolds(drop func(h *pb.Hold) bool, now time.Time) {
+	touched := map[string]bool{}
+	for _, h := range t.holds {
+		if drop(h) {
+			t.releaseHold(h)
+			touched[h.Section] = true
+		}
+	}
+	for id := range touched {
+		if section, ok := t.sections[id]; ok {
+			t.refreshSectionAvailability(section, now)
+		}
+	}
+}
+
+// expireHolds puts the seats of lapsed holds back on sale. Callers hold t.mu.
+func (t *trainServer) expireHolds(now time.Time) {
+	t.releaseHolds(func(h *pb.Hold) bool { return holdExpired(h, now) }, now)
+}
+
+// validateTicketRequest checks the journey fields shared by HoldSeat and PurchaseTicket.
+func validateTicketRequest(req *pb.TicketRequest) error {
+	if strings.TrimSpace(req.From) == "" {
+		return errors.New("From can not be blank")
+	} else if strings.TrimSpace(req.To) == "" {
+		return errors.New("To can not be blank")
+	} else if strings.TrimSpace(req.UserID) == "" {
+		return errors.New("UserID can not be blank")
+	} else if req.PricePaid.GetMinorUnits() < 0 {
+		return errors.New("Price paid can not be less than 0")
+	} else if currency := strings.ToUpper(strings.TrimSpace(req.CurrencyCode)); currency != "" && !validCurrency(currency) {
+		return errors.New("Unsupported currency " + currency)
+	} else if strings.TrimSpace(req.From) == strings.TrimSpace(req.To) {
+		return errors.New("From and to can not be same")
+	} else if !validSeatClass(req.Class) {
+		return errors.New("Invalid seat class")
+	} else if req.Companion && !req.AccessibilityNeed {
+		return errors.New("Companion seats are only available with an accessibility need")
+	}
+	return nil
+}
+
+// heldAllocation returns the seat and locked quote of the user's hold after checking that
+// it still matches the request. Callers hold t.mu.
+func (t *trainServer) heldAllocation(req *pb.TicketRequest, now time.Time) (*pb.Hold, seatRef, fareQuote, error) {
+	h, ok := t.holds[strings.TrimSpace(req.HoldID)]
+	if !ok || holdExpired(h, now) {
+		return nil, seatRef{}, fareQuote{}, errors.New("Hold has expired or does not exist")
+	}
+	if h.UserID != strings.TrimSpace(req.UserID) {
+		return nil, seatRef{}, fareQuote{}, errors.New("Hold belongs to another user")
+	}
+	if !strings.EqualFold(h.From, strings.TrimSpace(req.From)) || !strings.EqualFold(h.To, strings.TrimSpace(req.To)) ||
+		h.Class != req.Class || h.AccessibilityNeed != req.AccessibilityNeed || (h.CompanionSeat != 0) != req.Companion ||
+		h.Fare.CurrencyCode != t.payCurrency(req) {
+		return nil, seatRef{}, fareQuote{}, errors.New("Ticket request does not match the hold")
+	}
+	quote := fareQuote{currency: h.Fare.CurrencyCode, total: h.Fare.MinorUnits}
+	for _, line := range h.FareBreakdown {
+		quote.lines = append(quote.lines, proto.Clone(line).(*pb.FareLine))
+	}
+	return h, seatRef{section: h.Section, seat: h.SeatNumber, companion: h.CompanionSeat}, quote, nil
+}
+
+// HoldSeat reserves a seat for the user and locks its quoted fare until the hold expires.
+// Any earlier hold of the user is released.
+func (t *trainServer) HoldSeat(ctx context.Context, req *pb.TicketRequest) (*pb.Hold, error) {
+	if err := validateTicketRequest(req); err != nil {
+		return nil, err
+	}
+	userid := strings.TrimSpace(req.UserID)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.users[userid]; !ok {
+		return nil, errors.New("Invalid user")
+	}
+	if _, ok := t.tickets[userid]; ok {
+		return nil, errors.New("Ticket already book for this user")
+	}
+	now := time.Now()
+	t.releaseHolds(func(h *pb.Hold) bool { return h.UserID == userid }, now)
+	t.refreshAvailability(now)
+	var allocation seatRef
+	found := false
+	for _, id := range t.sortedSectionIDs() {
+		section := t.sections[id]
+		if section.Class == req.Class && section.AvailableSeats > 0 {
+			if allocation, found = t.pickSeat(section, req.AccessibilityNeed, req.Companion, section.TotalSeats, now, nil, userid); found {
+				break
+			}
+		}
+	}
+	if !found {
+		return nil, errors.New("All seats are booked in " + req.Class.String() + " class!")
+	}
+	section := t.sections[allocation.section]
+	quote, err := t.quoteFare(req, section, now)
+	if err != nil {
+		return nil, err
+	}
+	h := &pb.Hold{
+		HoldID:            uuid.NewString(),
+		UserID:            userid,
+		From:              strings.TrimSpace(req.From),
+		To:                strings.TrimSpace(req.To),
+		Class:             req.Class,
+		AccessibilityNeed: req.AccessibilityNeed,
+		Section:           allocation.section,
+		SeatNumber:        allocation.seat,
+		CompanionSeat:     allocation.companion,
+		Fare:              newMoney(quote.currency, quote.total),
+		FareBreakdown:     quote.lines,
+		ExpiresAt:         now.Add(t.holdTTL).Format(time.RFC3339Nano),
+		CreatedOn:         now.String(),
+	}
+	t.holds[h.HoldID] = h
+	t.touch(tableHold, h.HoldID)
+	t.heldSeats[seatKey(h.Section, h.SeatNumber)] = h.HoldID
+	if h.CompanionSeat != 0 {
+		t.heldSeats[seatKey(h.Section, h.CompanionSeat)] = h.HoldID
+	}
+	t.refreshSectionAvailability(section, now)
+	return h, nil
+}
+
+func (t *trainServer) ReleaseHold(ctx context.Context, req *pb.HoldRequest) (*pb.EmptyResponse, error) {
+	holdID := strings.TrimSpace(req.HoldID)
+	if holdID == "" {
+		return nil, errors.New("Provide hold id")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	h, ok := t.holds[holdID]
+	if !ok || h.UserID != strings.TrimSpace(req.UserID) {
+		return nil, errors.New("Invalid hold")
+	}
+	t.releaseHolds(func(other *pb.Hold) bool { return other == h }, time.Now())
+	return &pb.EmptyResponse{}, nil
+}
@@ -0,0 +1,167 @@
+// idempotency.go
+
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// idempotencyMetadataKey carries the idempotency key for clients that cannot set the request field.
+const idempotencyMetadataKey = "idempotency-key"
+
+// idempotentMethods lists the mutations whose responses are replayed for retried keys.
+var idempotentMethods = map[string]bool{
+	pb.TrainTicketing_CreateUser_FullMethodName:     true,
+	pb.TrainTicketing_PurchaseTicket_FullMethodName: true,
+	pb.TrainTicketing_CancelReceipt_FullMethodName:  true,
+	pb.TrainTicketing_ModifySeat_FullMethodName:     true,
+}
+
+// idempotencyStore remembers the first successful response for each key for a fixed window.
+type idempotencyStore struct {
+	window time.Duration
+	now    func() time.Time
+
+	mu        sync.Mutex
+	entries   map[string]*idempotencyEntry
+	nextSweep time.Time
+}
+
+type idempotencyEntry struct {
+	fingerprint [sha256.Size]byte
+	done        chan struct{} // closed once the first request has finished
+	ok          bool
+	resp        proto.Message
+	expires     time.Time
+}
+
+func newIdempotencyStore(window time.Duration) *idempotencyStore {
+	return &idempotencyStore{
+		window:  window,
+		now:     time.Now,
+		entries: make(map[string]*idempotencyEntry),
+	}
+}
+
+// idempotencyKey reads the key from the request field of a mutation, falling back to gRPC metadata.
+func idempotencyKey(ctx context.Context, req proto.Message) string {
+	if r, ok := req.(interface{ GetIdempotencyKey() string }); ok && r.GetIdempotencyKey() != "" {
+		return r.GetIdempotencyKey()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(idempotencyMetadataKey); len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// requestFingerprint hashes the request payload, ignoring the idempotency key itself.
+func requestFingerprint(req proto.Message) ([sha256.Size]byte, error) {
+	m := proto.Clone(req).ProtoReflect()
+	if fd := m.Descriptor().Fields().ByName("IdempotencyKey"); fd != nil {
+		m.Clear(fd)
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m.Interface())
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
+
+// sweep drops expired entries; it runs at most once per minute. Callers hold s.mu.
+func (s *idempotencyStore) sweep(now time.Time) {
+	if now.Before(s.nextSweep) {
+		return
+	}
+	for key, e := range s.entries {
+		if e.ok && now.After(e.expires) {
+			delete(s.entries, key)
+		}
+	}
+	s.nextSweep = now.Add(time.Minute)
+}
+
+func (s *idempotencyStore) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	msg, isProto := req.(proto.Message)
+	if !idempotentMethods[info.FullMethod] || !isProto {
+		return handler(ctx, req)
+	}
+	key := idempotencyKey(ctx, msg)
+	if key == "" {
+		return handler(ctx, req)
+	}
+	fingerprint, err := requestFingerprint(msg)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to fingerprint request: %v", err)
+	}
+	scope := info.FullMethod + "\x00" + key
+	if c, ok := callerFromContext(ctx); ok {
+		scope = c.Subject + "\x00" + scope
+	}
+
+	for {
+		now := s.now()
+		s.mu.Lock()
+		s.sweep(now)
+		e, found := s.entries[scope]
+		if found && e.ok && now.After(e.expires) {
+			delete(s.entries, scope)
+			found = false
+		}
+		if !found {
+			e = &idempotencyEntry{fingerprint: fingerprint, done: make(chan struct{})}
+			s.entries[scope] = e
+			s.mu.Unlock()
+			return s.execute(ctx, req, handler, scope, e)
+		}
+		s.mu.Unlock()
+
+		if e.fingerprint != fingerprint {
+			return nil, status.Error(codes.InvalidArgument, "Idempotency key already used with a different request")
+		}
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		if e.ok {
+			return proto.Clone(e.resp), nil
+		}
+		// The first attempt failed and released the key; try again as the first caller.
+	}
+}
+
+// execute runs the first request for a key. The entry is released and waiters woken even if
+// the handler panics, so retries are not left blocked on a key that will never complete.
+func (s *idempotencyStore) execute(ctx context.Context, req interface{}, handler grpc.UnaryHandler, scope string, e *idempotencyEntry) (resp interface{}, err error) {
+	defer func() {
+		s.mu.Lock()
+		if !e.ok {
+			delete(s.entries, scope)
+		}
+		s.mu.Unlock()
+		close(e.done)
+	}()
+	resp, err = handler(ctx, req)
+	if m, ok := resp.(proto.Message); ok && err == nil {
+		// Responses may alias stored state, so keep a copy of what the client saw.
+		s.mu.Lock()
+		e.ok = true
+		e.resp = proto.Clone(m)
+		e.expires = s.now().Add(s.window)
+		s.mu.Unlock()
+	}
+	return resp, err
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+func TestIdempotency(t *testing.T) {
+	t.Run("ReplaysPurchase", testIdempotencyReplaysPurchase)
+	t.Run("RejectsDifferentPayload", testIdempotencyRejectsDifferentPayload)
+	t.Run("MetadataKeyAndExpiry", testIdempotencyMetadataKeyAndExpiry)
+	t.Run("PanicReleasesKey", testIdempotencyPanicReleasesKey)
+}
+
+func purchaseHandler(s *trainServer, calls *int) grpc.UnaryHandler {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		*calls++
+		return s.PurchaseTicket(ctx, req.(*pb.TicketRequest))
+	}
+}
+
+var purchaseInfo = &grpc.UnaryServerInfo{FullMethod: pb.TrainTicketing_PurchaseTicket_FullMethodName}
+
+func testIdempotencyReplaysPurchase(t *testing.T) {
+	s := setupTestServer()
+	user, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test@gmail.com"})
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	store := newIdempotencyStore(time.Hour)
+	calls := 0
+
+	req := &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000), IdempotencyKey: "k1"}
+	first, err := store.unaryInterceptor(context.Background(), req, purchaseInfo, purchaseHandler(s, &calls))
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	retry, err := store.unaryInterceptor(context.Background(), req, purchaseInfo, purchaseHandler(s, &calls))
+	if err != nil {
+		t.Fatalf("Retried PurchaseTicket failed: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the handler to run once, ran %d times", calls)
+	}
+	if retry.(*pb.Ticket).TicketId != first.(*pb.Ticket).TicketId {
+		t.Errorf("Expected retry to replay the first ticket")
+	}
+}
+func testIdempotencyRejectsDifferentPayload(t *testing.T) {
+	s := setupTestServer()
+	user, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test@gmail.com"})
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	store := newIdempotencyStore(time.Hour)
+	calls := 0
+
+	req := &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000), IdempotencyKey: "k1"}
+	if _, err := store.unaryInterceptor(context.Background(), req, purchaseInfo, purchaseHandler(s, &calls)); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	other := &pb.TicketRequest{From: "Location 1", To: "Location 3", UserID: user.UserID, PricePaid: usd(10000), IdempotencyKey: "k1"}
+	_, err := store.unaryInterceptor(context.Background(), other, purchaseInfo, purchaseHandler(s, &calls))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a reused key, got %v", err)
+	}
+}
+func testIdempotencyMetadataKeyAndExpiry(t *testing.T) {
+	store := newIdempotencyStore(time.Minute)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	calls := 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return &pb.EmptyResponse{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: pb.TrainTicketing_CancelReceipt_FullMethodName}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(idempotencyMetadataKey, "cancel-1"))
+	req := &pb.CancelReceiptRequest{UserID: "u1"}
+
+	store.unaryInterceptor(ctx, req, info, handler)
+	store.unaryInterceptor(ctx, req, info, handler)
+	if calls != 1 {
+		t.Errorf("Expected the metadata key to deduplicate calls, ran %d times", calls)
+	}
+	now = now.Add(2 * time.Minute)
+	store.unaryInterceptor(ctx, req, info, handler)
+	if calls != 2 {
+		t.Errorf("Expected the key to expire after the window, ran %d times", calls)
+	}
+}
+func testIdempotencyPanicReleasesKey(t *testing.T) {
+	store := newIdempotencyStore(time.Minute)
+	info := &grpc.UnaryServerInfo{FullMethod: pb.TrainTicketing_CancelReceipt_FullMethodName}
+	req := &pb.CancelReceiptRequest{UserID: "u1", IdempotencyKey: "cancel-1"}
+	func() {
+		defer func() { recover() }()
+		store.unaryInterceptor(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("handler failed")
+		})
+	}()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := store.unaryInterceptor(context.Background(), req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &pb.EmptyResponse{}, nil
+		})
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Expected the retry to run after the first attempt panicked, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Expected a panicking handler to release its idempotency key")
+	}
+}
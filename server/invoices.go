@@ -0,0 +1,190 @@
+// invoices.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// invoiceConfig holds the seller details and tax rules printed on invoices.
+type invoiceConfig struct {
+	SellerName       string    `json:"seller_name"`
+	SellerAddress    string    `json:"seller_address"`
+	SellerTaxID      string    `json:"seller_tax_id"`
+	InvoicePrefix    string    `json:"invoice_prefix"`
+	CreditNotePrefix string    `json:"credit_note_prefix"`
+	TaxRules         []taxRule `json:"tax_rules"`
+}
+
+// taxRule is a tax included in fares of the given class, or of every class when Class is empty.
+type taxRule struct {
+	Name  string      `json:"name"`
+	Rate  basisPoints `json:"rate_percent"`
+	Class string      `json:"class"`
+}
+
+// taxesFor returns the tax rules that apply to a seat class. Callers hold t.mu.
+func (t *trainServer) taxesFor(class pb.SeatClass) []taxRule {
+	rules := []taxRule{}
+	for _, rule := range t.invoicing.TaxRules {
+		if rule.Class == "" || rule.Class == class.String() {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// splitTax divides a tax-inclusive amount into the tax owed under each rule, rounding each
+// tax half away from zero. The net amount is whatever remains.
+func splitTax(gross int64, rules []taxRule) []int64 {
+	combined := int64(10000)
+	for _, rule := range rules {
+		combined += int64(rule.Rate)
+	}
+	taxes := make([]int64, len(rules))
+	for i, rule := range rules {
+		taxes[i] = roundRat(big.NewRat(gross*int64(rule.Rate), combined))
+	}
+	return taxes
+}
+
+// issueInvoice numbers and stores the invoice for a newly purchased ticket. Callers hold t.mu.
+func (t *trainServer) issueInvoice(ticket *pb.Ticket, user *pb.User, now time.Time) *pb.Invoice {
+	currency := ticket.PricePaid.GetCurrencyCode()
+	rules := t.taxesFor(ticket.Class)
+	invoice := &pb.Invoice{
+		Type:       pb.InvoiceType_INVOICE,
+		IssuedOn:   now.Format(time.RFC3339),
+		Seller:     &pb.Seller{Name: t.invoicing.SellerName, Address: t.invoicing.SellerAddress, TaxID: t.invoicing.SellerTaxID},
+		UserID:     user.UserID,
+		BuyerName:  strings.TrimSpace(user.FirstName + " " + user.LastName),
+		BuyerEmail: user.Email,
+		TicketId:   ticket.TicketId,
+	}
+	taxTotals := make([]int64, len(rules))
+	var net, tax, gross int64
+	for _, line := range ticket.FareBreakdown {
+		lineTaxes := splitTax(line.Amount.GetMinorUnits(), rules)
+		var lineTax int64
+		for i, amount := range lineTaxes {
+			taxTotals[i] += amount
+			lineTax += amount
+		}
+		lineGross := line.Amount.GetMinorUnits()
+		invoice.Lines = append(invoice.Lines, &pb.InvoiceLine{
+			Description: line.Description,
+			Net:         newMoney(currency, lineGross-lineTax),
+			Tax:         newMoney(currency, lineTax),
+			Gross:       newMoney(currency, lineGross),
+		})
+		net += lineGross - lineTax
+		tax += lineTax
+		gross += lineGross
+	}
+	for i, rule := range rules {
+		invoice.Taxes = append(invoice.Taxes, &pb.TaxLine{Name: rule.Name, RateBasisPoints: int32(rule.Rate), Tax: newMoney(currency, taxTotals[i])})
+	}
+	invoice.Net = newMoney(currency, net)
+	invoice.Tax = newMoney(currency, tax)
+	invoice.Gross = newMoney(currency, gross)
+
+	invoice.InvoiceNumber = fmt.Sprintf("%s%06d", t.invoicing.InvoicePrefix, t.nextNumber(counterInvoice, &t.invoiceSeq))
+	t.storeInvoice(invoice)
+	ticket.InvoiceNumber = invoice.InvoiceNumber
+	return invoice
+}
+
+// issueCreditNote reverses the invoice of a cancelled ticket in full. Callers hold t.mu.
+func (t *trainServer) issueCreditNote(ticket *pb.Ticket, now time.Time) {
+	original, ok := t.invoices[ticket.InvoiceNumber]
+	if !ok {
+		return
+	}
+	negate := func(m *pb.Money) *pb.Money { return newMoney(m.GetCurrencyCode(), -m.GetMinorUnits()) }
+	note := &pb.Invoice{
+		Type:                  pb.InvoiceType_CREDIT_NOTE,
+		OriginalInvoiceNumber: original.InvoiceNumber,
+		IssuedOn:              now.Format(time.RFC3339),
+		Seller:                original.Seller,
+		UserID:                original.UserID,
+		BuyerName:             original.BuyerName,
+		BuyerEmail:            original.BuyerEmail,
+		TicketId:              original.TicketId,
+		Net:                   negate(original.Net),
+		Tax:                   negate(original.Tax),
+		Gross:                 negate(original.Gross),
+	}
+	for _, line := range original.Lines {
+		note.Lines = append(note.Lines, &pb.InvoiceLine{Description: line.Description, Net: negate(line.Net), Tax: negate(line.Tax), Gross: negate(line.Gross)})
+	}
+	for _, tax := range original.Taxes {
+		note.Taxes = append(note.Taxes, &pb.TaxLine{Name: tax.Name, RateBasisPoints: tax.RateBasisPoints, Tax: negate(tax.Tax)})
+	}
+	note.InvoiceNumber = fmt.Sprintf("%s%06d", t.invoicing.CreditNotePrefix, t.nextNumber(counterCreditNote, &t.creditNoteSeq))
+	t.storeInvoice(note)
+}
+
+// nextNumber advances the invoice or credit note counter held in seq. A backend that numbers
+// them for every server sharing it keeps the counter itself, so it is not stored as state.
+// Callers hold t.mu.
+func (t *trainServer) nextNumber(counter string, seq *int64) int64 {
+	if t.store != nil {
+		if numberer, ok := t.store.backend.(invoiceNumberer); ok {
+			*seq = numberer.nextNumber(counter, *seq)
+			return *seq
+		}
+	}
+	*seq++
+	t.touch(tableCounter, counter)
+	return *seq
+}
+
+func (t *trainServer) storeInvoice(invoice *pb.Invoice) {
+	t.invoices[invoice.InvoiceNumber] = invoice
+	t.userInvoices[invoice.UserID] = append(t.userInvoices[invoice.UserID], invoice.InvoiceNumber)
+	t.touch(tableInvoice, invoice.InvoiceNumber)
+	t.touch(tableUserInvoices, invoice.UserID)
+}
+
+func (t *trainServer) GetInvoice(ctx context.Context, req *pb.InvoiceRequest) (*pb.Invoice, error) {
+	userid := strings.TrimSpace(req.UserID)
+	if userid == "" {
+		return nil, errors.New("User id can not be blank")
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	number := strings.TrimSpace(req.InvoiceNumber)
+	if number == "" {
+		ticket, ok := t.tickets[userid]
+		if !ok {
+			return nil, errors.New("Ticket not found")
+		}
+		number = ticket.InvoiceNumber
+	}
+	invoice, ok := t.invoices[number]
+	if !ok || invoice.UserID != userid {
+		return nil, errors.New("Invoice not found")
+	}
+	return invoice, nil
+}
+
+func (t *trainServer) ListInvoices(ctx context.Context, req *pb.UseRequest) (*pb.AllInvoices, error) {
+	userid := strings.TrimSpace(req.UserID)
+	if userid == "" {
+		return nil, errors.New("User id can not be blank")
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	resp := &pb.AllInvoices{}
+	for _, number := range t.userInvoices[userid] {
+		resp.Invoices = append(resp.Invoices, t.invoices[number])
+	}
+	return resp, nil
+}
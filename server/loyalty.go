@@ -0,0 +1,153 @@
+// loyalty.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// loyaltyConfig sets how points are earned and spent and the spend needed for each tier.
+// Amounts are in minor units of the base currency.
+type loyaltyConfig struct {
+	PointsPerUnit int64 `json:"points_per_unit"` // points earned per major unit of fare paid
+	PointValue    int64 `json:"point_value"`     // fare discount per point redeemed
+	SilverSpend   int64 `json:"silver_spend"`
+	GoldSpend     int64 `json:"gold_spend"`
+}
+
+// tierMultiplier scales the points earned by each tier.
+var tierMultiplier = map[pb.LoyaltyTier]factor{
+	pb.LoyaltyTier_BRONZE: 10000,
+	pb.LoyaltyTier_SILVER: 12500,
+	pb.LoyaltyTier_GOLD:   15000,
+}
+
+// loyaltyStanding sums a user's point balance and the spend, in minor units of the base
+// currency, that counts towards their tier. Callers hold t.mu.
+func (t *trainServer) loyaltyStanding(userid string, now time.Time) (points int64, spend int64, tier pb.LoyaltyTier) {
+	since := now.AddDate(-1, 0, 0)
+	for _, entry := range t.ledger[userid] {
+		points += entry.Points
+		if at, err := time.Parse(time.RFC3339, entry.CreatedOn); err == nil && at.After(since) {
+			spend += entry.Spend.GetMinorUnits()
+		}
+	}
+	switch {
+	case t.loyalty.GoldSpend > 0 && spend >= t.loyalty.GoldSpend:
+		tier = pb.LoyaltyTier_GOLD
+	case t.loyalty.SilverSpend > 0 && spend >= t.loyalty.SilverSpend:
+		tier = pb.LoyaltyTier_SILVER
+	}
+	return points, spend, tier
+}
+
+// applyPointsRedemption spends points against a quote. Callers hold t.mu.
+func (t *trainServer) applyPointsRedemption(quote *fareQuote, userid string, points int64, now time.Time) error {
+	if points == 0 {
+		return nil
+	} else if points < 0 {
+		return errors.New("Points to redeem can not be less than 0")
+	} else if t.loyalty.PointValue <= 0 {
+		return errors.New("Points can not be redeemed against fares")
+	}
+	balance, _, _ := t.loyaltyStanding(userid, now)
+	if points > balance {
+		return fmt.Errorf("Only %d loyalty points are available", balance)
+	}
+	discount, err := t.convert(newMoney(t.currency, points*t.loyalty.PointValue), quote.currency)
+	if err != nil {
+		return err
+	}
+	if discount.MinorUnits > quote.total {
+		return errors.New("Points redeemed can not be worth more than the fare")
+	}
+	quote.add(fmt.Sprintf("Loyalty points (%d)", points), -discount.MinorUnits)
+	return nil
+}
+
+func (t *trainServer) appendLedger(userid string, entry *pb.LedgerEntry, now time.Time) {
+	entry.EntryID = uuid.NewString()
+	entry.CreatedOn = now.Format(time.RFC3339)
+	t.ledger[userid] = append(t.ledger[userid], entry)
+	t.touch(tableLedger, userid)
+}
+
+// recordPurchasePoints debits redeemed points and credits points for the fare paid on a
+// confirmed ticket, at the multiplier of the tier held before the purchase. Callers hold t.mu.
+func (t *trainServer) recordPurchasePoints(ticket *pb.Ticket, redeemed int64, now time.Time) error {
+	spend, err := t.convert(ticket.PricePaid, t.currency)
+	if err != nil {
+		return err
+	}
+	if redeemed > 0 {
+		t.appendLedger(ticket.UserID, &pb.LedgerEntry{
+			Type:        pb.LedgerEntryType_REDEEM,
+			Points:      -redeemed,
+			TicketId:    ticket.TicketId,
+			Description: "Redeemed against fare",
+		}, now)
+		ticket.PointsRedeemed = redeemed
+	}
+	_, _, tier := t.loyaltyStanding(ticket.UserID, now)
+	// Whole points only: a fraction of a point earned is dropped.
+	earned := spend.MinorUnits * t.loyalty.PointsPerUnit * int64(tierMultiplier[tier]) / (minorUnitScale(t.currency) * int64(unchanged))
+	t.appendLedger(ticket.UserID, &pb.LedgerEntry{
+		Type:        pb.LedgerEntryType_EARN,
+		Points:      earned,
+		TicketId:    ticket.TicketId,
+		Spend:       spend,
+		Description: "Earned on " + ticket.From + " to " + ticket.To,
+	}, now)
+	ticket.PointsEarned = earned
+	return nil
+}
+
+// reversePurchasePoints undoes the ledger movements of a cancelled ticket: earned points and
+// spend are taken back and redeemed points are returned. The balance may go negative if the
+// earned points were already spent. Callers hold t.mu.
+func (t *trainServer) reversePurchasePoints(ticket *pb.Ticket, now time.Time) {
+	spend := newMoney(t.currency, 0)
+	for _, entry := range t.ledger[ticket.UserID] {
+		if entry.TicketId == ticket.TicketId && entry.Type == pb.LedgerEntryType_EARN {
+			spend = newMoney(entry.Spend.GetCurrencyCode(), -entry.Spend.GetMinorUnits())
+		}
+	}
+	if ticket.PointsEarned == 0 && ticket.PointsRedeemed == 0 && spend.MinorUnits == 0 {
+		return
+	}
+	t.appendLedger(ticket.UserID, &pb.LedgerEntry{
+		Type:        pb.LedgerEntryType_REVERSAL,
+		Points:      ticket.PointsRedeemed - ticket.PointsEarned,
+		TicketId:    ticket.TicketId,
+		Spend:       spend,
+		Description: "Ticket cancelled",
+	}, now)
+}
+
+func (t *trainServer) GetLoyaltyBalance(ctx context.Context, req *pb.UseRequest) (*pb.LoyaltyBalance, error) {
+	userid := strings.TrimSpace(req.UserID)
+	if userid == "" {
+		return nil, errors.New("User id can not be blank")
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if _, ok := t.users[userid]; !ok {
+		return nil, errors.New("Invalid User")
+	}
+	points, spend, tier := t.loyaltyStanding(userid, time.Now())
+	return &pb.LoyaltyBalance{
+		UserID:        userid,
+		Points:        points,
+		Tier:          tier,
+		TrailingSpend: newMoney(t.currency, spend),
+		Entries:       t.ledger[userid],
+	}, nil
+}
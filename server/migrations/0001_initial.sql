@@ -0,0 +1,55 @@
+-- Domain events in sequence order; a server restores its state by replaying them.
+CREATE TABLE events (
+    sequence     bigint PRIMARY KEY CHECK (sequence > 0),
+    event_id     text NOT NULL UNIQUE,
+    type         text NOT NULL,
+    aggregate_id text NOT NULL,
+    occurred_on  timestamptz NOT NULL,
+    payload      bytea NOT NULL -- DomainEvent in protobuf encoding
+);
+
+-- Users, sections, tickets and seat allocations as of the last event. They carry the
+-- constraints every server sharing the database must respect.
+CREATE TABLE users (
+    user_id    text PRIMARY KEY,
+    email      text NOT NULL,
+    first_name text NOT NULL,
+    last_name  text NOT NULL,
+    version    bigint NOT NULL
+);
+CREATE UNIQUE INDEX users_email_key ON users (lower(email));
+
+CREATE TABLE sections (
+    section_id  text PRIMARY KEY,
+    name        text NOT NULL,
+    total_seats integer NOT NULL CHECK (total_seats > 0),
+    version     bigint NOT NULL
+);
+CREATE UNIQUE INDEX sections_name_key ON sections (lower(name));
+
+CREATE TABLE tickets (
+    user_id        text PRIMARY KEY REFERENCES users,
+    ticket_id      text NOT NULL UNIQUE,
+    section_id     text NOT NULL REFERENCES sections,
+    seat_number    integer NOT NULL,
+    companion_seat integer NOT NULL DEFAULT 0,
+    version        bigint NOT NULL
+);
+
+-- One row per allocated seat, so a seat can never be allocated twice.
+CREATE TABLE seat_allocations (
+    section_id  text NOT NULL REFERENCES sections ON DELETE CASCADE,
+    seat_number integer NOT NULL CHECK (seat_number > 0),
+    user_id     text NOT NULL REFERENCES tickets ON DELETE CASCADE,
+    PRIMARY KEY (section_id, seat_number)
+);
+CREATE INDEX seat_allocations_user_id_idx ON seat_allocations (user_id);
+
+-- Other state, such as holds, seat blocks, promotions, invoices and the loyalty ledger.
+CREATE TABLE state_entries (
+    entry_table text NOT NULL,
+    entry_key   text NOT NULL,
+    user_id     text NOT NULL DEFAULT '', -- set for redemptions, counted per user
+    payload     bytea NOT NULL,           -- StateEntry in protobuf encoding
+    PRIMARY KEY (entry_table, entry_key, user_id)
+);
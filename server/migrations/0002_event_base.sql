@@ -0,0 +1,7 @@
+-- Users, sections and tickets right after the event at sequence, stored in place of the
+-- events before it once they are no longer retained. A large snapshot is split into chunks.
+CREATE TABLE event_base (
+    chunk    integer PRIMARY KEY,
+    sequence bigint NOT NULL,
+    payload  bytea NOT NULL -- WALRecord holding part of the BookingSnapshot in protobuf encoding
+);
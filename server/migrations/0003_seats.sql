@@ -0,0 +1,18 @@
+-- One row per seat of a section. A server locks the rows of the seats it allocates until it
+-- stores the allocation, skipping those locked by other servers, so they pick different seats.
+CREATE TABLE seats (
+    section_id  text NOT NULL REFERENCES sections ON DELETE CASCADE,
+    seat_number integer NOT NULL CHECK (seat_number > 0),
+    PRIMARY KEY (section_id, seat_number)
+);
+INSERT INTO seats (section_id, seat_number)
+SELECT section_id, generate_series(1, total_seats) FROM sections;
+
+-- The last event sequence assigned. A write takes the next sequences from it, holding the row
+-- until it commits, so every server's events are numbered in the order they are stored.
+CREATE TABLE event_sequence (
+    id   boolean PRIMARY KEY DEFAULT true CHECK (id),
+    last bigint NOT NULL
+);
+INSERT INTO event_sequence (last)
+SELECT greatest((SELECT coalesce(max(sequence), 0) FROM events), (SELECT coalesce(max(sequence), 0) FROM event_base));
@@ -0,0 +1,10 @@
+-- The number of times each entry was written. A server updates or removes only the version it
+-- loaded or last wrote, so it can not overwrite an entry another server changed since.
+ALTER TABLE state_entries ADD COLUMN version bigint NOT NULL DEFAULT 1;
+
+-- The last invoice and credit note numbers issued. A call takes the next number from it,
+-- holding the row until the call's changes are stored, so every number is issued once.
+CREATE TABLE invoice_counters (
+    counter text PRIMARY KEY,
+    last    bigint NOT NULL
+);
@@ -0,0 +1,233 @@
+// money.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// currencyDigits lists the supported ISO 4217 currencies and the number of digits in their minor unit.
+var currencyDigits = map[string]int{
+	"AUD": 2, "BHD": 3, "CAD": 2, "CHF": 2, "CNY": 2, "EUR": 2, "GBP": 2, "HKD": 2,
+	"INR": 2, "JPY": 0, "KRW": 0, "KWD": 3, "NZD": 2, "SEK": 2, "SGD": 2, "USD": 2,
+}
+
+func validCurrency(code string) bool {
+	_, ok := currencyDigits[code]
+	return ok
+}
+
+func newMoney(currency string, units int64) *pb.Money {
+	return &pb.Money{CurrencyCode: currency, MinorUnits: units}
+}
+
+// minorUnitScale returns 10 to the power of the currency's minor unit digits.
+func minorUnitScale(currency string) int64 {
+	scale := int64(1)
+	for i := 0; i < currencyDigits[currency]; i++ {
+		scale *= 10
+	}
+	return scale
+}
+
+// basisPoints is a rate in hundredths of a percent, so 1250 is 12.5%. Config files give it as
+// a percentage such as 12.5, which is read exactly rather than through a float.
+type basisPoints int64
+
+// of returns the rate applied to units, rounded half away from zero.
+func (b basisPoints) of(units int64) int64 {
+	return roundRat(big.NewRat(units*int64(b), 10000))
+}
+
+func (b basisPoints) String() string { return formatFixed(int64(b), 100) + "%" }
+
+func (b basisPoints) MarshalJSON() ([]byte, error) {
+	return []byte(formatFixed(int64(b), 100)), nil
+}
+
+func (b *basisPoints) UnmarshalJSON(data []byte) error {
+	v, err := parseFixed(data, 100)
+	if err != nil {
+		return fmt.Errorf("percentage %w", err)
+	}
+	*b = basisPoints(v)
+	return nil
+}
+
+// factor scales an amount, in basis points of it, so 12500 is 1.25 times. Config files give it
+// as a number such as 1.25, read exactly like basisPoints.
+type factor int64
+
+func (f factor) String() string { return formatFixed(int64(f), 10000) }
+
+func (f factor) MarshalJSON() ([]byte, error) {
+	return []byte(formatFixed(int64(f), 10000)), nil
+}
+
+func (f *factor) UnmarshalJSON(data []byte) error {
+	v, err := parseFixed(data, 10000)
+	if err != nil {
+		return fmt.Errorf("multiplier %w", err)
+	}
+	*f = factor(v)
+	return nil
+}
+
+// parseFixed reads a JSON number as an integer count of 1/scale, failing if it is more precise.
+func parseFixed(data []byte, scale int64) (int64, error) {
+	r, ok := new(big.Rat).SetString(string(data))
+	if !ok {
+		return 0, fmt.Errorf("%s is not a number", data)
+	}
+	r.Mul(r, big.NewRat(scale, 1))
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, fmt.Errorf("%s has too many decimal places", data)
+	}
+	return r.Num().Int64(), nil
+}
+
+// formatFixed writes a count of 1/scale as a decimal number without trailing zeros.
+func formatFixed(v, scale int64) string {
+	digits := len(fmt.Sprint(scale)) - 1
+	s := new(big.Rat).SetFrac64(v, scale).FloatString(digits)
+	if digits > 0 {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// roundRat rounds r to the nearest integer, halves away from zero.
+func roundRat(r *big.Rat) int64 {
+	num := new(big.Int).Abs(r.Num())
+	q, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if r.Sign() < 0 {
+		q.Neg(q)
+	}
+	return q.Int64()
+}
+
+func parseRate(value string) (*big.Rat, error) {
+	rate, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+	if !ok || rate.Sign() <= 0 {
+		return nil, errors.New("Rate must be a positive decimal number")
+	}
+	return rate, nil
+}
+
+// rate returns the amount of currency per unit of the base currency. Callers hold t.mu.
+func (t *trainServer) rate(currency string) (*big.Rat, error) {
+	if currency == t.currency {
+		return big.NewRat(1, 1), nil
+	}
+	r, ok := t.exchangeRates[currency]
+	if !ok {
+		return nil, errors.New("No exchange rate for " + currency)
+	}
+	return parseRate(r.Rate)
+}
+
+// convert changes an amount to another currency at the current rates, rounding once to
+// the target's minor unit. Callers hold t.mu.
+func (t *trainServer) convert(m *pb.Money, to string) (*pb.Money, error) {
+	if m.CurrencyCode == to {
+		return newMoney(to, m.MinorUnits), nil
+	}
+	from, err := t.rate(m.CurrencyCode)
+	if err != nil {
+		return nil, err
+	}
+	target, err := t.rate(to)
+	if err != nil {
+		return nil, err
+	}
+	// minor(to) = minor(from) / scale(from) / rate(from) * rate(to) * scale(to)
+	amount := new(big.Rat).SetInt64(m.MinorUnits)
+	amount.Mul(amount, big.NewRat(minorUnitScale(to), minorUnitScale(m.CurrencyCode)))
+	amount.Quo(amount, from)
+	amount.Mul(amount, target)
+	return newMoney(to, roundRat(amount)), nil
+}
+
+// normalizeMoney checks a client supplied amount, defaulting its currency to the base currency.
+func (t *trainServer) normalizeMoney(m *pb.Money) (*pb.Money, error) {
+	if m == nil {
+		return newMoney(t.currency, 0), nil
+	}
+	currency := strings.ToUpper(strings.TrimSpace(m.CurrencyCode))
+	if currency == "" {
+		currency = t.currency
+	}
+	if !validCurrency(currency) {
+		return nil, errors.New("Unsupported currency " + currency)
+	}
+	return newMoney(currency, m.MinorUnits), nil
+}
+
+func (t *trainServer) SetExchangeRate(ctx context.Context, req *pb.ExchangeRate) (*pb.ExchangeRate, error) {
+	currency := strings.ToUpper(strings.TrimSpace(req.CurrencyCode))
+	if !validCurrency(currency) {
+		return nil, errors.New("Unsupported currency " + currency)
+	}
+	rate, err := parseRate(req.Rate)
+	if err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if currency == t.currency {
+		return nil, errors.New("The base currency always has a rate of 1")
+	}
+	entry := &pb.ExchangeRate{
+		CurrencyCode: currency,
+		Rate:         rate.FloatString(8),
+		UpdatedOn:    time.Now().String(),
+	}
+	entry.Rate = strings.TrimRight(strings.TrimRight(entry.Rate, "0"), ".")
+	t.exchangeRates[currency] = entry
+	t.touch(tableExchangeRate, currency)
+	return entry, nil
+}
+
+func (t *trainServer) ViewExchangeRates(ctx context.Context, req *pb.CurrencyRequest) (*pb.ExchangeRates, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	resp := &pb.ExchangeRates{BaseCurrency: t.currency}
+	if currency := strings.ToUpper(strings.TrimSpace(req.CurrencyCode)); currency != "" {
+		rate, ok := t.exchangeRates[currency]
+		if !ok {
+			return nil, errors.New("No exchange rate for " + currency)
+		}
+		resp.Rates = []*pb.ExchangeRate{rate}
+		return resp, nil
+	}
+	for _, rate := range t.exchangeRates {
+		resp.Rates = append(resp.Rates, rate)
+	}
+	sort.Slice(resp.Rates, func(i, j int) bool { return resp.Rates[i].CurrencyCode < resp.Rates[j].CurrencyCode })
+	return resp, nil
+}
+
+// formatMoney renders an amount for people, e.g. "USD 19.99" or "JPY -500".
+func formatMoney(m *pb.Money) string {
+	units, sign := m.GetMinorUnits(), ""
+	if units < 0 {
+		units, sign = -units, "-"
+	}
+	digits := currencyDigits[m.GetCurrencyCode()]
+	scale := minorUnitScale(m.GetCurrencyCode())
+	if digits == 0 {
+		return fmt.Sprintf("%s %s%d", m.GetCurrencyCode(), sign, units)
+	}
+	return fmt.Sprintf("%s %s%d.%0*d", m.GetCurrencyCode(), sign, units/scale, digits, units%scale)
+}
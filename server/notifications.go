@@ -0,0 +1,418 @@
+// notifications.go
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"sync"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/google/uuid"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// Event types emitted when a booking changes.
+const (
+	eventBooked      = "ticket.booked"
+	eventSeatChanged = "ticket.seat_changed"
+	eventCancelled   = "ticket.cancelled"
+	eventRefunded    = "ticket.refunded"
+	eventDisrupted   = "ticket.disrupted"
+)
+
+const notificationTemplate = "notifications.txt.tmpl"
+
+// maxDeliveryLog bounds the delivery log; older entries are dropped first.
+const maxDeliveryLog = 1000
+
+// bookingEvent describes a change to a passenger's booking. It holds copies of everything it
+// needs, so it can be delivered after t.mu is released.
+type bookingEvent struct {
+	ID            string    `json:"id"`
+	Type          string    `json:"type"`
+	At            time.Time `json:"at"`
+	UserID        string    `json:"user_id"`
+	Passenger     string    `json:"passenger"`
+	Email         string    `json:"email"`
+	TicketID      string    `json:"ticket_id"`
+	From          string    `json:"from"`
+	To            string    `json:"to"`
+	Section       string    `json:"section"`
+	Seat          int32     `json:"seat"`
+	CompanionSeat int32     `json:"companion_seat,omitempty"`
+	Departure     string    `json:"departure,omitempty"`
+	Price         *pb.Money `json:"price,omitempty"`
+	Refund        *pb.Money `json:"refund,omitempty"`
+	Detail        string    `json:"detail,omitempty"`
+}
+
+// newBookingEvent snapshots a ticket for an event of the given type. Callers hold t.mu.
+func (t *trainServer) newBookingEvent(eventType string, ticket *pb.Ticket, detail string) *bookingEvent {
+	e := &bookingEvent{
+		ID:            uuid.NewString(),
+		Type:          eventType,
+		At:            time.Now(),
+		UserID:        ticket.UserID,
+		Passenger:     t.passengerName(ticket.UserID),
+		TicketID:      ticket.TicketId,
+		From:          ticket.From,
+		To:            ticket.To,
+		Section:       ticket.Section,
+		Seat:          ticket.SeatNumber,
+		CompanionSeat: ticket.CompanionSeat,
+		Price:         newMoney(ticket.PricePaid.GetCurrencyCode(), ticket.PricePaid.GetMinorUnits()),
+		Detail:        detail,
+	}
+	if user, ok := t.users[ticket.UserID]; ok {
+		e.Email = user.Email
+	}
+	if section, ok := t.sections[ticket.Section]; ok {
+		e.Section = section.Section
+		e.Departure = section.Departure
+	}
+	return e
+}
+
+// notification is a rendered message for one event.
+type notification struct {
+	Event   *bookingEvent
+	To      string
+	Subject string
+	Body    string
+}
+
+// notificationChannel delivers notifications to one destination.
+type notificationChannel interface {
+	name() string
+	send(ctx context.Context, n notification) error
+}
+
+// smtpChannel emails the passenger.
+type smtpChannel struct {
+	cfg smtpConfig
+}
+
+func (c smtpChannel) name() string { return "smtp" }
+
+func (c smtpChannel) send(ctx context.Context, n notification) error {
+	if n.To == "" {
+		return errors.New("passenger has no email address")
+	}
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", c.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", n.To)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", n.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", n.Event.At.Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "Message-ID: <%s@ticketbook>\r\n", n.Event.ID)
+	msg.WriteString("MIME-Version: 1.0\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(n.Body, "\n", "\r\n"))
+	// Dial and talk to the server within ctx, so one that stops responding can not hold up
+	// the notifier or shutdown.
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", c.cfg.Addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+	host, _, _ := net.SplitHostPort(c.cfg.Addr)
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if c.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", c.cfg.Username, c.cfg.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(c.cfg.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(n.To); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte(msg.String())); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// webhookChannel posts each notification as JSON to a single operator URL.
+type webhookChannel struct {
+	url    string
+	client *http.Client
+}
+
+func (c webhookChannel) name() string { return "webhook" }
+
+func (c webhookChannel) send(ctx context.Context, n notification) error {
+	body, err := json.Marshal(struct {
+		*bookingEvent
+		Subject string `json:"subject"`
+		Body    string `json:"body"`
+	}{n.Event, n.Subject, n.Body})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// logChannel writes notifications to the server log.
+type logChannel struct{}
+
+func (logChannel) name() string { return "log" }
+
+func (logChannel) send(ctx context.Context, n notification) error {
+	slog.Info("notification", "event", n.Event.Type, "user", n.Event.UserID, "to", n.To, "subject", n.Subject)
+	return nil
+}
+
+// notifier renders booking events and delivers them through every channel in the background,
+// one event at a time in the order they were published, retrying failed sends.
+type notifier struct {
+	channels     []notificationChannel
+	templates    *texttemplate.Template
+	maxAttempts  int
+	retryBackoff time.Duration // doubled after each failed attempt
+	sendTimeout  time.Duration
+
+	mu         sync.Mutex
+	pending    []*bookingEvent
+	running    bool
+	busySince  time.Time // when run took the event it is delivering, zero while idle
+	deliveries []*pb.NotificationDelivery
+	wg         sync.WaitGroup
+}
+
+// newNotifier loads the notification templates, preferring any found in templateDir.
+func newNotifier(channels []notificationChannel, templateDir string, maxAttempts int, retryBackoff time.Duration) (*notifier, error) {
+	source, err := readTemplate(templateDir, notificationTemplate)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := texttemplate.New(notificationTemplate).Funcs(texttemplate.FuncMap{"money": formatMoney}).Parse(source)
+	if err != nil {
+		return nil, err
+	}
+	for _, eventType := range []string{eventBooked, eventSeatChanged, eventCancelled, eventRefunded, eventDisrupted} {
+		for _, part := range []string{".subject", ".body"} {
+			if templates.Lookup(eventType+part) == nil {
+				return nil, fmt.Errorf("%s: missing template %q", notificationTemplate, eventType+part)
+			}
+		}
+	}
+	return &notifier{
+		channels:     channels,
+		templates:    templates,
+		maxAttempts:  maxAttempts,
+		retryBackoff: retryBackoff,
+		sendTimeout:  10 * time.Second,
+	}, nil
+}
+
+// notificationChannels builds the configured channels.
+func (c notificationConfig) notificationChannels() []notificationChannel {
+	channels := []notificationChannel{}
+	for _, name := range c.Channels {
+		switch name {
+		case "log":
+			channels = append(channels, logChannel{})
+		case "smtp":
+			channels = append(channels, smtpChannel{cfg: c.SMTP})
+		case "webhook":
+			channels = append(channels, webhookChannel{url: c.WebhookURL, client: &http.Client{Timeout: 10 * time.Second}})
+		}
+	}
+	return channels
+}
+
+// publish queues an event for delivery without blocking, so it is safe to call with t.mu held.
+func (n *notifier) publish(e *bookingEvent) {
+	if len(n.channels) == 0 {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.pending = append(n.pending, e)
+	n.wg.Add(1)
+	if !n.running {
+		n.running = true
+		go n.run()
+	}
+}
+
+// run delivers queued events until the queue is empty.
+func (n *notifier) run() {
+	for {
+		n.mu.Lock()
+		if len(n.pending) == 0 {
+			n.running, n.busySince = false, time.Time{}
+			n.mu.Unlock()
+			return
+		}
+		e := n.pending[0]
+		n.pending = n.pending[1:]
+		n.busySince = time.Now()
+		n.mu.Unlock()
+		n.deliver(e)
+		n.wg.Done()
+	}
+}
+
+// healthy returns an error if delivering one event has taken longer than every attempt on
+// every channel timing out could, so queued events are not being delivered.
+func (n *notifier) healthy(now time.Time) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if !n.busySince.IsZero() && now.Sub(n.busySince) > n.deliveryLimit() {
+		return fmt.Errorf("delivering an event has taken %s, with %d more queued", now.Sub(n.busySince).Round(time.Second), len(n.pending))
+	}
+	return nil
+}
+
+// deliveryLimit is the longest delivering one event may take, with every attempt timing out.
+func (n *notifier) deliveryLimit() time.Duration {
+	limit, backoff := time.Duration(0), n.retryBackoff
+	for attempt := 0; attempt < n.maxAttempts; attempt++ {
+		if attempt > 0 {
+			limit += backoff
+			backoff *= 2
+		}
+		limit += n.sendTimeout
+	}
+	return limit * time.Duration(len(n.channels))
+}
+
+// wait blocks until every published event has been delivered or given up on.
+func (n *notifier) wait() {
+	n.wg.Wait()
+}
+
+func (n *notifier) render(e *bookingEvent) (notification, error) {
+	msg := notification{Event: e, To: e.Email}
+	var subject, body strings.Builder
+	if err := n.templates.ExecuteTemplate(&subject, e.Type+".subject", e); err != nil {
+		return msg, err
+	}
+	if err := n.templates.ExecuteTemplate(&body, e.Type+".body", e); err != nil {
+		return msg, err
+	}
+	msg.Subject = strings.TrimSpace(subject.String())
+	msg.Body = body.String()
+	return msg, nil
+}
+
+// deliver sends an event through every channel and records the outcome in the delivery log.
+func (n *notifier) deliver(e *bookingEvent) {
+	msg, renderErr := n.render(e)
+	for _, channel := range n.channels {
+		record := &pb.NotificationDelivery{
+			ID:        uuid.NewString(),
+			EventID:   e.ID,
+			Event:     e.Type,
+			Channel:   channel.name(),
+			UserID:    e.UserID,
+			Recipient: msg.To,
+			Subject:   msg.Subject,
+			Status:    pb.DeliveryStatus_UNDELIVERED,
+			CreatedOn: time.Now().String(),
+		}
+		if renderErr != nil {
+			record.LastError = renderErr.Error()
+		} else {
+			backoff := n.retryBackoff
+			for record.Attempts < int32(n.maxAttempts) {
+				if record.Attempts > 0 {
+					time.Sleep(backoff)
+					backoff *= 2
+				}
+				record.Attempts++
+				ctx, cancel := context.WithTimeout(context.Background(), n.sendTimeout)
+				err := channel.send(ctx, msg)
+				cancel()
+				if err == nil {
+					record.Status = pb.DeliveryStatus_DELIVERED
+					record.LastError = ""
+					break
+				}
+				record.LastError = err.Error()
+			}
+		}
+		if record.Status != pb.DeliveryStatus_DELIVERED {
+			slog.Warn("notification not delivered", "event", e.Type, "channel", record.Channel, "attempts", record.Attempts, "error", record.LastError)
+		}
+		record.CompletedOn = time.Now().String()
+		n.mu.Lock()
+		n.deliveries = append(n.deliveries, record)
+		if len(n.deliveries) > maxDeliveryLog {
+			n.deliveries = n.deliveries[len(n.deliveries)-maxDeliveryLog:]
+		}
+		n.mu.Unlock()
+	}
+}
+
+// notify publishes a booking event to passengers and webhook subscribers once the change is
+// stored. Callers hold t.mu.
+func (t *trainServer) notify(e *bookingEvent) {
+	t.afterStore(func() {
+		t.notifier.publish(e)
+		t.webhooks.publish(&webhookEvent{ID: e.ID, Type: e.Type, CreatedAt: e.At, Data: e, userID: e.UserID})
+	})
+}
+
+// ViewNotifications lists the delivery log, oldest first, for one user or for everyone when
+// no user id is given.
+func (t *trainServer) ViewNotifications(ctx context.Context, req *pb.UseRequest) (*pb.NotificationLog, error) {
+	userid := strings.TrimSpace(req.UserID)
+	log := &pb.NotificationLog{}
+	t.notifier.mu.Lock()
+	defer t.notifier.mu.Unlock()
+	for _, d := range t.notifier.deliveries {
+		if userid == "" || d.UserID == userid {
+			log.Deliveries = append(log.Deliveries, d)
+		}
+	}
+	return log, nil
+}
@@ -0,0 +1,263 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// fakeSMTP is a minimal SMTP server that records the messages it accepts.
+type fakeSMTP struct {
+	addr string
+
+	mu       sync.Mutex
+	messages []fakeMail
+}
+
+type fakeMail struct {
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+	s := &fakeSMTP{addr: lis.Addr().String()}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+	c := textproto.NewConn(conn)
+	c.PrintfLine("220 localhost fake SMTP")
+	var mail fakeMail
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch verb {
+		case "EHLO", "HELO":
+			c.PrintfLine("250 localhost")
+		case "MAIL":
+			mail = fakeMail{from: strings.TrimPrefix(line, "MAIL FROM:")}
+			c.PrintfLine("250 OK")
+		case "RCPT":
+			mail.to = append(mail.to, strings.TrimPrefix(line, "RCPT TO:"))
+			c.PrintfLine("250 OK")
+		case "DATA":
+			c.PrintfLine("354 Go ahead")
+			data, err := c.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			mail.data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, mail)
+			s.mu.Unlock()
+			c.PrintfLine("250 Queued")
+		case "QUIT":
+			c.PrintfLine("221 Bye")
+			return
+		default:
+			c.PrintfLine("502 Not implemented")
+		}
+	}
+}
+
+func (s *fakeSMTP) mail() []fakeMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]fakeMail{}, s.messages...)
+}
+
+func setupNotifier(t *testing.T, s *trainServer, channels ...notificationChannel) {
+	t.Helper()
+	n, err := newNotifier(channels, "", 3, time.Millisecond)
+	if err != nil {
+		t.Fatalf("newNotifier failed: %v", err)
+	}
+	s.notifier = n
+}
+
+func TestNotifications(t *testing.T) {
+	t.Run("SMTP", testNotificationsSMTP)
+	t.Run("SMTPTimeout", testNotificationsSMTPTimeout)
+	t.Run("WebhookRetries", testNotificationsWebhookRetries)
+	t.Run("Undelivered", testNotificationsUndelivered)
+	t.Run("Stalled", testNotificationsStalled)
+}
+func testNotificationsSMTP(t *testing.T) {
+	smtpServer := newFakeSMTP(t)
+	s := setupTestServer()
+	setupNotifier(t, s, smtpChannel{cfg: smtpConfig{Addr: smtpServer.addr, From: "tickets@ticketbook.test"}})
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "Coach A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "London", To: "Manchester", UserID: user.UserID, PricePaid: usd(4250)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: user.UserID, Section: section.SectionID, SeatNumber: 7, Version: ticket.Version})
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: user.UserID})
+	s.notifier.wait()
+
+	mail := smtpServer.mail()
+	if len(mail) != 4 {
+		t.Fatalf("Expected booked, seat changed, cancelled and refunded emails, got %d", len(mail))
+	}
+	for _, want := range []string{"Subject: Booking confirmed: London to Manchester", "Seat:      1", "Paid:      USD 42.50", "Hello Aman jain"} {
+		if !strings.Contains(mail[0].data, want) {
+			t.Errorf("Expected the confirmation to contain %q:\n%s", want, mail[0].data)
+		}
+	}
+	if mail[0].to[0] != "<test@gmail.com>" || mail[0].from != "<tickets@ticketbook.test>" {
+		t.Errorf("Expected mail from the configured sender to the passenger, got %s to %v", mail[0].from, mail[0].to)
+	}
+	if !strings.Contains(mail[1].data, "section Coach A, seat 7") || !strings.Contains(mail[3].data, "Subject: Refund of USD 42.50") {
+		t.Errorf("Expected seat change and refund emails, got:\n%s\n%s", mail[1].data, mail[3].data)
+	}
+
+	log, _ := s.ViewNotifications(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	if len(log.Deliveries) != 4 || log.Deliveries[0].Event != eventBooked || log.Deliveries[0].Status != pb.DeliveryStatus_DELIVERED || log.Deliveries[0].Attempts != 1 {
+		t.Errorf("Expected 4 deliveries in order, got %v", log.Deliveries)
+	}
+}
+func testNotificationsSMTPTimeout(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	go func() {
+		// Accept connections but never greet, like a server that has hung.
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+	channel := smtpChannel{cfg: smtpConfig{Addr: lis.Addr().String(), From: "tickets@ticketbook.test"}}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err = channel.send(ctx, notification{Event: &bookingEvent{ID: "e1"}, To: "test@gmail.com"})
+	if err == nil || time.Since(start) > 5*time.Second {
+		t.Errorf("Expected the send to give up when the context ends, got %v after %v", err, time.Since(start))
+	}
+}
+func testNotificationsWebhookRetries(t *testing.T) {
+	var calls atomic.Int32
+	received := make(chan map[string]any, 1)
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		received <- body
+	}))
+	defer hook.Close()
+	s := setupTestServer()
+	setupNotifier(t, s, webhookChannel{url: hook.URL, client: hook.Client()})
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	ticket, _ := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	s.notifier.wait()
+
+	body := <-received
+	if body["type"] != eventBooked || body["ticket_id"] != ticket.TicketId || body["subject"] == "" {
+		t.Errorf("Expected the booking event as JSON, got %v", body)
+	}
+	log, _ := s.ViewNotifications(context.Background(), &pb.UseRequest{})
+	if len(log.Deliveries) != 1 || log.Deliveries[0].Attempts != 3 || log.Deliveries[0].Status != pb.DeliveryStatus_DELIVERED {
+		t.Errorf("Expected delivery on the third attempt, got %v", log.Deliveries)
+	}
+}
+func testNotificationsUndelivered(t *testing.T) {
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer hook.Close()
+	s := setupTestServer()
+	setupNotifier(t, s, webhookChannel{url: hook.URL, client: hook.Client()}, logChannel{})
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	s.notifier.wait()
+
+	log, _ := s.ViewNotifications(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	if len(log.Deliveries) != 2 {
+		t.Fatalf("Expected a delivery per channel, got %v", log.Deliveries)
+	}
+	if d := log.Deliveries[0]; d.Status != pb.DeliveryStatus_UNDELIVERED || d.Attempts != 3 || !strings.Contains(d.LastError, "500") {
+		t.Errorf("Expected the webhook to give up after 3 attempts, got %v", d)
+	}
+	if d := log.Deliveries[1]; d.Channel != "log" || d.Status != pb.DeliveryStatus_DELIVERED {
+		t.Errorf("Expected a failing channel not to hold up the others, got %v", d)
+	}
+}
+
+// stuckChannel ignores the send timeout and blocks until released, as a broken channel might.
+type stuckChannel struct{ release chan struct{} }
+
+func (c stuckChannel) name() string { return "stuck" }
+
+func (c stuckChannel) send(ctx context.Context, n notification) error {
+	<-c.release
+	return nil
+}
+
+func testNotificationsStalled(t *testing.T) {
+	s := setupTestServer()
+	release := make(chan struct{})
+	setupNotifier(t, s, stuckChannel{release: release})
+	s.notifier.sendTimeout = 10 * time.Millisecond
+	later := func() time.Time { return time.Now().Add(time.Second) }
+	if err := s.notifier.healthy(later()); err != nil {
+		t.Fatalf("Expected an idle notifier to be healthy, got %v", err)
+	}
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	for deadline := time.Now().Add(5 * time.Second); s.notifier.healthy(later()) == nil; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected a delivery taking longer than its attempts could to be reported")
+		}
+	}
+	if err := s.ready(context.Background()); err != nil {
+		t.Errorf("Expected stalled notifications not to affect readiness, got %v", err)
+	}
+	close(release)
+	s.notifier.wait()
+	if err := s.notifier.healthy(later()); err != nil {
+		t.Errorf("Expected the notifier to be healthy once delivery resumed, got %v", err)
+	}
+}
@@ -0,0 +1,195 @@
+// outbox.go
+
+package main
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// Domain event types recorded in the outbox.
+const (
+	domainUserCreated     = "UserCreated"
+	domainUserUpdated     = "UserUpdated"
+	domainUserRemoved     = "UserRemoved"
+	domainTicketPurchased = "TicketPurchased"
+	domainSeatModified    = "SeatModified"
+	domainTicketCancelled = "TicketCancelled"
+	domainSectionChanged  = "SectionChanged"
+
+	domainTicketBoarded        = "TicketBoarded"
+	domainBoardingPassReissued = "BoardingPassReissued"
+)
+
+// Changes a SectionChanged event describes.
+const (
+	sectionCreated   = "created"
+	sectionUpdated   = "updated"
+	sectionResized   = "resized"
+	sectionDeleted   = "deleted"
+	sectionDisrupted = "disrupted"
+)
+
+var domainEventTypes = []string{
+	domainUserCreated, domainUserUpdated, domainUserRemoved,
+	domainTicketPurchased, domainSeatModified, domainTicketBoarded, domainBoardingPassReissued,
+	domainTicketCancelled, domainSectionChanged,
+}
+
+// recordEvent appends an event to the outbox and wakes the subscribers. It is called in the
+// same critical section as the mutation it describes, so a change and its event are always
+// seen together. Callers hold t.mu for writing.
+func (t *trainServer) recordEvent(e *pb.DomainEvent) {
+	e.Sequence = t.latestSequence() + 1
+	e.EventID = uuid.NewString()
+	e.OccurredOn = time.Now().Format(time.RFC3339Nano)
+	t.outbox = append(t.outbox, e)
+	t.applyEvent(e)
+	t.trimOutbox()
+	close(t.outboxChanged)
+	t.outboxChanged = make(chan struct{})
+}
+
+// recordUserEvent records a user change. Callers hold t.mu.
+func (t *trainServer) recordUserEvent(eventType string, user *pb.User) {
+	t.recordEvent(&pb.DomainEvent{Type: eventType, AggregateID: user.UserID, User: proto.Clone(user).(*pb.User)})
+}
+
+// recordTicketEvent records a ticket change. Callers hold t.mu.
+func (t *trainServer) recordTicketEvent(eventType string, ticket *pb.Ticket, refund *pb.Money) {
+	t.recordEvent(&pb.DomainEvent{Type: eventType, AggregateID: ticket.TicketId, Ticket: proto.Clone(ticket).(*pb.Ticket), Refund: refund})
+}
+
+// recordSectionEvent records a section change such as created or resized. Callers hold t.mu.
+func (t *trainServer) recordSectionEvent(change string, section *pb.Section) {
+	t.recordEvent(&pb.DomainEvent{Type: domainSectionChanged, AggregateID: section.SectionID, Section: proto.Clone(section).(*pb.Section), Change: change})
+}
+
+// eventConsumer returns the consumer's committed offset, registering it at the oldest retained
+// event the first time it is seen. Callers hold t.mu for writing.
+func (t *trainServer) eventConsumer(consumerID string, now time.Time) *pb.EventConsumer {
+	consumer, ok := t.eventConsumers[consumerID]
+	if !ok {
+		consumer = &pb.EventConsumer{ConsumerID: consumerID, Offset: t.outboxBase, CreatedOn: now.String(), ModifiedOn: now.String()}
+		t.eventConsumers[consumerID] = consumer
+		t.touch(tableConsumer, consumerID)
+	}
+	return consumer
+}
+
+// consumerView copies a consumer with its current lag. Callers hold t.mu.
+func (t *trainServer) consumerView(consumer *pb.EventConsumer) *pb.EventConsumer {
+	view := proto.Clone(consumer).(*pb.EventConsumer)
+	view.Lag = t.latestSequence() - consumer.Offset
+	return view
+}
+
+// SubscribeEvents streams outbox events to a consumer, starting after its committed offset,
+// and keeps the stream open for new ones. Delivery is at least once: events after the offset
+// are sent again on the next subscription until they are acknowledged with AckEvents. With the
+// wal storage backend only events synced to the log are sent, so none can be lost in a crash.
+// A consumer that falls more than retainEvents behind gets OutOfRange.
+func (t *trainServer) SubscribeEvents(req *pb.SubscribeEventsRequest, stream pb.TrainTicketing_SubscribeEventsServer) error {
+	consumerID := strings.TrimSpace(req.ConsumerID)
+	if consumerID == "" {
+		return status.Error(codes.InvalidArgument, "Provide consumer id")
+	} else if req.FromSequence < 0 {
+		return status.Error(codes.InvalidArgument, "From sequence can not be negative")
+	}
+	types := map[string]bool{}
+	for _, eventType := range req.EventTypes {
+		if !slices.Contains(domainEventTypes, eventType) {
+			return status.Error(codes.InvalidArgument, "Unknown event type "+eventType)
+		}
+		types[eventType] = true
+	}
+	t.mu.Lock()
+	next := t.eventConsumer(consumerID, time.Now()).Offset
+	t.mu.Unlock()
+	if req.FromSequence > 0 {
+		next = req.FromSequence - 1
+	}
+
+	for {
+		// Recorded events are never modified, so they can be sent after the lock is released.
+		t.mu.RLock()
+		if next < t.outboxBase {
+			err := t.errNotRetained()
+			t.mu.RUnlock()
+			return err
+		}
+		durable := t.durableSequence()
+		batch := t.outbox[min(next, durable)-t.outboxBase : durable-t.outboxBase]
+		changed := t.outboxChanged
+		t.mu.RUnlock()
+		for _, e := range batch {
+			if len(types) == 0 || types[e.Type] {
+				if err := stream.Send(e); err != nil {
+					return err
+				}
+			}
+			next = e.Sequence
+		}
+		if len(batch) > 0 {
+			continue
+		}
+		select {
+		case <-changed:
+		case <-t.closing:
+			return status.Error(codes.Unavailable, "Server is shutting down")
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		}
+	}
+}
+
+// AckEvents commits a consumer's offset. Offsets only move forward, so a late acknowledgement
+// of an earlier event is ignored.
+func (t *trainServer) AckEvents(ctx context.Context, req *pb.AckEventsRequest) (*pb.EventConsumer, error) {
+	consumerID := strings.TrimSpace(req.ConsumerID)
+	if consumerID == "" {
+		return nil, status.Error(codes.InvalidArgument, "Provide consumer id")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if req.Sequence < 0 || req.Sequence > t.durableSequence() {
+		return nil, status.Error(codes.InvalidArgument, "Sequence has not been recorded")
+	}
+	now := time.Now()
+	consumer := t.eventConsumer(consumerID, now)
+	if req.Sequence > consumer.Offset {
+		consumer.Offset = req.Sequence
+		consumer.ModifiedOn = now.String()
+		t.touch(tableConsumer, consumerID)
+	}
+	return t.consumerView(consumer), nil
+}
+
+// ViewEventConsumers lists the consumers with their offsets and lag, by consumer id.
+func (t *trainServer) ViewEventConsumers(ctx context.Context, req *pb.EmptyResponse) (*pb.EventConsumers, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	consumers := &pb.EventConsumers{LatestSequence: t.latestSequence()}
+	for _, consumer := range t.eventConsumers {
+		consumers.Consumers = append(consumers.Consumers, t.consumerView(consumer))
+	}
+	sort.Slice(consumers.Consumers, func(i, j int) bool {
+		return consumers.Consumers[i].ConsumerID < consumers.Consumers[j].ConsumerID
+	})
+	return consumers, nil
+}
+
+// closeEventStreams ends every SubscribeEvents stream so a graceful stop does not wait on them.
+func (t *trainServer) closeEventStreams() {
+	close(t.closing)
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// eventStream is a SubscribeEvents stream that hands each sent event to the test.
+type eventStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.DomainEvent
+}
+
+func (s *eventStream) Context() context.Context { return s.ctx }
+
+func (s *eventStream) Send(e *pb.DomainEvent) error {
+	select {
+	case s.events <- e:
+		return nil
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+// subscribe starts a SubscribeEvents call; cancel ends it and returns its error.
+func subscribe(s *trainServer, req *pb.SubscribeEventsRequest) (*eventStream, func() error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &eventStream{ctx: ctx, events: make(chan *pb.DomainEvent)}
+	done := make(chan error, 1)
+	go func() { done <- s.SubscribeEvents(req, stream) }()
+	return stream, func() error {
+		cancel()
+		return <-done
+	}
+}
+
+func (s *eventStream) next(t *testing.T) *pb.DomainEvent {
+	t.Helper()
+	select {
+	case e := <-s.events:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for an event")
+		return nil
+	}
+}
+
+func (s *eventStream) types(t *testing.T, n int) string {
+	t.Helper()
+	types := []string{}
+	for i := 0; i < n; i++ {
+		types = append(types, s.next(t).Type)
+	}
+	return strings.Join(types, " ")
+}
+
+func TestOutbox(t *testing.T) {
+	t.Run("DomainEvents", testOutboxDomainEvents)
+	t.Run("ConsumerOffsets", testOutboxConsumerOffsets)
+	t.Run("Filter", testOutboxFilter)
+	t.Run("Shutdown", testOutboxShutdown)
+	t.Run("Retention", testOutboxRetention)
+}
+func testOutboxDomainEvents(t *testing.T) {
+	s := setupTestServer()
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	ticket, _ := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: user.UserID, Section: section.SectionID, SeatNumber: 7, Version: ticket.Version})
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: user.UserID})
+	// Failed mutations record nothing.
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: "nobody", PricePaid: usd(1000)})
+	s.RemoveUser(context.Background(), &pb.UseRequest{UserID: user.UserID})
+
+	stream, cancel := subscribe(s, &pb.SubscribeEventsRequest{ConsumerID: "analytics"})
+	defer cancel()
+	want := []string{"SectionChanged", "UserCreated", "TicketPurchased", "SeatModified", "TicketCancelled", "UserRemoved"}
+	for i, eventType := range want {
+		e := stream.next(t)
+		if e.Type != eventType || e.Sequence != int64(i+1) || e.EventID == "" {
+			t.Fatalf("Expected %s at sequence %d, got %s at %d", eventType, i+1, e.Type, e.Sequence)
+		}
+		switch e.Type {
+		case "SectionChanged":
+			if e.Change != "created" || e.Section.SectionID != section.SectionID || e.AggregateID != section.SectionID {
+				t.Errorf("Expected the created section, got %v", e)
+			}
+		case "SeatModified":
+			if e.Ticket.SeatNumber != 7 || e.AggregateID != ticket.TicketId {
+				t.Errorf("Expected the ticket after the move, got %v", e.Ticket)
+			}
+		case "TicketCancelled":
+			if e.Refund.GetMinorUnits() != 1000 {
+				t.Errorf("Expected the refund on the cancellation, got %v", e.Refund)
+			}
+		}
+	}
+
+	// Events hold the state at the time of the change, not later state.
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 10})
+	if e := stream.next(t); e.Type != "SectionChanged" || e.Sequence != 7 || e.Section.Section != "B" {
+		t.Errorf("Expected the new section to be streamed live, got %v", e)
+	}
+	s.mu.RLock()
+	purchased := s.outbox[2].Ticket.SeatNumber
+	s.mu.RUnlock()
+	if purchased == 7 {
+		t.Errorf("Expected TicketPurchased to keep the original seat")
+	}
+}
+func testOutboxConsumerOffsets(t *testing.T) {
+	s := setupTestServer()
+	createPassenger(t, s, "first@gmail.com")
+	createPassenger(t, s, "second@gmail.com")
+	createPassenger(t, s, "third@gmail.com")
+
+	stream, cancel := subscribe(s, &pb.SubscribeEventsRequest{ConsumerID: "crm"})
+	first := stream.next(t)
+	stream.next(t)
+	if consumer, err := s.AckEvents(context.Background(), &pb.AckEventsRequest{ConsumerID: "crm", Sequence: 2}); err != nil || consumer.Offset != 2 || consumer.Lag != 1 {
+		t.Fatalf("Expected offset 2 with lag 1, got %v, %v", consumer, err)
+	}
+	if err := cancel(); status.Code(err) != codes.Canceled {
+		t.Errorf("Expected the stream to end as cancelled, got %v", err)
+	}
+
+	// A reconnecting consumer resumes after its committed offset.
+	stream, cancel = subscribe(s, &pb.SubscribeEventsRequest{ConsumerID: "crm"})
+	if e := stream.next(t); e.Sequence != 3 {
+		t.Errorf("Expected to resume at sequence 3, got %d", e.Sequence)
+	}
+	cancel()
+	if consumer, _ := s.AckEvents(context.Background(), &pb.AckEventsRequest{ConsumerID: "crm", Sequence: 1}); consumer.Offset != 2 {
+		t.Errorf("Expected an earlier ack not to move the offset back, got %d", consumer.Offset)
+	}
+	if _, err := s.AckEvents(context.Background(), &pb.AckEventsRequest{ConsumerID: "crm", Sequence: 4}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected an ack beyond the outbox to be rejected, got %v", err)
+	}
+
+	// A replay does not change the committed offset.
+	stream, cancel = subscribe(s, &pb.SubscribeEventsRequest{ConsumerID: "crm", FromSequence: 1})
+	if e := stream.next(t); e.EventID != first.EventID {
+		t.Errorf("Expected the replay to start with the first event")
+	}
+	cancel()
+	consumers, _ := s.ViewEventConsumers(context.Background(), &pb.EmptyResponse{})
+	if consumers.LatestSequence != 3 || len(consumers.Consumers) != 1 || consumers.Consumers[0].Offset != 2 {
+		t.Errorf("Expected the crm consumer at offset 2 of 3, got %v", consumers)
+	}
+}
+func testOutboxFilter(t *testing.T) {
+	s := setupTestServer()
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: user.UserID})
+
+	stream, cancel := subscribe(s, &pb.SubscribeEventsRequest{ConsumerID: "finance", EventTypes: []string{"TicketPurchased", "TicketCancelled"}})
+	defer cancel()
+	if got := stream.types(t, 2); got != "TicketPurchased TicketCancelled" {
+		t.Errorf("Expected only ticket events, got %q", got)
+	}
+	err := s.SubscribeEvents(&pb.SubscribeEventsRequest{ConsumerID: "finance", EventTypes: []string{"ticket.booked"}}, &eventStream{ctx: context.Background()})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected an unknown event type to be rejected, got %v", err)
+	}
+}
+func testOutboxShutdown(t *testing.T) {
+	s := setupTestServer()
+	done := make(chan error, 1)
+	go func() {
+		done <- s.SubscribeEvents(&pb.SubscribeEventsRequest{ConsumerID: "crm"}, &eventStream{ctx: context.Background()})
+	}()
+	s.closeEventStreams()
+	select {
+	case err := <-done:
+		if status.Code(err) != codes.Unavailable {
+			t.Errorf("Expected the stream to end as unavailable, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the stream to end on shutdown")
+	}
+}
+func testOutboxRetention(t *testing.T) {
+	s := setupTestServer()
+	s.retainEvents = 4
+	s.AckEvents(context.Background(), &pb.AckEventsRequest{ConsumerID: "slow"})
+	for i := 0; i < 10; i++ {
+		createPassenger(t, s, "user"+strconv.Itoa(i)+"@gmail.com")
+	}
+	if len(s.outbox) != 4 || s.outboxBase != 6 || s.outbox[0].Sequence != 7 {
+		t.Fatalf("Expected only events 7 to 10 to be kept, got %d after %d", len(s.outbox), s.outboxBase)
+	}
+
+	// A consumer that fell behind is told, and a new one starts at the oldest event kept.
+	err := s.SubscribeEvents(&pb.SubscribeEventsRequest{ConsumerID: "slow"}, &eventStream{ctx: context.Background()})
+	if status.Code(err) != codes.OutOfRange {
+		t.Errorf("Expected OutOfRange for trimmed events, got %v", err)
+	}
+	stream, cancel := subscribe(s, &pb.SubscribeEventsRequest{ConsumerID: "fresh"})
+	if e := stream.next(t); e.Sequence != 7 {
+		t.Errorf("Expected a new consumer to start at sequence 7, got %d", e.Sequence)
+	}
+	cancel()
+
+	if _, err := s.ViewStateAt(context.Background(), &pb.StateAtRequest{Sequence: 5}); status.Code(err) != codes.OutOfRange {
+		t.Errorf("Expected OutOfRange for the state before the oldest event kept, got %v", err)
+	}
+	for sequence, users := range map[int64]int{6: 6, 8: 8, 10: 10} {
+		state, err := s.ViewStateAt(context.Background(), &pb.StateAtRequest{Sequence: sequence})
+		if err != nil || len(state.Users) != users {
+			t.Errorf("Expected %d users after event %d, got %v, %v", users, sequence, state, err)
+		}
+	}
+}
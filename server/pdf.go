@@ -0,0 +1,105 @@
+// pdf.go
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"rsc.io/qr"
+)
+
+// A4 page layout for PDF receipts, in points.
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 50
+	pdfFontSize     = 10
+	pdfLineHeight   = 14
+	pdfQRModuleSize = 3
+)
+
+// pdfString escapes text for a PDF literal string. The built-in Courier font only covers
+// Latin-1, so other characters are replaced.
+func pdfString(s string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\t':
+			b.WriteString("    ")
+		case r < 0x20 || r > 0xff:
+			b.WriteByte('?')
+		default:
+			b.WriteByte(byte(r))
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// renderPDF lays out lines of monospaced text over as many A4 pages as needed and draws
+// the QR code below the text on the last page, starting a new page if it does not fit.
+func renderPDF(lines []string, code *qr.Code) []byte {
+	perPage := (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+	qrHeight := code.Size * pdfQRModuleSize
+	pages := []string{}
+	for len(lines) > 0 || len(pages) == 0 {
+		n := min(perPage, len(lines))
+		var content strings.Builder
+		fmt.Fprintf(&content, "BT /F1 %d Tf %d TL %d %d Td\n", pdfFontSize, pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range lines[:n] {
+			fmt.Fprintf(&content, "%s Tj T*\n", pdfString(line))
+		}
+		content.WriteString("ET\n")
+		lines = lines[n:]
+		if len(lines) == 0 {
+			top := pdfPageHeight - pdfMargin - (n+1)*pdfLineHeight
+			if top-qrHeight < pdfMargin {
+				pages = append(pages, content.String())
+				content.Reset()
+				top = pdfPageHeight - pdfMargin
+			}
+			for y := 0; y < code.Size; y++ {
+				for x := 0; x < code.Size; x++ {
+					if code.Black(x, y) {
+						fmt.Fprintf(&content, "%d %d %d %d re\n", pdfMargin+x*pdfQRModuleSize, top-(y+1)*pdfQRModuleSize, pdfQRModuleSize, pdfQRModuleSize)
+					}
+				}
+			}
+			content.WriteString("f\n")
+		}
+		pages = append(pages, content.String())
+	}
+
+	// Objects: 1 catalog, 2 page tree, 3 font, then a page and its content stream per page.
+	var out bytes.Buffer
+	offsets := []int{}
+	object := func(body string) {
+		offsets = append(offsets, out.Len())
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	for i, content := range pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pdfPageWidth, pdfPageHeight, 5+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", len(content), content))
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return out.Bytes()
+}
@@ -0,0 +1,672 @@
+// persistence.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+	"project/ticketbook/wal"
+)
+
+// Tables of server state persisted alongside the domain events, which already describe the
+// users, sections, tickets and seat allocations.
+const (
+	tableBlock        = "block"
+	tableHold         = "hold"
+	tablePromotion    = "promotion"
+	tableRedemption   = "redemption"
+	tableLedger       = "ledger"
+	tableExchangeRate = "exchange_rate"
+	tableInvoice      = "invoice"
+	tableUserInvoices = "user_invoices"
+	tableCounter      = "counter"
+	tableConsumer     = "consumer"
+	tableWebhook      = "webhook"
+	tableDeadLetter   = "dead_letter"
+
+	counterInvoice    = "invoice"
+	counterCreditNote = "credit_note"
+)
+
+// snapshotChunk is how many events or entries go in one record of a compacted snapshot.
+const snapshotChunk = 1000
+
+type stateKey struct{ table, key string }
+
+// restoreFunc rebuilds server state from one stored record.
+type restoreFunc func(record *pb.WALRecord) error
+
+// storageBackend durably stores the records persist collects; see walBackend and postgresBackend.
+type storageBackend interface {
+	// write stores the records, in order, as one atomic change.
+	write(records []*pb.WALRecord) error
+	// wantsSnapshot reports whether the records stored so far should be compacted into a snapshot.
+	wantsSnapshot() bool
+	// snapshot replaces everything stored with records describing the whole state.
+	snapshot(records []*pb.WALRecord) error
+	// healthy returns why records can not be stored now, nil if they can.
+	healthy(ctx context.Context) error
+	close() error
+}
+
+// trimmingBackend is a backend that keeps every event written to it until told that the
+// events before a base snapshot are no longer retained. Other backends drop them on compaction.
+type trimmingBackend interface {
+	storageBackend
+	// trim replaces the stored events up to the base snapshot's sequence with the snapshot.
+	trim(base []*pb.WALRecord) error
+}
+
+// reloadableBackend is a backend shared with other servers. When it rejects a write that
+// conflicts with one of theirs, the server reloads its state from it.
+type reloadableBackend interface {
+	storageBackend
+	load(restore restoreFunc) error
+}
+
+// callBackend is a shared backend that takes part in each call: the interceptor brackets the
+// handler and the write of its changes with beginCall and endCall, and calls run one at a time.
+type callBackend interface {
+	storageBackend
+	beginCall()
+	// endCall ends the call, returning the first error of the queries it made.
+	endCall() error
+}
+
+// seatClaimer is a shared backend that arbitrates seat allocation between the servers using it.
+type seatClaimer interface {
+	// claimSeats reserves the seats of a section for userID until the call's changes are
+	// stored, reporting false if another server allocated or is allocating any of them.
+	claimSeats(sectionID string, seats []int32, userID string) bool
+}
+
+// invoiceNumberer is a shared backend that numbers invoices and credit notes for every server
+// using it, so no two servers issue the same number.
+type invoiceNumberer interface {
+	// nextNumber takes the number after both the counter's stored number and last, the
+	// server's own, until the call's changes are stored. A failed query fails the call.
+	nextNumber(counter string, last int64) int64
+}
+
+// errStaleState is returned by a shared backend that stored a write after changes of other
+// servers this one has not seen; the server reloads to catch up with them.
+var errStaleState = errors.New("stored after changes not yet loaded")
+
+// storeConflict is returned by a backend that rejected a write because it breaks a constraint
+// of the stored state, such as a seat another server sharing the database allocated first.
+type storeConflict struct {
+	code    codes.Code
+	message string // what the client is told, as the in-memory check would have said it
+	err     error
+}
+
+func (c *storeConflict) Error() string { return c.message + ": " + c.err.Error() }
+func (c *storeConflict) Unwrap() error { return c.err }
+
+// stateStore persists the server state to a storage backend. Handlers mark the entries they
+// change with touch; after each call persist writes the events recorded and the entries marked
+// since the previous call as one record, stored before the response is sent.
+type stateStore struct {
+	backend   storageBackend
+	conflicts atomic.Int64 // writes rejected by a shared backend, after which the state was reloaded
+
+	mu      sync.Mutex    // serialises persist and compaction
+	trimmed int64         // sequence of the last base snapshot given to a trimmingBackend
+	failed  chan struct{} // closed when the store stops after a write it could not recover from
+	failure error         // why it stopped, set before failed is closed
+
+	// Guarded by trainServer.mu; written holds both locks to change.
+	dirty   map[stateKey]bool
+	sends   []func() // notifications about changes not yet stored
+	written int64    // events collected into a record being stored, or stored
+	durable int64    // events stored
+}
+
+// conflictCount returns how many writes the backend rejected, 0 without a backend.
+func (s *stateStore) conflictCount() int64 {
+	if s == nil {
+		return 0
+	}
+	return s.conflicts.Load()
+}
+
+// broken returns why the store stopped, nil while it works or without a backend.
+func (s *stateStore) broken() error {
+	if s == nil {
+		return nil
+	}
+	select {
+	case <-s.failed:
+		return s.failure
+	default:
+		return nil
+	}
+}
+
+// storeFailed is closed when the store stops; it is nil, and never ready, without one.
+func (t *trainServer) storeFailed() <-chan struct{} {
+	if t.store == nil {
+		return nil
+	}
+	return t.store.failed
+}
+
+// sharedStore reports whether the state is stored in a backend shared with other servers.
+func (t *trainServer) sharedStore() bool {
+	if t.store == nil {
+		return false
+	}
+	_, ok := t.store.backend.(reloadableBackend)
+	return ok
+}
+
+// touch marks an entry changed so the next persist writes its value. Callers hold t.mu.
+func (t *trainServer) touch(table, key string) {
+	if t.store != nil {
+		t.store.dirty[stateKey{table, key}] = true
+	}
+}
+
+// afterStore runs send once the changes made so far are stored, so passengers and subscribers
+// are never told about a change that is then rejected. Without a backend it runs at once.
+// Callers hold t.mu.
+func (t *trainServer) afterStore(send func()) {
+	if t.store == nil {
+		send()
+		return
+	}
+	t.store.sends = append(t.store.sends, send)
+}
+
+// durableSequence is the sequence of the last event that survives a crash; events after it
+// are not streamed yet. Callers hold t.mu.
+func (t *trainServer) durableSequence() int64 {
+	if t.store == nil {
+		return t.latestSequence()
+	}
+	return t.store.durable
+}
+
+// stateEntries returns a copy of the current value of an entry, marked deleted when it no
+// longer exists. A redemption entry expands to one per user of the code. Callers hold t.mu.
+func (t *trainServer) stateEntries(k stateKey) []*pb.StateEntry {
+	e := &pb.StateEntry{Table: k.table, Key: k.key}
+	ok := false
+	switch k.table {
+	case tableBlock:
+		e.Block, ok = t.blockedSeats[k.key]
+	case tableHold:
+		e.Hold, ok = t.holds[k.key]
+	case tablePromotion:
+		e.Promotion, ok = t.promotions[k.key]
+	case tableRedemption:
+		entries := []*pb.StateEntry{}
+		for userid, count := range t.redemptions[k.key] {
+			entries = append(entries, &pb.StateEntry{Table: k.table, Key: k.key, UserID: userid, Count: int64(count)})
+		}
+		return entries
+	case tableLedger:
+		e.Ledger, ok = t.ledger[k.key]
+	case tableExchangeRate:
+		e.ExchangeRate, ok = t.exchangeRates[k.key]
+	case tableInvoice:
+		e.Invoice, ok = t.invoices[k.key]
+	case tableUserInvoices:
+		e.InvoiceNumbers, ok = t.userInvoices[k.key]
+	case tableCounter:
+		e.Count, ok = t.invoiceSeq, true
+		if k.key == counterCreditNote {
+			e.Count = t.creditNoteSeq
+		}
+	case tableConsumer:
+		e.Consumer, ok = t.eventConsumers[k.key]
+	case tableWebhook, tableDeadLetter:
+		return []*pb.StateEntry{t.webhooks.stateEntry(k)}
+	}
+	e.Deleted = !ok
+	return []*pb.StateEntry{proto.Clone(e).(*pb.StateEntry)}
+}
+
+// stateKeys lists every entry of the server state for a compacted snapshot. Callers hold t.mu.
+func (t *trainServer) stateKeys() []stateKey {
+	keys := []stateKey{{tableCounter, counterInvoice}, {tableCounter, counterCreditNote}}
+	add := func(table string, key string) { keys = append(keys, stateKey{table, key}) }
+	for key := range t.blockedSeats {
+		add(tableBlock, key)
+	}
+	for key := range t.holds {
+		add(tableHold, key)
+	}
+	for key := range t.promotions {
+		add(tablePromotion, key)
+	}
+	for key := range t.redemptions {
+		add(tableRedemption, key)
+	}
+	for key := range t.ledger {
+		add(tableLedger, key)
+	}
+	for key := range t.exchangeRates {
+		add(tableExchangeRate, key)
+	}
+	for key := range t.invoices {
+		add(tableInvoice, key)
+	}
+	for key := range t.userInvoices {
+		add(tableUserInvoices, key)
+	}
+	for key := range t.eventConsumers {
+		add(tableConsumer, key)
+	}
+	return append(keys, t.webhooks.stateKeys()...)
+}
+
+// restoreEntry sets an entry read back from the log. Callers hold t.mu.
+func (t *trainServer) restoreEntry(e *pb.StateEntry) {
+	switch e.Table {
+	case tableBlock:
+		if e.Deleted {
+			delete(t.blockedSeats, e.Key)
+		} else {
+			t.blockedSeats[e.Key] = e.Block
+		}
+	case tableHold:
+		if e.Deleted {
+			delete(t.holds, e.Key)
+		} else {
+			t.holds[e.Key] = e.Hold
+		}
+	case tablePromotion:
+		if e.Deleted {
+			delete(t.promotions, e.Key)
+		} else {
+			t.promotions[e.Key] = e.Promotion
+		}
+	case tableRedemption:
+		if t.redemptions[e.Key] == nil {
+			t.redemptions[e.Key] = make(map[string]int32)
+		}
+		t.redemptions[e.Key][e.UserID] = int32(e.Count)
+	case tableLedger:
+		if e.Deleted {
+			delete(t.ledger, e.Key)
+		} else {
+			t.ledger[e.Key] = e.Ledger
+		}
+	case tableExchangeRate:
+		if e.Deleted {
+			delete(t.exchangeRates, e.Key)
+		} else {
+			t.exchangeRates[e.Key] = e.ExchangeRate
+		}
+	case tableInvoice:
+		if e.Deleted {
+			delete(t.invoices, e.Key)
+		} else {
+			t.invoices[e.Key] = e.Invoice
+		}
+	case tableUserInvoices:
+		if e.Deleted {
+			delete(t.userInvoices, e.Key)
+		} else {
+			t.userInvoices[e.Key] = e.InvoiceNumbers
+		}
+	case tableCounter:
+		if e.Key == counterCreditNote {
+			t.creditNoteSeq = e.Count
+		} else {
+			t.invoiceSeq = e.Count
+		}
+	case tableConsumer:
+		if e.Deleted {
+			delete(t.eventConsumers, e.Key)
+		} else {
+			t.eventConsumers[e.Key] = e.Consumer
+		}
+	case tableWebhook, tableDeadLetter:
+		t.webhooks.restoreEntry(e)
+	}
+}
+
+// restoreState rebuilds the state of a new server from the records load passes to its
+// restore function. Callers hold t.mu.
+func (t *trainServer) restoreState(load func(restore restoreFunc) error) error {
+	var base *pb.BookingSnapshot
+	events := []*pb.DomainEvent{}
+	err := load(func(record *pb.WALRecord) error {
+		// A base snapshot may be split across records; a later one replaces an earlier one.
+		if part := record.Base; part != nil && base != nil && base.Sequence == part.Sequence {
+			base.Users = append(base.Users, part.Users...)
+			base.Sections = append(base.Sections, part.Sections...)
+			base.Tickets = append(base.Tickets, part.Tickets...)
+		} else if part != nil {
+			base = part
+		}
+		events = append(events, record.Events...)
+		for _, entry := range record.Entries {
+			t.restoreEntry(entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, h := range t.holds {
+		t.heldSeats[seatKey(h.Section, h.SeatNumber)] = h.HoldID
+		if h.CompanionSeat != 0 {
+			t.heldSeats[seatKey(h.Section, h.CompanionSeat)] = h.HoldID
+		}
+	}
+	return t.replayLog(base, events)
+}
+
+// startStore persists every later change to a backend the state was restored from. Callers hold t.mu.
+func (t *trainServer) startStore(backend storageBackend) {
+	sequence := t.latestSequence()
+	t.store = &stateStore{backend: backend, dirty: make(map[stateKey]bool), written: sequence, durable: sequence, trimmed: t.outboxBase, failed: make(chan struct{})}
+	t.webhooks.track()
+}
+
+// walBackend stores records in a write-ahead log, compacted every compactEvery records.
+type walBackend struct {
+	log          *wal.Log
+	compactEvery int
+}
+
+func openWALBackend(dir string, compactEvery int, restore restoreFunc) (*walBackend, wal.Recovery, error) {
+	log, recovery, err := wal.Open(dir, func(data []byte) error {
+		record := &pb.WALRecord{}
+		if err := proto.Unmarshal(data, record); err != nil {
+			return err
+		}
+		return restore(record)
+	})
+	if err != nil {
+		return nil, recovery, err
+	}
+	return &walBackend{log: log, compactEvery: compactEvery}, recovery, nil
+}
+
+func (b *walBackend) write(records []*pb.WALRecord) error {
+	data, err := marshalRecords(records)
+	if err != nil {
+		return err
+	}
+	return b.log.Append(data...)
+}
+
+func (b *walBackend) wantsSnapshot() bool { return b.log.Records() >= b.compactEvery }
+
+func (b *walBackend) snapshot(records []*pb.WALRecord) error {
+	data, err := marshalRecords(records)
+	if err != nil {
+		return err
+	}
+	return b.log.Snapshot(data)
+}
+
+func (b *walBackend) healthy(ctx context.Context) error {
+	if !b.log.Writable() {
+		return errors.New("write-ahead log is closed")
+	}
+	return nil
+}
+
+func (b *walBackend) close() error { return b.log.Close() }
+
+func marshalRecords(records []*pb.WALRecord) ([][]byte, error) {
+	data := make([][]byte, 0, len(records))
+	for _, record := range records {
+		b, err := proto.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, b)
+	}
+	return data, nil
+}
+
+// openWALStore restores a new server from the write-ahead log in dir, truncating a record torn
+// by a crash, and persists every later change to it. Call it before the server handles requests.
+func (t *trainServer) openWALStore(dir string, compactEvery int) (wal.Recovery, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var backend *walBackend
+	var recovery wal.Recovery
+	err := t.restoreState(func(restore restoreFunc) (err error) {
+		backend, recovery, err = openWALBackend(dir, compactEvery, restore)
+		return err
+	})
+	if err != nil {
+		if backend != nil {
+			backend.close()
+		}
+		return recovery, err
+	}
+	t.startStore(backend)
+	return recovery, nil
+}
+
+// persist stores the events recorded and the entries changed since the last call, sends the
+// notifications about them, then compacts the stored records when the backend wants it. It
+// does nothing without a backend. A failed write is handled by writeFailed, and a write stored
+// after changes of other servers reloads the state; once the store has stopped, persist only
+// returns why.
+func (t *trainServer) persist() error {
+	s := t.store
+	if s == nil {
+		return nil
+	}
+	t.mu.Lock()
+	for _, k := range t.webhooks.takeChanged() {
+		s.dirty[k] = true
+	}
+	idle := len(s.dirty) == 0 && len(s.sends) == 0 && s.written == t.latestSequence()
+	t.mu.Unlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.broken(); err != nil || idle {
+		return err
+	}
+
+	t.mu.Lock()
+	record := &pb.WALRecord{Events: t.outbox[s.written-t.outboxBase:]}
+	for k := range s.dirty {
+		record.Entries = append(record.Entries, t.stateEntries(k)...)
+	}
+	sequence := t.latestSequence()
+	sends := s.sends
+	s.dirty, s.sends = make(map[stateKey]bool), nil
+	s.written = sequence
+	t.mu.Unlock()
+	if len(record.Events) > 0 || len(record.Entries) > 0 {
+		if err := s.backend.write([]*pb.WALRecord{record}); errors.Is(err, errStaleState) {
+			if err := t.reload(); err != nil {
+				return s.stop(fmt.Errorf("reload after a write: %w", err))
+			}
+		} else if err != nil {
+			return t.writeFailed(err)
+		} else {
+			t.markDurable(sequence)
+		}
+	}
+	for _, send := range sends {
+		send()
+	}
+
+	if backend, ok := s.backend.(trimmingBackend); ok {
+		t.mu.RLock()
+		base := t.baseProjection
+		t.mu.RUnlock()
+		if base.sequence > s.trimmed {
+			if err := backend.trim(base.snapshotRecords()); err != nil {
+				slog.Warn("failed to trim the stored events", "error", err)
+			} else {
+				s.trimmed = base.sequence
+			}
+		}
+	}
+
+	if s.backend.wantsSnapshot() {
+		if err := t.compact(); err != nil {
+			slog.Warn("failed to compact the stored state", "error", err)
+		}
+	}
+	return nil
+}
+
+// writeFailed handles a record the backend did not store, whose changes are already applied in
+// memory; nobody is told about them. A shared backend is reloaded, which drops the changes, so
+// that a retry starts afresh. Otherwise what reached the backend is unknown and the changes
+// can not be undone, so the store stops: every later call fails, and the server is expected
+// to stop serving. Callers hold t.store.mu.
+func (t *trainServer) writeFailed(err error) error {
+	s := t.store
+	if _, ok := s.backend.(reloadableBackend); ok {
+		s.conflicts.Add(1)
+		rerr := t.reload()
+		if rerr == nil {
+			return err
+		}
+		err = fmt.Errorf("reload after a failed write: %w", rerr)
+	}
+	return s.stop(err)
+}
+
+// stop stops the store because of err, which it returns. Callers hold s.mu.
+func (s *stateStore) stop(err error) error {
+	s.failure = err
+	close(s.failed)
+	return err
+}
+
+// reload replaces the state with the one stored by a shared backend, dropping the changes it
+// rejected. Callers hold t.store.mu.
+func (t *trainServer) reload() error {
+	backend, ok := t.store.backend.(reloadableBackend)
+	if !ok {
+		return errors.New("Storage backend can not be reloaded")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.resetState()
+	if err := t.restoreState(backend.load); err != nil {
+		return err
+	}
+	sequence := t.latestSequence()
+	t.store.dirty, t.store.sends = make(map[stateKey]bool), nil
+	t.store.written, t.store.durable, t.store.trimmed = sequence, sequence, t.outboxBase
+	t.trimOutbox()
+	close(t.outboxChanged)
+	t.outboxChanged = make(chan struct{})
+	return nil
+}
+
+// markDurable lets event streams deliver the events up to sequence, and trims the outbox now
+// that they may go.
+func (t *trainServer) markDurable(sequence int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if sequence > t.store.durable {
+		t.store.durable = sequence
+		t.trimOutbox()
+		close(t.outboxChanged)
+		t.outboxChanged = make(chan struct{})
+	}
+}
+
+// compact replaces the stored records with a snapshot of the whole state. Entries changed
+// meanwhile stay marked and are written again after it. Callers hold t.store.mu.
+func (t *trainServer) compact() error {
+	s := t.store
+	records := []*pb.WALRecord{}
+	t.mu.RLock()
+	sequence := t.latestSequence()
+	if t.outboxBase > 0 {
+		records = append(records, t.baseProjection.snapshotRecords()...)
+	}
+	for i := 0; i < len(t.outbox); i += snapshotChunk {
+		records = append(records, &pb.WALRecord{Events: t.outbox[i:min(i+snapshotChunk, len(t.outbox))]})
+	}
+	keys := t.stateKeys()
+	for i := 0; i < len(keys); i += snapshotChunk {
+		record := &pb.WALRecord{}
+		for _, k := range keys[i:min(i+snapshotChunk, len(keys))] {
+			record.Entries = append(record.Entries, t.stateEntries(k)...)
+		}
+		records = append(records, record)
+	}
+	t.mu.RUnlock()
+
+	if err := s.backend.snapshot(records); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	s.written = sequence
+	t.mu.Unlock()
+	t.markDurable(sequence)
+	return nil
+}
+
+// closeStore writes what changed since the last call, such as holds expired in the
+// background, and closes the backend.
+func (t *trainServer) closeStore() error {
+	if t.store == nil {
+		return nil
+	}
+	err := t.persist()
+	if cerr := t.store.backend.close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// persistUnaryInterceptor makes each call's changes durable before its response is sent. A
+// call whose changes could not be written fails with Unavailable, and once the store has
+// stopped every call does without being handled. A call whose changes conflicted with another
+// server's fails with the conflict, and so does any call that was in progress, as its changes
+// may have been part of the rejected write. With a backend that takes part in calls they run
+// one at a time, and a call whose queries to it failed fails with Unavailable.
+func (t *trainServer) persistUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if t.store.broken() != nil {
+		return nil, status.Error(codes.Unavailable, "Storage has failed, try another server")
+	}
+	var call callBackend
+	if t.store != nil {
+		call, _ = t.store.backend.(callBackend)
+	}
+	if call != nil {
+		call.beginCall()
+	}
+	conflicts := t.store.conflictCount()
+	resp, err := handler(ctx, req)
+	perr := t.persist()
+	if call != nil {
+		if cerr := call.endCall(); perr == nil {
+			perr = cerr
+		}
+	}
+	if perr != nil {
+		var conflict *storeConflict
+		if errors.As(perr, &conflict) {
+			return nil, status.Error(conflict.code, conflict.message)
+		}
+		slog.Error("failed to persist the change", "method", info.FullMethod, "error", perr)
+		return nil, status.Error(codes.Unavailable, "Could not save the change, try again")
+	}
+	if t.store.conflictCount() != conflicts {
+		return nil, status.Error(codes.Aborted, "A conflicting change was saved by another server, try again")
+	}
+	return resp, err
+}
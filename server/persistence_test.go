@@ -0,0 +1,320 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+func TestPersistence(t *testing.T) {
+	t.Run("Restart", testPersistenceRestart)
+	t.Run("TornTail", testPersistenceTornTail)
+	t.Run("Compaction", testPersistenceCompaction)
+	t.Run("Durability", testPersistenceDurability)
+	t.Run("Retention", testPersistenceRetention)
+}
+
+// openPersistentServer starts a test server on the write-ahead log in dir. The log is closed
+// without a final persist at the end of the test, as after a crash.
+func openPersistentServer(t *testing.T, dir string, compactEvery int) *trainServer {
+	t.Helper()
+	s := setupTestServer()
+	s.loyalty = loyaltyConfig{PointsPerUnit: 1, PointValue: 10, SilverSpend: 15000, GoldSpend: 100000}
+	if _, err := s.openWALStore(dir, compactEvery); err != nil {
+		t.Fatalf("openWALStore failed: %v", err)
+	}
+	t.Cleanup(func() { s.store.backend.close() })
+	return s
+}
+
+// populate changes every kind of persisted state, persisting after each call like the
+// interceptor does.
+func populate(t *testing.T, s *trainServer) {
+	t.Helper()
+	ctx := context.Background()
+	var section *pb.Section
+	var alice, bob, carol *pb.User
+	var ticket *pb.Ticket
+	steps := []func() error{
+		func() (err error) {
+			section, err = s.CreateSection(ctx, &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+			return err
+		},
+		func() error {
+			_, err := s.SetExchangeRate(ctx, &pb.ExchangeRate{CurrencyCode: "EUR", Rate: "0.925"})
+			return err
+		},
+		func() error {
+			_, err := s.CreatePromotion(ctx, &pb.CreatePromotionRequest{Code: "TENOFF", Type: pb.DiscountType_FIXED, Amount: usd(100)})
+			return err
+		},
+		func() error {
+			alice, bob, carol = createPassenger(t, s, "alice@gmail.com"), createPassenger(t, s, "bob@gmail.com"), createPassenger(t, s, "carol@gmail.com")
+			return nil
+		},
+		func() (err error) {
+			ticket, err = s.PurchaseTicket(ctx, &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: alice.UserID, PricePaid: usd(1000), PromoCode: "TENOFF"})
+			return err
+		},
+		func() error {
+			_, err := s.PurchaseTicket(ctx, &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: bob.UserID, PricePaid: usd(1000)})
+			return err
+		},
+		func() error {
+			_, err := s.CancelReceipt(ctx, &pb.CancelReceiptRequest{UserID: bob.UserID})
+			return err
+		},
+		func() error {
+			_, err := s.PurchaseTicket(ctx, &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: bob.UserID, PricePaid: usd(1000)})
+			return err
+		},
+		func() error {
+			_, err := s.HoldSeat(ctx, &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: carol.UserID, PricePaid: usd(1000)})
+			return err
+		},
+		func() error {
+			_, err := s.BlockSeats(ctx, &pb.BlockSeatsRequest{SectionID: section.SectionID, SeatNumbers: []int32{9}, Reason: "Broken"})
+			return err
+		},
+		func() error {
+			_, err := s.ModifySeat(ctx, &pb.ModifySeatRequest{UserID: alice.UserID, Section: section.SectionID, SeatNumber: 5, Version: ticket.Version})
+			return err
+		},
+		func() error {
+			_, err := s.AckEvents(ctx, &pb.AckEventsRequest{ConsumerID: "billing", Sequence: 3})
+			return err
+		},
+	}
+	for i, step := range steps {
+		if err := step(); err != nil {
+			t.Fatalf("Step %d failed: %v", i+1, err)
+		}
+		if err := s.persist(); err != nil {
+			t.Fatalf("persist after step %d failed: %v", i+1, err)
+		}
+	}
+}
+
+// checkSameState fails unless both servers hold the same bookings and other persisted state.
+func checkSameState(t *testing.T, want, got *trainServer) {
+	t.Helper()
+	checkSameBookings(t, want, got)
+	keys := want.stateKeys()
+	if n := len(got.stateKeys()); n != len(keys) {
+		t.Errorf("Expected %d state entries, got %d", len(keys), n)
+	}
+	for _, k := range keys {
+		a, b := want.stateEntries(k), got.stateEntries(k)
+		for _, entries := range [][]*pb.StateEntry{a, b} {
+			sort.Slice(entries, func(i, j int) bool { return entries[i].UserID < entries[j].UserID })
+		}
+		if !proto.Equal(&pb.WALRecord{Entries: a}, &pb.WALRecord{Entries: b}) {
+			t.Errorf("Expected %s %s to be\n%v\ngot\n%v", k.table, k.key, a, b)
+		}
+	}
+	if len(want.heldSeats) != len(got.heldSeats) {
+		t.Errorf("Expected %d held seats, got %d", len(want.heldSeats), len(got.heldSeats))
+	}
+	if len(want.outbox) != len(got.outbox) {
+		t.Errorf("Expected %d events, got %d", len(want.outbox), len(got.outbox))
+	}
+}
+
+func testPersistenceRestart(t *testing.T) {
+	dir := t.TempDir()
+	s := openPersistentServer(t, dir, 1000)
+	populate(t, s)
+	s.store.backend.close()
+
+	restarted := openPersistentServer(t, dir, 1000)
+	checkSameState(t, s, restarted)
+	if len(restarted.ledger) == 0 || len(restarted.blockedSeats) != 1 || len(restarted.holds) != 1 || restarted.creditNoteSeq != 1 {
+		t.Fatalf("Expected the ledger, block, hold and credit note to be restored")
+	}
+
+	// The restored server carries on numbering and allocating where the old one stopped.
+	dave := createPassenger(t, restarted, "dave@gmail.com")
+	ticket, err := restarted.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: dave.UserID, PricePaid: usd(1000)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket after restart failed: %v", err)
+	}
+	if _, reused := s.invoices[ticket.InvoiceNumber]; reused {
+		t.Errorf("Expected a new invoice number, got %s again", ticket.InvoiceNumber)
+	}
+	if _, taken := s.allocatedSeats[seatKey(ticket.Section, ticket.SeatNumber)]; taken {
+		t.Errorf("Expected a free seat, got seat %d which was already allocated", ticket.SeatNumber)
+	}
+}
+func testPersistenceTornTail(t *testing.T) {
+	dir := t.TempDir()
+	s := openPersistentServer(t, dir, 1000)
+	populate(t, s)
+	logs, _ := filepath.Glob(filepath.Join(dir, "log-*"))
+	if len(logs) != 1 {
+		t.Fatalf("Expected one log file, got %v", logs)
+	}
+	info, _ := os.Stat(logs[0])
+	intact := info.Size()
+	createPassenger(t, s, "last@gmail.com")
+	if err := s.persist(); err != nil {
+		t.Fatalf("persist failed: %v", err)
+	}
+	s.store.backend.close()
+	data, err := os.ReadFile(logs[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// copyLog makes a server directory holding the first n bytes of the log.
+	copyLog := func(n int64) string {
+		d := t.TempDir()
+		if err := os.WriteFile(filepath.Join(d, filepath.Base(logs[0])), data[:n], 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	before := openPersistentServer(t, copyLog(intact), 1000)
+	last := int64(len(data))
+	for _, cut := range []int64{intact + 1, intact + 8, (intact + last) / 2, last - 1} {
+		d := copyLog(cut)
+		restarted := setupTestServer()
+		recovery, err := restarted.openWALStore(d, 1000)
+		if err != nil {
+			t.Fatalf("Cut at %d: openWALStore failed: %v", cut, err)
+		}
+		if recovery.TruncatedBytes != cut-intact {
+			t.Errorf("Cut at %d: expected %d torn bytes truncated, got %d", cut, cut-intact, recovery.TruncatedBytes)
+		}
+		checkSameState(t, before, restarted)
+		restarted.store.backend.close()
+	}
+	restarted := openPersistentServer(t, copyLog(last), 1000)
+	if len(restarted.users) != len(s.users) {
+		t.Errorf("Expected the last change to survive when its record is whole")
+	}
+}
+func testPersistenceCompaction(t *testing.T) {
+	dir := t.TempDir()
+	s := openPersistentServer(t, dir, 4)
+	populate(t, s)
+	if n := s.store.backend.(*walBackend).log.Records(); n >= 4 {
+		t.Errorf("Expected the log to be compacted every 4 records, got %d since the last snapshot", n)
+	}
+	snapshots, _ := filepath.Glob(filepath.Join(dir, "snapshot-*"))
+	files, _ := os.ReadDir(dir)
+	if len(snapshots) != 1 || len(files) != 2 {
+		t.Errorf("Expected only the latest snapshot and its log to be kept, got %d files", len(files))
+	}
+	s.store.backend.close()
+
+	restarted := openPersistentServer(t, dir, 4)
+	checkSameState(t, s, restarted)
+}
+func testPersistenceDurability(t *testing.T) {
+	s := openPersistentServer(t, t.TempDir(), 1000)
+	setupNotifier(t, s, logChannel{})
+	healthServer, stopHealth := health.NewServer(), make(chan struct{})
+	defer close(stopHealth)
+	go s.watchHealth(healthServer, stopHealth)
+	servingStatus := func(service string, want healthpb.HealthCheckResponse_ServingStatus) bool {
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+			if resp, _ := healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service}); resp.GetStatus() == want {
+				return true
+			}
+		}
+		return false
+	}
+	if !servingStatus(pb.TrainTicketing_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING) {
+		t.Fatalf("Expected a server with a writable log to be serving")
+	}
+	if !servingStatus(notificationsService, healthpb.HealthCheckResponse_SERVING) {
+		t.Errorf("Expected notifications to be reported as a separate service")
+	}
+	stream, cancel := subscribe(s, &pb.SubscribeEventsRequest{ConsumerID: "mail"})
+	defer cancel()
+	user := createPassenger(t, s, "test@gmail.com")
+	select {
+	case e := <-stream.events:
+		t.Fatalf("Expected no event before it is synced, got %s", e.Type)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := s.persist(); err != nil {
+		t.Fatalf("persist failed: %v", err)
+	}
+	if e := stream.next(t); e.Type != domainUserCreated {
+		t.Errorf("Expected the synced UserCreated event, got %s", e.Type)
+	}
+
+	// A change that can not be written is not acknowledged.
+	s.store.backend.close()
+	_, err := s.persistUnaryInterceptor(context.Background(), &pb.CreateUserRequest{}, &grpc.UnaryServerInfo{FullMethod: "/train_ticketing.TrainTicketing/CreateUser"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.CreateUser(ctx, &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "other@gmail.com"})
+	})
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected Unavailable when the log can not be written, got %v", err)
+	}
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	_, err = s.persistUnaryInterceptor(context.Background(), &pb.TicketRequest{}, &grpc.UnaryServerInfo{FullMethod: "/train_ticketing.TrainTicketing/PurchaseTicket"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.PurchaseTicket(ctx, &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	})
+	s.notifier.wait()
+	if log, _ := s.ViewNotifications(context.Background(), &pb.UseRequest{UserID: user.UserID}); status.Code(err) != codes.Unavailable || len(log.Deliveries) != 0 {
+		t.Errorf("Expected no booking confirmation for a purchase that was not saved, got %v", log.Deliveries)
+	}
+
+	// The store stops after the failed write, so later calls are not handled at all.
+	select {
+	case <-s.storeFailed():
+	default:
+		t.Fatalf("Expected the store to stop after a failed write")
+	}
+	if _, booked := s.tickets[user.UserID]; booked {
+		t.Errorf("Expected the purchase not to be handled once the store stopped")
+	}
+	if s.ready(context.Background()) == nil || !servingStatus("", healthpb.HealthCheckResponse_NOT_SERVING) {
+		t.Errorf("Expected the server to stop serving once the store stopped")
+	}
+}
+func testPersistenceRetention(t *testing.T) {
+	dir := t.TempDir()
+	open := func() *trainServer {
+		s := setupTestServer()
+		s.loyalty = loyaltyConfig{PointsPerUnit: 1, PointValue: 10, SilverSpend: 15000, GoldSpend: 100000}
+		s.retainEvents = 3
+		if _, err := s.openWALStore(dir, 4); err != nil {
+			t.Fatalf("openWALStore failed: %v", err)
+		}
+		t.Cleanup(func() { s.store.backend.close() })
+		return s
+	}
+	s := open()
+	populate(t, s)
+	if s.outboxBase == 0 || len(s.outbox) != 3 {
+		t.Fatalf("Expected the outbox to keep the latest 3 events, got %d after %d", len(s.outbox), s.outboxBase)
+	}
+	s.store.backend.close()
+
+	// The trimmed events are restored as the snapshot they were folded into.
+	restarted := open()
+	checkSameState(t, s, restarted)
+	if restarted.outboxBase != s.outboxBase {
+		t.Errorf("Expected the retained events to start after %d, got %d", s.outboxBase, restarted.outboxBase)
+	}
+	want, _ := s.ViewStateAt(context.Background(), &pb.StateAtRequest{Sequence: s.outboxBase})
+	got, err := restarted.ViewStateAt(context.Background(), &pb.StateAtRequest{Sequence: s.outboxBase})
+	if err != nil || !proto.Equal(want, got) {
+		t.Errorf("Expected the state at %d to be\n%v\ngot\n%v, %v", s.outboxBase, want, got, err)
+	}
+}
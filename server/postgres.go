@@ -0,0 +1,562 @@
+// postgres.go
+
+package main
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// migrationLock is the advisory lock that stops servers starting together from applying the
+// same migration twice.
+const migrationLock = 0x7469636b6574 // "ticket"
+
+// postgresTimeout bounds a write, which the call being persisted waits for.
+const postgresTimeout = 10 * time.Second
+
+// postgresBackend stores the server state in PostgreSQL. Events are applied to relational
+// tables whose constraints are the arbiter of email and section name uniqueness and of seat
+// allocation for every server sharing the database; a write breaking one is rejected whole.
+// The database numbers the events and invoices, and a call claims the seats it allocates by
+// locking their rows, so servers sharing it neither clash on numbers nor pick the same seat.
+// Other state entries are written only over the version the server loaded.
+type postgresBackend struct {
+	pool     *pgxpool.Pool
+	versions map[entryRef]int64 // the stored version of each state entry, as last loaded or written
+
+	calls  sync.Mutex // held from beginCall to endCall, so calls run one at a time
+	inCall bool
+	tx     pgx.Tx // the transaction of the call in progress, begun by its first claim
+	err    error  // the first error of the call in progress
+}
+
+// entryRef identifies a row of state_entries.
+type entryRef struct {
+	table, key, userID string
+}
+
+func openPostgresBackend(ctx context.Context, config *pgxpool.Config, restore restoreFunc) (*postgresBackend, error) {
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	b := &postgresBackend{pool: pool}
+	if err := b.migrate(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	if err := b.load(restore); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+// openPostgresStore restores a new server from PostgreSQL, migrating the schema first, and
+// persists every later change to it. Call it before the server handles requests.
+func (t *trainServer) openPostgresStore(ctx context.Context, config *pgxpool.Config) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var backend *postgresBackend
+	err := t.restoreState(func(restore restoreFunc) (err error) {
+		backend, err = openPostgresBackend(ctx, config, restore)
+		return err
+	})
+	if err != nil {
+		if backend != nil {
+			backend.close()
+		}
+		return err
+	}
+	t.startStore(backend)
+	return nil
+}
+
+// migrate applies the migrations not yet recorded in schema_migrations, each in a transaction.
+func (b *postgresBackend) migrate(ctx context.Context) error {
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	conn, err := b.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+	if _, err := conn.Exec(ctx, "SELECT pg_advisory_lock($1)", migrationLock); err != nil {
+		return err
+	}
+	defer conn.Exec(context.Background(), "SELECT pg_advisory_unlock($1)", migrationLock)
+	if _, err := conn.Exec(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    text PRIMARY KEY,
+		applied_on timestamptz NOT NULL DEFAULT now()
+	)`); err != nil {
+		return err
+	}
+	for _, name := range names {
+		version := strings.TrimSuffix(path.Base(name), ".sql")
+		sql, err := migrations.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		err = pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			var applied bool
+			if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM schema_migrations WHERE version = $1)", version).Scan(&applied); err != nil || applied {
+				return err
+			}
+			if _, err := tx.Exec(ctx, string(sql)); err != nil {
+				return err
+			}
+			_, err := tx.Exec(ctx, "INSERT INTO schema_migrations (version) VALUES ($1)", version)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("migration %s: %w", version, err)
+		}
+	}
+	return nil
+}
+
+// load passes the chunks of the base snapshot, then every retained event, in sequence order,
+// state entry and invoice counter as one record to restore, read from a single snapshot of the
+// database.
+func (b *postgresBackend) load(restore restoreFunc) error {
+	ctx := context.Background()
+	record := &pb.WALRecord{}
+	var base []*pb.WALRecord
+	versions := map[entryRef]int64{}
+	err := pgx.BeginTxFunc(ctx, b.pool, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		rows, _ := tx.Query(ctx, "SELECT payload FROM event_base ORDER BY chunk")
+		var err error
+		base, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.WALRecord, error) {
+			chunk := &pb.WALRecord{}
+			return chunk, scanPayload(row, chunk)
+		})
+		if err != nil {
+			return err
+		}
+		rows, _ = tx.Query(ctx, "SELECT payload FROM events ORDER BY sequence")
+		events, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.DomainEvent, error) {
+			e := &pb.DomainEvent{}
+			return e, scanPayload(row, e)
+		})
+		if err != nil {
+			return err
+		}
+		rows, _ = tx.Query(ctx, "SELECT version, payload FROM state_entries")
+		entries, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.StateEntry, error) {
+			var version int64
+			var payload []byte
+			if err := row.Scan(&version, &payload); err != nil {
+				return nil, err
+			}
+			e := &pb.StateEntry{}
+			if err := proto.Unmarshal(payload, e); err != nil {
+				return nil, err
+			}
+			versions[entryRef{e.Table, e.Key, e.UserID}] = version
+			return e, nil
+		})
+		if err != nil {
+			return err
+		}
+		// Counters stored as entries before invoice_counters existed are superseded by it.
+		rows, _ = tx.Query(ctx, "SELECT counter, last FROM invoice_counters")
+		counters, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (*pb.StateEntry, error) {
+			e := &pb.StateEntry{Table: tableCounter}
+			return e, row.Scan(&e.Key, &e.Count)
+		})
+		record.Events, record.Entries = events, append(entries, counters...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	b.versions = versions
+	for _, chunk := range base {
+		if err := restore(chunk); err != nil {
+			return err
+		}
+	}
+	return restore(record)
+}
+
+func scanPayload(row pgx.CollectableRow, m proto.Message) error {
+	var payload []byte
+	if err := row.Scan(&payload); err != nil {
+		return err
+	}
+	return proto.Unmarshal(payload, m)
+}
+
+// beginCall starts a call. Seats claimed during it stay locked until its write commits or
+// endCall releases them.
+func (b *postgresBackend) beginCall() {
+	b.calls.Lock()
+	b.inCall = true
+}
+
+// endCall releases the seats a call claimed but did not write, and returns the first error
+// of its claims.
+func (b *postgresBackend) endCall() error {
+	defer b.calls.Unlock()
+	if b.tx != nil {
+		b.tx.Rollback(context.Background())
+		b.tx = nil
+	}
+	err := b.err
+	b.inCall, b.err = false, nil
+	return err
+}
+
+// claimSeats locks the seats of a section for userID until the call's write, reporting false
+// if any is allocated to another user or locked by another server's call. A failed query
+// counts as a failed claim and fails the call. Outside a call the seats are only checked.
+func (b *postgresBackend) claimSeats(sectionID string, seats []int32, userID string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), postgresTimeout)
+	defer cancel()
+	claimed, err := b.queryClaim(ctx, sectionID, seats, userID)
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return false
+	}
+	return claimed == len(seats)
+}
+
+// queryClaim returns how many of the seats it locked.
+func (b *postgresBackend) queryClaim(ctx context.Context, sectionID string, seats []int32, userID string) (int, error) {
+	q, err := b.callQuerier()
+	if err != nil {
+		return 0, err
+	}
+	rows, _ := q.Query(ctx, `SELECT seat_number FROM seats s
+		WHERE section_id = $1 AND seat_number = ANY($2) AND NOT EXISTS (
+			SELECT 1 FROM seat_allocations a WHERE a.section_id = s.section_id AND a.seat_number = s.seat_number AND a.user_id <> $3)
+		FOR UPDATE SKIP LOCKED`, sectionID, seats, userID)
+	claimed, err := pgx.CollectRows(rows, pgx.RowTo[int32])
+	return len(claimed), err
+}
+
+// nextNumber takes the next number of an invoice counter, holding the counter's row until the
+// call's write commits or endCall rolls it back, so numbers are issued in the order they are
+// stored and without gaps. A failed query fails the call. Outside a call the number is taken
+// at once.
+func (b *postgresBackend) nextNumber(counter string, last int64) int64 {
+	ctx, cancel := context.WithTimeout(context.Background(), postgresTimeout)
+	defer cancel()
+	q, err := b.callQuerier()
+	var number int64
+	if err == nil {
+		err = q.QueryRow(ctx, `INSERT INTO invoice_counters (counter, last) VALUES ($1, $2::bigint + 1)
+			ON CONFLICT (counter) DO UPDATE SET last = greatest(invoice_counters.last, $2::bigint) + 1
+			RETURNING last`, counter, last).Scan(&number)
+	}
+	if err != nil {
+		if b.err == nil {
+			b.err = err
+		}
+		return 0
+	}
+	return number
+}
+
+// querier runs queries in a transaction or on the pool.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// callQuerier returns the transaction of the call in progress, begun on first use, or the
+// pool outside a call. It fails once a query of the call has failed.
+func (b *postgresBackend) callQuerier() (querier, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if !b.inCall {
+		return b.pool, nil
+	}
+	if b.tx == nil {
+		tx, err := b.pool.Begin(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		b.tx = tx
+	}
+	return b.tx, nil
+}
+
+// write applies the records in the transaction of the call in progress, or a new one, taking
+// the events' sequences from event_sequence. It returns errStaleState once the records are
+// stored if the sequences differ from the ones the server gave the events.
+func (b *postgresBackend) write(records []*pb.WALRecord) error {
+	tx := b.tx
+	b.tx = nil
+	if b.err != nil {
+		if tx != nil {
+			tx.Rollback(context.Background())
+		}
+		return b.err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), postgresTimeout)
+	defer cancel()
+	if tx == nil {
+		var err error
+		if tx, err = b.pool.Begin(ctx); err != nil {
+			return err
+		}
+	}
+	defer tx.Rollback(context.Background())
+	written := map[entryRef]int64{}
+	stale, err := b.writeRecords(ctx, tx, records, written)
+	if err == nil {
+		err = tx.Commit(ctx)
+	}
+	if err != nil {
+		return postgresError(err)
+	}
+	for ref, version := range written {
+		if version == 0 {
+			delete(b.versions, ref)
+		} else {
+			b.versions[ref] = version
+		}
+	}
+	if stale {
+		return errStaleState
+	}
+	return nil
+}
+
+// writeRecords queues the records' events, numbered from event_sequence, and entries, and
+// reports whether the numbers differ from the ones the server gave the events. The versions
+// the entries are written at are added to written, 0 for a removed entry.
+func (b *postgresBackend) writeRecords(ctx context.Context, tx pgx.Tx, records []*pb.WALRecord, written map[entryRef]int64) (bool, error) {
+	events, entries := []*pb.DomainEvent{}, []*pb.StateEntry{}
+	for _, record := range records {
+		events, entries = append(events, record.Events...), append(entries, record.Entries...)
+	}
+	stale := false
+	batch := &pgx.Batch{}
+	if len(events) > 0 {
+		var last int64
+		if err := tx.QueryRow(ctx, "UPDATE event_sequence SET last = last + $1 RETURNING last", len(events)).Scan(&last); err != nil {
+			return false, err
+		}
+		first := last - int64(len(events)) + 1
+		stale = first != events[0].Sequence
+		for i, e := range events {
+			if stale {
+				e = proto.Clone(e).(*pb.DomainEvent)
+				e.Sequence = first + int64(i)
+			}
+			if err := queueEvent(batch, e); err != nil {
+				return false, err
+			}
+		}
+	}
+	for _, e := range entries {
+		if err := b.queueEntry(batch, e, written); err != nil {
+			return false, err
+		}
+	}
+	return stale, tx.SendBatch(ctx, batch).Close()
+}
+
+// errChangedRow fails a write that updates or removes a user, section, ticket or state entry
+// another server changed or removed first, which the server would have caught in memory had
+// it seen it.
+var errChangedRow = errors.New("postgres: row changed by another server")
+
+// changedOnce fails the write unless the queued change affected exactly one row.
+func changedOnce(tag pgconn.CommandTag) error {
+	if tag.RowsAffected() != 1 {
+		return errChangedRow
+	}
+	return nil
+}
+
+// queueEvent queues the changes an event makes to the relational tables, then the event
+// itself. Updates and removals apply only to the version the event was made from.
+func queueEvent(batch *pgx.Batch, e *pb.DomainEvent) error {
+	payload, err := proto.Marshal(e)
+	if err != nil {
+		return err
+	}
+	occurred, err := time.Parse(time.RFC3339Nano, e.OccurredOn)
+	if err != nil {
+		return err
+	}
+	switch e.Type {
+	case domainUserCreated:
+		batch.Queue("INSERT INTO users (user_id, email, first_name, last_name, version) VALUES ($1, $2, $3, $4, $5)",
+			e.User.UserID, e.User.Email, e.User.FirstName, e.User.LastName, e.User.Version)
+	case domainUserUpdated:
+		batch.Queue("UPDATE users SET email = $2, first_name = $3, last_name = $4, version = $5 WHERE user_id = $1 AND version = $5 - 1",
+			e.User.UserID, e.User.Email, e.User.FirstName, e.User.LastName, e.User.Version).Exec(changedOnce)
+	case domainUserRemoved:
+		batch.Queue("DELETE FROM users WHERE user_id = $1 AND version = $2", e.User.UserID, e.User.Version).Exec(changedOnce)
+	case domainTicketPurchased, domainSeatModified, domainTicketBoarded:
+		ticket := e.Ticket
+		if e.Type == domainTicketPurchased {
+			batch.Queue("INSERT INTO tickets (user_id, ticket_id, section_id, seat_number, companion_seat, version) VALUES ($1, $2, $3, $4, $5, $6)",
+				ticket.UserID, ticket.TicketId, ticket.Section, ticket.SeatNumber, ticket.CompanionSeat, ticket.Version)
+		} else {
+			batch.Queue(`UPDATE tickets SET section_id = $3, seat_number = $4, companion_seat = $5, version = $6
+				WHERE user_id = $1 AND ticket_id = $2 AND version = $6 - 1`,
+				ticket.UserID, ticket.TicketId, ticket.Section, ticket.SeatNumber, ticket.CompanionSeat, ticket.Version).Exec(changedOnce)
+		}
+		batch.Queue("DELETE FROM seat_allocations WHERE user_id = $1", ticket.UserID)
+		for _, seat := range []int32{ticket.SeatNumber, ticket.CompanionSeat} {
+			if seat != 0 {
+				batch.Queue("INSERT INTO seat_allocations (section_id, seat_number, user_id) VALUES ($1, $2, $3)", ticket.Section, seat, ticket.UserID)
+			}
+		}
+	case domainBoardingPassReissued:
+		// The pass is not kept in the tables and the ticket's version is unchanged.
+	case domainTicketCancelled:
+		batch.Queue("DELETE FROM tickets WHERE user_id = $1 AND ticket_id = $2 AND version = $3", e.Ticket.UserID, e.Ticket.TicketId, e.Ticket.Version).Exec(changedOnce)
+	case domainSectionChanged:
+		section := e.Section
+		switch e.Change {
+		case sectionDeleted:
+			batch.Queue("DELETE FROM sections WHERE section_id = $1 AND version = $2", section.SectionID, section.Version).Exec(changedOnce)
+		case sectionCreated:
+			batch.Queue("INSERT INTO sections (section_id, name, total_seats, version) VALUES ($1, $2, $3, $4)",
+				section.SectionID, section.Section, section.TotalSeats, section.Version)
+		default:
+			batch.Queue("UPDATE sections SET name = $2, total_seats = $3, version = $4 WHERE section_id = $1 AND version = $4 - 1",
+				section.SectionID, section.Section, section.TotalSeats, section.Version).Exec(changedOnce)
+		}
+		if e.Change != sectionDeleted {
+			batch.Queue("INSERT INTO seats (section_id, seat_number) SELECT $1, generate_series(1, $2::integer) ON CONFLICT DO NOTHING",
+				section.SectionID, section.TotalSeats)
+			batch.Queue("DELETE FROM seats WHERE section_id = $1 AND seat_number > $2", section.SectionID, section.TotalSeats)
+		}
+	}
+	batch.Queue("INSERT INTO events (sequence, event_id, type, aggregate_id, occurred_on, payload) VALUES ($1, $2, $3, $4, $5, $6)",
+		e.Sequence, e.EventID, e.Type, e.AggregateID, occurred, payload)
+	return nil
+}
+
+// queueEntry queues the change of a state entry. An entry the server has not seen stored is
+// inserted, and clashes with one another server inserted first; updates and removals apply
+// only to the version the server last saw.
+func (b *postgresBackend) queueEntry(batch *pgx.Batch, e *pb.StateEntry, written map[entryRef]int64) error {
+	ref := entryRef{e.Table, e.Key, e.UserID}
+	version, ok := written[ref]
+	if !ok {
+		version = b.versions[ref]
+	}
+	switch {
+	case e.Deleted && version == 0:
+		// Added and removed again since the last write, so never stored.
+	case e.Deleted:
+		batch.Queue("DELETE FROM state_entries WHERE entry_table = $1 AND entry_key = $2 AND user_id = $3 AND version = $4",
+			e.Table, e.Key, e.UserID, version).Exec(changedOnce)
+		written[ref] = 0
+	default:
+		payload, err := proto.Marshal(e)
+		if err != nil {
+			return err
+		}
+		if version == 0 {
+			batch.Queue("INSERT INTO state_entries (entry_table, entry_key, user_id, payload, version) VALUES ($1, $2, $3, $4, 1)",
+				e.Table, e.Key, e.UserID, payload)
+		} else {
+			batch.Queue("UPDATE state_entries SET payload = $4, version = $5 + 1 WHERE entry_table = $1 AND entry_key = $2 AND user_id = $3 AND version = $5",
+				e.Table, e.Key, e.UserID, payload, version).Exec(changedOnce)
+		}
+		written[ref] = version + 1
+	}
+	return nil
+}
+
+// postgresError turns a broken constraint into a storeConflict carrying the error the server
+// returns when it catches the same conflict in memory.
+func postgresError(err error) error {
+	if errors.Is(err, errChangedRow) {
+		return &storeConflict{code: codes.Aborted, message: "A conflicting change was saved by another server, try again", err: err}
+	}
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+	switch {
+	case pgErr.ConstraintName == "users_email_key":
+		return &storeConflict{code: codes.AlreadyExists, message: "Email already used.", err: err}
+	case pgErr.ConstraintName == "sections_name_key":
+		return &storeConflict{code: codes.AlreadyExists, message: "Section name already used.", err: err}
+	case pgErr.ConstraintName == "seat_allocations_pkey":
+		return &storeConflict{code: codes.Aborted, message: "Seat was taken by another booking, try again", err: err}
+	case strings.HasPrefix(pgErr.Code, "23"), pgErr.Code == "40001", pgErr.Code == "40P01":
+		// Other integrity violations, serialization failures and deadlocks.
+		return &storeConflict{code: codes.Aborted, message: "A conflicting change was saved by another server, try again", err: err}
+	}
+	return err
+}
+
+// trim replaces the events up to the base snapshot's sequence with the snapshot, unless
+// another server sharing the database already trimmed further.
+func (b *postgresBackend) trim(base []*pb.WALRecord) error {
+	ctx, cancel := context.WithTimeout(context.Background(), postgresTimeout)
+	defer cancel()
+	sequence := base[0].Base.Sequence
+	return pgx.BeginFunc(ctx, b.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "LOCK TABLE event_base IN EXCLUSIVE MODE"); err != nil {
+			return err
+		}
+		var trimmed int64
+		if err := tx.QueryRow(ctx, "SELECT coalesce(max(sequence), 0) FROM event_base").Scan(&trimmed); err != nil || trimmed >= sequence {
+			return err
+		}
+		batch := &pgx.Batch{}
+		batch.Queue("DELETE FROM event_base")
+		for i, chunk := range base {
+			payload, err := proto.Marshal(chunk)
+			if err != nil {
+				return err
+			}
+			batch.Queue("INSERT INTO event_base (chunk, sequence, payload) VALUES ($1, $2, $3)", i, sequence, payload)
+		}
+		batch.Queue("DELETE FROM events WHERE sequence <= $1", sequence)
+		return tx.SendBatch(ctx, batch).Close()
+	})
+}
+
+// The relational tables are kept by write, so there is nothing to compact.
+func (b *postgresBackend) wantsSnapshot() bool { return false }
+
+func (b *postgresBackend) snapshot(records []*pb.WALRecord) error {
+	return errors.New("postgres: snapshots are not supported")
+}
+
+func (b *postgresBackend) healthy(ctx context.Context) error {
+	return b.pool.Ping(ctx)
+}
+
+func (b *postgresBackend) close() error {
+	b.pool.Close()
+	return nil
+}
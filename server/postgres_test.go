@@ -0,0 +1,335 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// postgresTestDSN names the environment variable holding the connection string of a database
+// the integration tests may create schemas in, such as postgres://localhost/ticketbook_test.
+const postgresTestDSN = "TICKETBOOK_TEST_POSTGRES_DSN"
+
+func TestPostgres(t *testing.T) {
+	t.Run("Errors", testPostgresErrors)
+	t.Run("Migrations", testPostgresMigrations)
+	t.Run("Restart", testPostgresRestart)
+	t.Run("EmailUnique", testPostgresEmailUnique)
+	t.Run("SectionNameUnique", testPostgresSectionNameUnique)
+	t.Run("SeatAllocation", testPostgresSeatAllocation)
+	t.Run("SeatClaims", testPostgresSeatClaims)
+	t.Run("Sequences", testPostgresSequences)
+	t.Run("StaleUpdate", testPostgresStaleUpdate)
+	t.Run("SharedEntries", testPostgresSharedEntries)
+}
+
+// postgresTestConfig returns the configuration of a new, empty schema in the test database,
+// dropped at the end of the test. The test is skipped when no database is configured.
+func postgresTestConfig(t *testing.T) *pgxpool.Config {
+	t.Helper()
+	dsn := os.Getenv(postgresTestDSN)
+	if dsn == "" {
+		t.Skip(postgresTestDSN + " is not set")
+	}
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		t.Fatalf("Invalid %s: %v", postgresTestDSN, err)
+	}
+	ctx := context.Background()
+	conn, err := pgx.ConnectConfig(ctx, config.ConnConfig.Copy())
+	if err != nil {
+		t.Fatalf("Connecting to the test database failed: %v", err)
+	}
+	schema := "ticketbook_test_" + strings.ReplaceAll(uuid.NewString(), "-", "")
+	if _, err := conn.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("CREATE SCHEMA failed: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+		conn.Close(context.Background())
+	})
+	config.ConnConfig.RuntimeParams["search_path"] = schema
+	return config
+}
+
+func openPostgresServer(t *testing.T, config *pgxpool.Config) *trainServer {
+	t.Helper()
+	s := setupTestServer()
+	s.loyalty = loyaltyConfig{PointsPerUnit: 1, PointValue: 10, SilverSpend: 15000, GoldSpend: 100000}
+	if err := s.openPostgresStore(context.Background(), config.Copy()); err != nil {
+		t.Fatalf("openPostgresStore failed: %v", err)
+	}
+	t.Cleanup(func() { s.store.backend.close() })
+	return s
+}
+
+// call runs a handler through the persist interceptor, as the server does.
+func call[Resp any](s *trainServer, handler func(ctx context.Context) (Resp, error)) (Resp, error) {
+	resp, err := s.persistUnaryInterceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "test"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return handler(ctx)
+	})
+	if err != nil {
+		var zero Resp
+		return zero, err
+	}
+	return resp.(Resp), nil
+}
+
+func testPostgresErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{"Email", &pgconn.PgError{Code: "23505", ConstraintName: "users_email_key"}, codes.AlreadyExists},
+		{"SectionName", &pgconn.PgError{Code: "23505", ConstraintName: "sections_name_key"}, codes.AlreadyExists},
+		{"Seat", &pgconn.PgError{Code: "23505", ConstraintName: "seat_allocations_pkey"}, codes.Aborted},
+		{"Sequence", &pgconn.PgError{Code: "23505", ConstraintName: "events_pkey"}, codes.Aborted},
+		{"ForeignKey", &pgconn.PgError{Code: "23503", ConstraintName: "tickets_user_id_fkey"}, codes.Aborted},
+		{"Deadlock", fmt.Errorf("batch: %w", &pgconn.PgError{Code: "40P01"}), codes.Aborted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conflict *storeConflict
+			if !errors.As(postgresError(tt.err), &conflict) || conflict.code != tt.code {
+				t.Errorf("Expected a %v conflict, got %v", tt.code, postgresError(tt.err))
+			}
+		})
+	}
+	for _, err := range []error{&pgconn.PgError{Code: "53300"}, errors.New("connection refused")} {
+		var conflict *storeConflict
+		if errors.As(postgresError(err), &conflict) {
+			t.Errorf("Expected %v not to be a conflict", err)
+		}
+	}
+}
+func testPostgresMigrations(t *testing.T) {
+	config := postgresTestConfig(t)
+	openPostgresServer(t, config)
+	openPostgresServer(t, config)
+
+	conn, err := pgx.ConnectConfig(context.Background(), config.ConnConfig.Copy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close(context.Background())
+	var applied int
+	if err := conn.QueryRow(context.Background(), "SELECT count(*) FROM schema_migrations").Scan(&applied); err != nil {
+		t.Fatalf("Reading schema_migrations failed: %v", err)
+	}
+	names, _ := migrations.ReadDir("migrations")
+	if applied != len(names) {
+		t.Errorf("Expected each of the %d migrations applied once, got %d", len(names), applied)
+	}
+}
+func testPostgresRestart(t *testing.T) {
+	config := postgresTestConfig(t)
+	s := openPostgresServer(t, config)
+	populate(t, s)
+
+	restarted := openPostgresServer(t, config)
+	checkSameState(t, s, restarted)
+	if len(restarted.ledger) == 0 || len(restarted.blockedSeats) != 1 || len(restarted.holds) != 1 || restarted.creditNoteSeq != 1 {
+		t.Fatalf("Expected the ledger, block, hold and credit note to be restored")
+	}
+}
+func testPostgresEmailUnique(t *testing.T) {
+	config := postgresTestConfig(t)
+	a, b := openPostgresServer(t, config), openPostgresServer(t, config)
+	request := &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test@gmail.com"}
+	if _, err := call(a, func(ctx context.Context) (*pb.User, error) { return a.CreateUser(ctx, request) }); err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+
+	// The other server has not seen the user, so only the database catches the duplicate.
+	request.Email = "TEST@gmail.com"
+	_, err := call(b, func(ctx context.Context) (*pb.User, error) { return b.CreateUser(ctx, request) })
+	if status.Code(err) != codes.AlreadyExists || status.Convert(err).Message() != "Email already used." {
+		t.Fatalf("Expected the unique email index to reject the user, got %v", err)
+	}
+	if len(b.users) != 1 || len(b.outbox) != 1 {
+		t.Errorf("Expected the server to reload the stored user in place of its own, got %d users", len(b.users))
+	}
+}
+func testPostgresSectionNameUnique(t *testing.T) {
+	config := postgresTestConfig(t)
+	a, b := openPostgresServer(t, config), openPostgresServer(t, config)
+	if _, err := call(a, func(ctx context.Context) (*pb.Section, error) {
+		return a.CreateSection(ctx, &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	}); err != nil {
+		t.Fatalf("CreateSection failed: %v", err)
+	}
+	_, err := call(b, func(ctx context.Context) (*pb.Section, error) {
+		return b.CreateSection(ctx, &pb.CreateSectionRequest{Section: "a", TotalSeats: 20})
+	})
+	if status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("Expected the unique section name index to reject the section, got %v", err)
+	}
+	if len(b.sections) != 1 {
+		t.Errorf("Expected the server to reload the stored section")
+	}
+}
+func testPostgresSeatAllocation(t *testing.T) {
+	config := postgresTestConfig(t)
+	setup := openPostgresServer(t, config)
+	section, _ := setup.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 2})
+	alice := createPassenger(t, setup, "alice@gmail.com")
+	bob := createPassenger(t, setup, "bob@gmail.com")
+	if err := setup.persist(); err != nil {
+		t.Fatalf("persist failed: %v", err)
+	}
+
+	a, b := openPostgresServer(t, config), openPostgresServer(t, config)
+	purchase := func(s *trainServer, user *pb.User) (*pb.Ticket, error) {
+		return call(s, func(ctx context.Context) (*pb.Ticket, error) {
+			return s.PurchaseTicket(ctx, &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+		})
+	}
+	first, err := purchase(a, alice)
+	if err != nil || first.SeatNumber != 1 {
+		t.Fatalf("Expected alice in seat 1, got %v, %v", first, err)
+	}
+	// b still believes seat 1 is free; its claim finds it allocated and moves on to seat 2.
+	second, err := purchase(b, bob)
+	if err != nil || second.SeatNumber != 2 {
+		t.Fatalf("Expected bob in seat 2 at the first attempt, got %v, %v", second, err)
+	}
+	if b.allocatedSeats[seatKey(section.SectionID, 1)] != alice.UserID || b.store.conflictCount() != 0 {
+		t.Fatalf("Expected the server to reload alice's seat after storing bob's")
+	}
+}
+func testPostgresSeatClaims(t *testing.T) {
+	config := postgresTestConfig(t)
+	a, b := openPostgresServer(t, config), openPostgresServer(t, config)
+	section, err := call(a, func(ctx context.Context) (*pb.Section, error) {
+		return a.CreateSection(ctx, &pb.CreateSectionRequest{Section: "A", TotalSeats: 2})
+	})
+	if err != nil {
+		t.Fatalf("CreateSection failed: %v", err)
+	}
+	claimer, other := a.store.backend.(*postgresBackend), b.store.backend.(*postgresBackend)
+
+	claimer.beginCall()
+	if !claimer.claimSeats(section.SectionID, []int32{1}, "alice") {
+		t.Fatalf("Expected a free seat to be claimed")
+	}
+	other.beginCall()
+	if other.claimSeats(section.SectionID, []int32{1}, "bob") || !other.claimSeats(section.SectionID, []int32{2}, "bob") {
+		t.Errorf("Expected only the seat claimed by the other server's call to be skipped")
+	}
+	if err := other.endCall(); err != nil {
+		t.Errorf("endCall failed: %v", err)
+	}
+	claimer.endCall()
+
+	// A claim not followed by a write is released when the call ends.
+	other.beginCall()
+	defer other.endCall()
+	if !other.claimSeats(section.SectionID, []int32{1, 2}, "bob") {
+		t.Errorf("Expected the seats to be released at the end of the calls")
+	}
+}
+func testPostgresSequences(t *testing.T) {
+	config := postgresTestConfig(t)
+	a, b := openPostgresServer(t, config), openPostgresServer(t, config)
+	for i, s := range []*trainServer{a, b, a} {
+		email := fmt.Sprintf("user%d@gmail.com", i)
+		if _, err := call(s, func(ctx context.Context) (*pb.User, error) {
+			return s.CreateUser(ctx, &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: email})
+		}); err != nil {
+			t.Fatalf("CreateUser %d failed: %v", i, err)
+		}
+	}
+	// Each server numbered its first event 1; the database numbered them in the order stored.
+	for _, s := range []*trainServer{a, b} {
+		if len(s.users) != len(s.outbox) || s.latestSequence() != int64(len(s.outbox)) || s.store.durable != s.latestSequence() {
+			t.Errorf("Expected the server to reload the events stored by the other, got %d users and %d events", len(s.users), len(s.outbox))
+		}
+	}
+	if len(a.outbox) != 3 || a.store.conflictCount() != 0 || b.store.conflictCount() != 0 {
+		t.Errorf("Expected all 3 users stored without conflicts, got %d", len(a.outbox))
+	}
+	checkSameState(t, a, openPostgresServer(t, config))
+}
+func testPostgresStaleUpdate(t *testing.T) {
+	config := postgresTestConfig(t)
+	setup := openPostgresServer(t, config)
+	user := createPassenger(t, setup, "aman@gmail.com")
+	if err := setup.persist(); err != nil {
+		t.Fatalf("persist failed: %v", err)
+	}
+	a, b := openPostgresServer(t, config), openPostgresServer(t, config)
+	rename := func(s *trainServer, name string) error {
+		_, err := call(s, func(ctx context.Context) (*pb.User, error) {
+			return s.ModifyUser(ctx, &pb.User{UserID: user.UserID, FirstName: name, LastName: "jain", Email: user.Email, Version: 1})
+		})
+		return err
+	}
+	if err := rename(a, "Amit"); err != nil {
+		t.Fatalf("ModifyUser failed: %v", err)
+	}
+	// b has not seen the change, so only the version stored catches that it is overwritten.
+	if err := rename(b, "Anil"); status.Code(err) != codes.Aborted {
+		t.Fatalf("Expected the stale update to be rejected, got %v", err)
+	}
+	if got := b.users[user.UserID]; got.FirstName != "Amit" || got.Version != 2 {
+		t.Errorf("Expected the server to reload the stored user, got %v", got)
+	}
+}
+func testPostgresSharedEntries(t *testing.T) {
+	config := postgresTestConfig(t)
+	setup := openPostgresServer(t, config)
+	setup.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	if _, err := setup.CreatePromotion(context.Background(), &pb.CreatePromotionRequest{Code: "ONCE", Type: pb.DiscountType_FIXED, Amount: usd(100), MaxUses: 1}); err != nil {
+		t.Fatalf("CreatePromotion failed: %v", err)
+	}
+	alice := createPassenger(t, setup, "alice@gmail.com")
+	bob := createPassenger(t, setup, "bob@gmail.com")
+	if err := setup.persist(); err != nil {
+		t.Fatalf("persist failed: %v", err)
+	}
+
+	a, b := openPostgresServer(t, config), openPostgresServer(t, config)
+	purchase := func(s *trainServer, user *pb.User, promo string) (*pb.Ticket, error) {
+		return call(s, func(ctx context.Context) (*pb.Ticket, error) {
+			return s.PurchaseTicket(ctx, &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000), PromoCode: promo})
+		})
+	}
+	first, err := purchase(a, alice, "ONCE")
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	// b has not seen the redemption, so only the version stored keeps the code from being used twice.
+	if _, err := purchase(b, bob, "ONCE"); status.Code(err) != codes.Aborted {
+		t.Fatalf("Expected the stale redemption to be rejected, got %v", err)
+	}
+	if _, err := purchase(b, bob, "ONCE"); err == nil || !strings.Contains(err.Error(), "fully redeemed") {
+		t.Fatalf("Expected the retry to see the code redeemed, got %v", err)
+	}
+	second, err := purchase(b, bob, "")
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	// The numbers come from the database, so b's failed call neither reused nor skipped one.
+	if first.InvoiceNumber != "000001" || second.InvoiceNumber != "000002" {
+		t.Errorf("Expected invoices numbered in the order stored, got %s and %s", first.InvoiceNumber, second.InvoiceNumber)
+	}
+
+	restarted := openPostgresServer(t, config)
+	if promo := restarted.promotions["ONCE"]; promo.Redemptions != 1 || len(restarted.invoices) != 2 || restarted.invoiceSeq != 2 {
+		t.Errorf("Expected one redemption and both invoices stored, got %d redemptions and %d invoices", promo.Redemptions, len(restarted.invoices))
+	}
+}
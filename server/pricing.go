@@ -0,0 +1,159 @@
+// pricing.go
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// concessionRule describes the discount and eligibility for a passenger category.
+type concessionRule struct {
+	PercentOff   basisPoints `json:"percent_off"`
+	MinAge       int         `json:"min_age"` // 0 means no lower bound
+	MaxAge       int         `json:"max_age"` // 0 means no upper bound
+	RequiresCard bool        `json:"requires_card"`
+}
+
+// fareQuote is the priced total of a ticket together with its itemised lines, in the
+// currency the passenger pays in.
+type fareQuote struct {
+	currency string
+	total    int64 // minor units
+	lines    []*pb.FareLine
+}
+
+func (q *fareQuote) add(description string, units int64) {
+	q.lines = append(q.lines, &pb.FareLine{Description: description, Amount: newMoney(q.currency, units)})
+	q.total += units
+}
+
+// payCurrency returns the currency the request is paid in. Callers hold t.mu.
+func (t *trainServer) payCurrency(req *pb.TicketRequest) string {
+	if currency := strings.ToUpper(strings.TrimSpace(req.CurrencyCode)); currency != "" {
+		return currency
+	}
+	return t.currency
+}
+
+// quoteFare prices a seat in section before any passenger discounts. A fare configured for
+// the class takes precedence over the price supplied by the client, and dynamic pricing
+// strategies matching the journey are applied on top. Callers hold t.mu.
+func (t *trainServer) quoteFare(req *pb.TicketRequest, section *pb.Section, now time.Time) (fareQuote, error) {
+	base, ok := t.fares[req.Class]
+	if !ok {
+		var err error
+		if base, err = t.normalizeMoney(req.PricePaid); err != nil {
+			return fareQuote{}, err
+		}
+	}
+	quote := fareQuote{currency: t.payCurrency(req)}
+	fare, err := t.convert(base, quote.currency)
+	if err != nil {
+		return fareQuote{}, err
+	}
+	quote.add(titleCase(req.Class.String())+" fare", fare.MinorUnits)
+	t.applyDynamicPricing(&quote, req, section, now)
+	return quote, nil
+}
+
+// applyConcession discounts a quote for the user's passenger category. Callers hold t.mu.
+func (t *trainServer) applyConcession(quote *fareQuote, user *pb.User, now time.Time) error {
+	if user.Category == pb.PassengerCategory_ADULT {
+		return nil
+	}
+	rule, ok := t.concessions[user.Category]
+	if !ok {
+		return errors.New("No concession is offered for " + strings.ToLower(user.Category.String()) + " passengers")
+	}
+	if err := concessionEligible(user, rule, now); err != nil {
+		return err
+	}
+	quote.add(fmt.Sprintf("%s concession (%s)", titleCase(user.Category.String()), rule.PercentOff), -rule.PercentOff.of(quote.total))
+	return nil
+}
+
+// concessionEligible checks the user's attributes against the rule for their category.
+func concessionEligible(user *pb.User, rule concessionRule, now time.Time) error {
+	category := strings.ToLower(user.Category.String())
+	if rule.RequiresCard && strings.TrimSpace(user.ConcessionCardID) == "" {
+		return errors.New("Provide a concession card id for " + category + " fares")
+	}
+	if rule.MinAge == 0 && rule.MaxAge == 0 {
+		return nil
+	}
+	if user.DateOfBirth == "" {
+		return errors.New("Provide date of birth for " + category + " fares")
+	}
+	age := ageOn(user.DateOfBirth, now)
+	if (rule.MinAge > 0 && age < rule.MinAge) || (rule.MaxAge > 0 && age > rule.MaxAge) {
+		return errors.New("Passenger is not eligible for " + category + " fares")
+	}
+	return nil
+}
+
+// ageOn returns the age in whole years on the given day for a YYYY-MM-DD date of birth.
+func ageOn(dateOfBirth string, now time.Time) int {
+	dob, err := time.Parse(time.DateOnly, dateOfBirth)
+	if err != nil {
+		return -1
+	}
+	age := now.Year() - dob.Year()
+	// Compare calendar days rather than days of the year, which shift after February in leap
+	// years. A 29 February birthday falls on 1 March in other years.
+	if now.Month() < dob.Month() || (now.Month() == dob.Month() && now.Day() < dob.Day()) {
+		age--
+	}
+	return age
+}
+
+func validatePassenger(category pb.PassengerCategory, dateOfBirth string) error {
+	if _, ok := pb.PassengerCategory_name[int32(category)]; !ok {
+		return errors.New("Invalid passenger category")
+	}
+	if strings.TrimSpace(dateOfBirth) == "" {
+		return nil
+	}
+	dob, err := time.Parse(time.DateOnly, strings.TrimSpace(dateOfBirth))
+	if err != nil {
+		return errors.New("Date of birth must be formatted as YYYY-MM-DD")
+	} else if dob.After(time.Now()) {
+		return errors.New("Date of birth can not be in the future")
+	}
+	return nil
+}
+
+func titleCase(s string) string {
+	s = strings.ToLower(s)
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func validSeatClass(class pb.SeatClass) bool {
+	_, ok := pb.SeatClass_name[int32(class)]
+	return ok
+}
+
+// normalizeAmenities validates amenity tags and returns them sorted without duplicates.
+func normalizeAmenities(amenities []pb.Amenity) ([]pb.Amenity, error) {
+	seen := map[pb.Amenity]bool{}
+	out := []pb.Amenity{}
+	for _, amenity := range amenities {
+		if _, ok := pb.Amenity_name[int32(amenity)]; !ok {
+			return nil, errors.New("Invalid amenity")
+		}
+		if !seen[amenity] {
+			seen[amenity] = true
+			out = append(out, amenity)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out, nil
+}
@@ -0,0 +1,209 @@
+// promotions.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+func normalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// promotionAllowsSection reports whether a promotion may be redeemed on a seat in sectionID.
+func promotionAllowsSection(promo *pb.Promotion, sectionID string) bool {
+	if promo == nil || len(promo.Sections) == 0 {
+		return true
+	}
+	for _, id := range promo.Sections {
+		if id == sectionID {
+			return true
+		}
+	}
+	return false
+}
+
+// redeemablePromotion looks up a code and checks that userid may redeem it for the
+// requested journey at now. Section restrictions are applied during seat allocation.
+// Callers hold t.mu.
+func (t *trainServer) redeemablePromotion(code string, req *pb.TicketRequest, userid string, now time.Time) (*pb.Promotion, error) {
+	promo, ok := t.promotions[normalizePromoCode(code)]
+	if !ok || promo.Disabled {
+		return nil, errors.New("Invalid promo code")
+	}
+	if from, _ := parseOptionalTime(promo.ValidFrom, "ValidFrom"); !from.IsZero() && now.Before(from) {
+		return nil, errors.New("Promo code is not valid yet")
+	}
+	if until, _ := parseOptionalTime(promo.ValidUntil, "ValidUntil"); !until.IsZero() && !now.Before(until) {
+		return nil, errors.New("Promo code has expired")
+	}
+	if promo.From != "" && !strings.EqualFold(promo.From, strings.TrimSpace(req.From)) ||
+		promo.To != "" && !strings.EqualFold(promo.To, strings.TrimSpace(req.To)) {
+		return nil, errors.New("Promo code is not valid for this route")
+	}
+	if promo.MaxUses > 0 && promo.Redemptions >= promo.MaxUses {
+		return nil, errors.New("Promo code has been fully redeemed")
+	}
+	if promo.MaxUsesPerUser > 0 && t.redemptions[promo.Code][userid] >= promo.MaxUsesPerUser {
+		return nil, errors.New("Promo code already used the maximum number of times by this user")
+	}
+	return promo, nil
+}
+
+// applyPromotion adds the promotion's discount to a quote, never taking the total below zero.
+// Callers hold t.mu.
+func (t *trainServer) applyPromotion(quote *fareQuote, promo *pb.Promotion) error {
+	if promo == nil {
+		return nil
+	}
+	var discount int64
+	if promo.Type == pb.DiscountType_PERCENT {
+		discount = basisPoints(promo.BasisPoints).of(quote.total)
+	} else {
+		amount, err := t.convert(promo.Amount, quote.currency)
+		if err != nil {
+			return err
+		}
+		discount = amount.MinorUnits
+	}
+	if discount > quote.total {
+		discount = quote.total
+	}
+	quote.add("Promo "+promo.Code, -discount)
+	return nil
+}
+
+// redeemPromotion records a redemption of code by userid. Callers hold t.mu.
+func (t *trainServer) redeemPromotion(code, userid string) {
+	promo, ok := t.promotions[code]
+	if !ok {
+		return
+	}
+	promo.Redemptions++
+	if t.redemptions[code] == nil {
+		t.redemptions[code] = make(map[string]int32)
+	}
+	t.redemptions[code][userid]++
+	t.touch(tablePromotion, code)
+	t.touch(tableRedemption, code)
+}
+
+// releasePromotion returns a redemption when its ticket is cancelled. Callers hold t.mu.
+func (t *trainServer) releasePromotion(code, userid string) {
+	promo, ok := t.promotions[code]
+	if !ok || t.redemptions[code][userid] == 0 {
+		return
+	}
+	promo.Redemptions--
+	t.redemptions[code][userid]--
+	t.touch(tablePromotion, code)
+	t.touch(tableRedemption, code)
+}
+
+func (t *trainServer) CreatePromotion(ctx context.Context, req *pb.CreatePromotionRequest) (*pb.Promotion, error) {
+	code := normalizePromoCode(req.Code)
+	if code == "" {
+		return nil, errors.New("Provide promo code")
+	} else if _, ok := pb.DiscountType_name[int32(req.Type)]; !ok {
+		return nil, errors.New("Invalid discount type")
+	} else if req.Type == pb.DiscountType_PERCENT && (req.BasisPoints <= 0 || req.BasisPoints > 10000) {
+		return nil, errors.New("Percentage discount must be greater than 0 and not more than 100")
+	} else if req.Type == pb.DiscountType_FIXED && req.Amount.GetMinorUnits() <= 0 {
+		return nil, errors.New("Discount amount must be greater than 0")
+	} else if req.MaxUses < 0 || req.MaxUsesPerUser < 0 {
+		return nil, errors.New("Usage caps can not be less than 0")
+	}
+	from, err := parseOptionalTime(req.ValidFrom, "ValidFrom")
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTime(req.ValidUntil, "ValidUntil")
+	if err != nil {
+		return nil, err
+	}
+	if !from.IsZero() && !until.IsZero() && !until.After(from) {
+		return nil, errors.New("ValidUntil must be after ValidFrom")
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var amount *pb.Money
+	if req.Type == pb.DiscountType_FIXED {
+		if amount, err = t.normalizeMoney(req.Amount); err != nil {
+			return nil, err
+		}
+	}
+	if _, exists := t.promotions[code]; exists {
+		return nil, status.Error(codes.AlreadyExists, "Promo code already exists")
+	}
+	sections := []string{}
+	for _, id := range req.Sections {
+		id = strings.TrimSpace(id)
+		if _, ok := t.sections[id]; !ok {
+			return nil, fmt.Errorf("Invalid Section %q", id)
+		}
+		sections = append(sections, id)
+	}
+	sort.Strings(sections)
+	promo := &pb.Promotion{
+		Code:           code,
+		Type:           req.Type,
+		BasisPoints:    req.BasisPoints,
+		Amount:         amount,
+		ValidFrom:      strings.TrimSpace(req.ValidFrom),
+		ValidUntil:     strings.TrimSpace(req.ValidUntil),
+		MaxUses:        req.MaxUses,
+		MaxUsesPerUser: req.MaxUsesPerUser,
+		From:           strings.TrimSpace(req.From),
+		To:             strings.TrimSpace(req.To),
+		Sections:       sections,
+		CreatedOn:      time.Now().String(),
+	}
+	t.promotions[code] = promo
+	t.touch(tablePromotion, code)
+	return promo, nil
+}
+
+func (t *trainServer) ViewPromotions(ctx context.Context, req *pb.PromotionRequest) (*pb.AllPromotions, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if code := normalizePromoCode(req.Code); code != "" {
+		promo, ok := t.promotions[code]
+		if !ok {
+			return nil, errors.New("Invalid promo code")
+		}
+		return &pb.AllPromotions{Promotions: []*pb.Promotion{promo}}, nil
+	}
+	resp := &pb.AllPromotions{}
+	for _, promo := range t.promotions {
+		resp.Promotions = append(resp.Promotions, promo)
+	}
+	sort.Slice(resp.Promotions, func(i, j int) bool { return resp.Promotions[i].Code < resp.Promotions[j].Code })
+	return resp, nil
+}
+
+func (t *trainServer) DisablePromotion(ctx context.Context, req *pb.PromotionRequest) (*pb.Promotion, error) {
+	code := normalizePromoCode(req.Code)
+	if code == "" {
+		return nil, errors.New("Provide promo code")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	promo, ok := t.promotions[code]
+	if !ok {
+		return nil, errors.New("Invalid promo code")
+	}
+	promo.Disabled = true
+	t.touch(tablePromotion, code)
+	return promo, nil
+}
@@ -0,0 +1,218 @@
+// receipts.go
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"rsc.io/qr"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+//go:embed templates
+var defaultTemplates embed.FS
+
+const (
+	htmlReceiptTemplate = "receipt.html.tmpl"
+	textReceiptTemplate = "receipt.txt.tmpl"
+)
+
+// receiptRenderer turns receipts into printable documents. PDF receipts are laid out from
+// the text template, with the QR code drawn as vector graphics.
+type receiptRenderer struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+// receiptView is the data available to receipt templates.
+type receiptView struct {
+	Passenger     string
+	Email         string
+	From          string
+	To            string
+	Section       string
+	Class         string
+	Seat          int32
+	CompanionSeat int32
+	TicketID      string
+	InvoiceNumber string
+	Price         string
+	FareLines     []fareLineView
+	CheckID       bool
+	IssuedOn      string
+	QRPayload     string
+	QRSVG         htmltemplate.HTML // QR code as inline SVG, for HTML templates
+	QRText        string            // QR code drawn with block characters, empty in PDFs
+}
+
+type fareLineView struct {
+	Description string
+	Amount      string
+}
+
+// readTemplate returns an operator template from dir when present, otherwise the built-in one.
+func readTemplate(dir, name string) (string, error) {
+	if dir != "" {
+		b, err := os.ReadFile(filepath.Join(dir, name))
+		if err == nil {
+			return string(b), nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+	b, err := defaultTemplates.ReadFile("templates/" + name)
+	return string(b), err
+}
+
+// newReceiptRenderer loads the receipt templates, preferring any found in dir.
+func newReceiptRenderer(dir string) (*receiptRenderer, error) {
+	htmlSource, err := readTemplate(dir, htmlReceiptTemplate)
+	if err != nil {
+		return nil, err
+	}
+	textSource, err := readTemplate(dir, textReceiptTemplate)
+	if err != nil {
+		return nil, err
+	}
+	r := &receiptRenderer{}
+	if r.html, err = htmltemplate.New(htmlReceiptTemplate).Parse(htmlSource); err != nil {
+		return nil, err
+	}
+	if r.text, err = texttemplate.New(textReceiptTemplate).Parse(textSource); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// qrPayload is the text encoded in a receipt's QR code: the signed boarding pass, or for
+// tickets issued before boarding passes existed, the ticket ID.
+func qrPayload(ticket *pb.Ticket) string {
+	if ticket.BoardingPass != "" {
+		return ticket.BoardingPass
+	}
+	return "TICKETBOOK:" + strings.ToUpper(ticket.TicketId)
+}
+
+func qrSVG(code *qr.Code) htmltemplate.HTML {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg class="qr" xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges"><rect width="100%%" height="100%%" fill="#fff"/><path fill="#000" d="`, code.Size, code.Size)
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if code.Black(x, y) {
+				fmt.Fprintf(&b, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	b.WriteString(`"/></svg>`)
+	return htmltemplate.HTML(b.String())
+}
+
+// qrText draws a QR code two rows per line with half block characters.
+func qrText(code *qr.Code) string {
+	var b strings.Builder
+	for y := 0; y < code.Size; y += 2 {
+		for x := 0; x < code.Size; x++ {
+			top, bottom := code.Black(x, y), code.Black(x, y+1)
+			switch {
+			case top && bottom:
+				b.WriteString("█")
+			case top:
+				b.WriteString("▀")
+			case bottom:
+				b.WriteString("▄")
+			default:
+				b.WriteString(" ")
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// render produces the receipt in the requested format, returning the content and its MIME type.
+func (r *receiptRenderer) render(view receiptView, format pb.ReceiptFormat) ([]byte, string, error) {
+	code, err := qr.Encode(view.QRPayload, qr.M)
+	if err != nil {
+		return nil, "", err
+	}
+	var out bytes.Buffer
+	switch format {
+	case pb.ReceiptFormat_HTML:
+		view.QRSVG = qrSVG(code)
+		if err := r.html.Execute(&out, view); err != nil {
+			return nil, "", err
+		}
+		return out.Bytes(), "text/html; charset=utf-8", nil
+	case pb.ReceiptFormat_TEXT:
+		view.QRText = qrText(code)
+		if err := r.text.Execute(&out, view); err != nil {
+			return nil, "", err
+		}
+		return out.Bytes(), "text/plain; charset=utf-8", nil
+	case pb.ReceiptFormat_PDF:
+		if err := r.text.Execute(&out, view); err != nil {
+			return nil, "", err
+		}
+		return renderPDF(strings.Split(strings.TrimRight(out.String(), "\n"), "\n"), code), "application/pdf", nil
+	}
+	return nil, "", errors.New("Invalid receipt format")
+}
+
+func (t *trainServer) RenderReceipt(ctx context.Context, req *pb.RenderReceiptRequest) (*pb.RenderedReceipt, error) {
+	if _, ok := pb.ReceiptFormat_name[int32(req.Format)]; !ok {
+		return nil, errors.New("Invalid receipt format")
+	}
+	t.mu.RLock()
+	receipt, err := t.ViewReceipt(ctx, &pb.UseRequest{UserID: req.UserID})
+	if err != nil {
+		t.mu.RUnlock()
+		return nil, err
+	}
+	ticket := t.tickets[receipt.User.UserID]
+	view := receiptView{
+		Passenger:     strings.TrimSpace(receipt.User.FirstName + " " + receipt.User.LastName),
+		Email:         receipt.User.Email,
+		From:          receipt.From,
+		To:            receipt.To,
+		Section:       receipt.Section,
+		Class:         titleCase(receipt.Class.String()),
+		Seat:          receipt.SeatNumber,
+		CompanionSeat: receipt.CompanionSeat,
+		TicketID:      ticket.TicketId,
+		InvoiceNumber: receipt.InvoiceNumber,
+		Price:         formatMoney(receipt.PricePaid),
+		CheckID:       receipt.CheckID,
+		IssuedOn:      time.Now().Format("2 Jan 2006 15:04 MST"),
+		QRPayload:     qrPayload(ticket),
+	}
+	if section, ok := t.sections[receipt.Section]; ok {
+		view.Section = section.Section
+	}
+	for _, line := range receipt.FareBreakdown {
+		view.FareLines = append(view.FareLines, fareLineView{Description: line.Description, Amount: formatMoney(line.Amount)})
+	}
+	t.mu.RUnlock()
+
+	content, contentType, err := t.receipts.render(view, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	extension := map[pb.ReceiptFormat]string{pb.ReceiptFormat_PDF: "pdf", pb.ReceiptFormat_HTML: "html", pb.ReceiptFormat_TEXT: "txt"}[req.Format]
+	return &pb.RenderedReceipt{
+		Format:      req.Format,
+		ContentType: contentType,
+		FileName:    "receipt-" + view.TicketID + "." + extension,
+		Content:     content,
+	}, nil
+}
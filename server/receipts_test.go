@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+func TestRenderReceipt(t *testing.T) {
+	t.Run("Formats", testRenderReceiptFormats)
+	t.Run("OperatorTemplates", testRenderReceiptOperatorTemplates)
+}
+
+func setupReceipt(t *testing.T, s *trainServer) *pb.Ticket {
+	t.Helper()
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "Coach A", TotalSeats: 10})
+	user, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test@gmail.com"})
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "London (Euston)", To: "Manchester", UserID: user.UserID, PricePaid: usd(4250)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	return ticket
+}
+func testRenderReceiptFormats(t *testing.T) {
+	s := setupTestServer()
+	ticket := setupReceipt(t, s)
+
+	text, err := s.RenderReceipt(context.Background(), &pb.RenderReceiptRequest{UserID: ticket.UserID, Format: pb.ReceiptFormat_TEXT})
+	if err != nil {
+		t.Fatalf("RenderReceipt failed: %v", err)
+	}
+	for _, want := range []string{"Aman jain", "London (Euston) to Manchester", "Coach A", "Seat:      1", "USD 42.50", "█"} {
+		if !strings.Contains(string(text.Content), want) {
+			t.Errorf("Expected the text receipt to contain %q:\n%s", want, text.Content)
+		}
+	}
+
+	html, _ := s.RenderReceipt(context.Background(), &pb.RenderReceiptRequest{UserID: ticket.UserID, Format: pb.ReceiptFormat_HTML})
+	if html.ContentType != "text/html; charset=utf-8" || !strings.Contains(string(html.Content), "<svg") || !strings.Contains(string(html.Content), "USD 42.50") {
+		t.Errorf("Expected an HTML receipt with an inline QR code, got %s", html.Content)
+	}
+
+	pdf, _ := s.RenderReceipt(context.Background(), &pb.RenderReceiptRequest{UserID: ticket.UserID, Format: pb.ReceiptFormat_PDF})
+	if !bytes.HasPrefix(pdf.Content, []byte("%PDF-1.4")) || pdf.FileName != "receipt-"+ticket.TicketId+".pdf" {
+		t.Fatalf("Expected a PDF file, got %q", pdf.FileName)
+	}
+	if !bytes.Contains(pdf.Content, []byte(`London \(Euston\) to Manchester) Tj`)) {
+		t.Errorf("Expected escaped journey text in the PDF")
+	}
+	// The cross-reference table must point at each object.
+	m := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(pdf.Content)
+	if m == nil {
+		t.Fatalf("Expected a startxref trailer")
+	}
+	xref, _ := strconv.Atoi(string(m[1]))
+	if !bytes.HasPrefix(pdf.Content[xref:], []byte("xref\n")) {
+		t.Fatalf("Expected startxref to point at the xref table")
+	}
+	for i, entry := range regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(pdf.Content[xref:], -1) {
+		offset, _ := strconv.Atoi(string(entry[1]))
+		if !bytes.HasPrefix(pdf.Content[offset:], []byte(strconv.Itoa(i+1)+" 0 obj")) {
+			t.Errorf("Expected object %d at offset %d", i+1, offset)
+		}
+	}
+}
+func testRenderReceiptOperatorTemplates(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, textReceiptTemplate), []byte("Operator receipt for {{.Passenger}} seat {{.Seat}}\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	renderer, err := newReceiptRenderer(dir)
+	if err != nil {
+		t.Fatalf("newReceiptRenderer failed: %v", err)
+	}
+	s := setupTestServer()
+	s.receipts = renderer
+	ticket := setupReceipt(t, s)
+	text, _ := s.RenderReceipt(context.Background(), &pb.RenderReceiptRequest{UserID: ticket.UserID, Format: pb.ReceiptFormat_TEXT})
+	if string(text.Content) != "Operator receipt for Aman jain seat 1\n" {
+		t.Errorf("Expected the operator text template, got %q", text.Content)
+	}
+	html, _ := s.RenderReceipt(context.Background(), &pb.RenderReceiptRequest{UserID: ticket.UserID, Format: pb.ReceiptFormat_HTML})
+	if !strings.Contains(string(html.Content), "<h1>Train ticket receipt</h1>") {
+		t.Errorf("Expected the built-in HTML template when no override exists")
+	}
+
+	os.WriteFile(filepath.Join(dir, htmlReceiptTemplate), []byte("{{.Missing"), 0o644)
+	if _, err := newReceiptRenderer(dir); err == nil {
+		t.Errorf("Expected a broken operator template to be rejected")
+	}
+}
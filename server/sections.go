@@ -0,0 +1,290 @@
+// sections.go
+
+package main
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// seatKey builds the allocatedSeats key for a seat in a section.
+func seatKey(sectionID string, seat int32) string {
+	return sectionID + "_" + strconv.Itoa(int(seat))
+}
+
+// seatRef identifies a seat in a section and, optionally, the companion seat booked with it.
+type seatRef struct {
+	section   string
+	seat      int32
+	companion int32
+}
+
+// seats lists the seat and, if there is one, the companion seat.
+func (r seatRef) seats() []int32 {
+	if r.companion == 0 {
+		return []int32{r.seat}
+	}
+	return []int32{r.seat, r.companion}
+}
+
+// sortedSectionIDs returns section ids in a stable order. Callers hold t.mu.
+func (t *trainServer) sortedSectionIDs() []string {
+	ids := make([]string, 0, len(t.sections))
+	for id := range t.sections {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// ticketsInSection returns the tickets seated in a section whose seat matches keep. Callers hold t.mu.
+func (t *trainServer) ticketsInSection(sectionID string, keep func(seat int32) bool) []*pb.Ticket {
+	tickets := []*pb.Ticket{}
+	for _, ticket := range t.tickets {
+		if ticket.Section == sectionID && keep(ticket.SeatNumber) {
+			tickets = append(tickets, ticket)
+		}
+	}
+	sort.Slice(tickets, func(i, j int) bool { return tickets[i].SeatNumber < tickets[j].SeatNumber })
+	return tickets
+}
+
+// planReassignment picks a free seat for every ticket, trying the candidate sections in
+// order and never offering a seat above maxSeat for that section. It returns false if
+// any ticket can not be placed. Callers hold t.mu.
+func (t *trainServer) planReassignment(tickets []*pb.Ticket, candidates []string, maxSeat map[string]int32) ([]seatRef, bool) {
+	now := time.Now()
+	taken := map[string]bool{}
+	plan := make([]seatRef, 0, len(tickets))
+	for _, ticket := range tickets {
+		placed := false
+		for _, sectionID := range candidates {
+			ref, ok := t.pickSeat(t.sections[sectionID], ticket.AccessibilityNeed, ticket.CompanionSeat != 0, maxSeat[sectionID], now, taken, ticket.UserID)
+			if !ok {
+				continue
+			}
+			taken[seatKey(ref.section, ref.seat)] = true
+			if ref.companion != 0 {
+				taken[seatKey(ref.section, ref.companion)] = true
+			}
+			plan = append(plan, ref)
+			placed = true
+			break
+		}
+		if !placed {
+			return nil, false
+		}
+	}
+	return plan, true
+}
+
+// moveTicket records a ticket reseated and recounts the sections involved. The ticket takes the
+// class of its new section, keeping the fare and invoice it was bought with. A ticket moved to
+// another train must be boarded again. Callers hold t.mu.
+func (t *trainServer) moveTicket(ticket *pb.Ticket, to seatRef, boardAgain bool) {
+	now := time.Now()
+	from := ticket.Section
+	moved := proto.Clone(ticket).(*pb.Ticket)
+	moved.Section, moved.SeatNumber, moved.CompanionSeat = to.section, to.seat, to.companion
+	moved.Class = t.sections[to.section].Class
+	if boardAgain {
+		moved.BoardedAt, moved.BoardedLocation = "", ""
+	}
+	moved.ModifiedOn = now.String()
+	moved.Version++
+	t.issueBoardingPass(moved, now)
+	t.recordTicketEvent(domainSeatModified, moved, nil)
+	if prev, ok := t.sections[from]; ok {
+		t.refreshSectionAvailability(prev, now)
+	}
+	t.refreshSectionAvailability(t.sections[to.section], now)
+}
+
+// cancelTicket removes a ticket and tells the passenger it was cancelled and refunded,
+// returning the amount to refund. Callers hold t.mu.
+func (t *trainServer) cancelTicket(ticket *pb.Ticket) *pb.Money {
+	cancelled := t.newBookingEvent(eventCancelled, ticket, "")
+	refund := t.removeTicket(ticket)
+	t.notify(cancelled)
+	if refund.MinorUnits > 0 {
+		refunded := t.newBookingEvent(eventRefunded, ticket, "")
+		refunded.Refund = refund
+		t.notify(refunded)
+	}
+	return refund
+}
+
+// removeTicket releases the ticket's seat, promotion redemption and loyalty points, credits its
+// invoice and removes it without notifying the passenger, returning the amount to refund.
+// Callers hold t.mu.
+func (t *trainServer) removeTicket(ticket *pb.Ticket) *pb.Money {
+	refund := newMoney(ticket.PricePaid.GetCurrencyCode(), ticket.PricePaid.GetMinorUnits())
+	t.recordTicketEvent(domainTicketCancelled, ticket, refund)
+	if ticket.PromoCode != "" {
+		t.releasePromotion(ticket.PromoCode, ticket.UserID)
+	}
+	t.reversePurchasePoints(ticket, time.Now())
+	t.issueCreditNote(ticket, time.Now())
+	if section, ok := t.sections[ticket.Section]; ok {
+		t.refreshSectionAvailability(section, time.Now())
+	}
+	return refund
+}
+
+// applyBookingPolicy reassigns or cancels the affected tickets according to policy.
+// Nothing is changed when an error is returned. Callers hold t.mu.
+func (t *trainServer) applyBookingPolicy(affected []*pb.Ticket, policy pb.BookingPolicy, candidates []string, maxSeat map[string]int32) ([]*pb.AffectedTicket, error) {
+	report := []*pb.AffectedTicket{}
+	if len(affected) == 0 {
+		return report, nil
+	}
+	switch policy {
+	case pb.BookingPolicy_BLOCK:
+		return nil, status.Errorf(codes.FailedPrecondition, "%d tickets are booked on affected seats", len(affected))
+	case pb.BookingPolicy_REASSIGN:
+		plan, ok := t.planReassignment(affected, candidates, maxSeat)
+		if !ok {
+			return nil, status.Error(codes.ResourceExhausted, "Not enough free seats to reassign affected tickets")
+		}
+		for i, ticket := range affected {
+			entry := &pb.AffectedTicket{
+				TicketId:    ticket.TicketId,
+				UserID:      ticket.UserID,
+				Outcome:     pb.TicketOutcome_REASSIGNED,
+				FromSection: ticket.Section,
+				FromSeat:    ticket.SeatNumber,
+			}
+			t.moveTicket(ticket, plan[i], false)
+			entry.ToSection = ticket.Section
+			entry.ToSeat = ticket.SeatNumber
+			t.notify(t.newBookingEvent(eventSeatChanged, ticket, "Your section has been changed by the operator."))
+			report = append(report, entry)
+		}
+	case pb.BookingPolicy_CANCEL:
+		for _, ticket := range affected {
+			report = append(report, &pb.AffectedTicket{
+				TicketId:    ticket.TicketId,
+				UserID:      ticket.UserID,
+				Outcome:     pb.TicketOutcome_CANCELLED,
+				FromSection: ticket.Section,
+				FromSeat:    ticket.SeatNumber,
+				Refund:      t.cancelTicket(ticket),
+			})
+		}
+	default:
+		return nil, errors.New("Invalid booking policy")
+	}
+	return report, nil
+}
+
+func (t *trainServer) ResizeSection(ctx context.Context, req *pb.ResizeSectionRequest) (*pb.SectionChangeReport, error) {
+	sectionID := strings.TrimSpace(req.SectionID)
+	if sectionID == "" {
+		return nil, errors.New("Provide section id")
+	} else if req.TotalSeats <= 0 {
+		return nil, errors.New("Total seats must be greater than 0")
+	} else if req.Version <= 0 {
+		return nil, errors.New("Provide version")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	section, ok := t.sections[sectionID]
+	if !ok {
+		return nil, errors.New("Invalid Section")
+	}
+	if section.Version != req.Version {
+		return nil, status.Error(codes.Aborted, "Section was modified by another request, reload and try again")
+	}
+
+	report := []*pb.AffectedTicket{}
+	if req.TotalSeats < section.TotalSeats {
+		affected := t.ticketsInSection(sectionID, func(seat int32) bool { return seat > req.TotalSeats })
+		for _, ticket := range t.ticketsInSection(sectionID, func(seat int32) bool { return seat <= req.TotalSeats }) {
+			if ticket.CompanionSeat > req.TotalSeats {
+				affected = append(affected, ticket)
+			}
+		}
+		// Prefer keeping passengers in the same section, then fall back to the others.
+		candidates := []string{sectionID}
+		maxSeat := map[string]int32{sectionID: req.TotalSeats}
+		for _, id := range t.sortedSectionIDs() {
+			if id != sectionID && t.sections[id].Class == section.Class {
+				candidates = append(candidates, id)
+				maxSeat[id] = t.sections[id].TotalSeats
+			}
+		}
+		var err error
+		if report, err = t.applyBookingPolicy(affected, req.Policy, candidates, maxSeat); err != nil {
+			return nil, err
+		}
+		t.seats[sectionID] = t.seats[sectionID][:req.TotalSeats]
+		t.releaseHolds(func(h *pb.Hold) bool {
+			return h.Section == sectionID && (h.SeatNumber > req.TotalSeats || h.CompanionSeat > req.TotalSeats)
+		}, time.Now())
+		t.removeSeatBlocks(sectionID, func(seat int32) bool { return seat > req.TotalSeats })
+	} else {
+		for seat := section.TotalSeats + 1; seat <= req.TotalSeats; seat++ {
+			t.seats[sectionID] = append(t.seats[sectionID], seat)
+		}
+	}
+	resized := proto.Clone(section).(*pb.Section)
+	resized.AccessibleSeats = slices.DeleteFunc(resized.AccessibleSeats, func(seat int32) bool { return seat > req.TotalSeats })
+	resized.TotalSeats = req.TotalSeats
+	resized.ModifiedOn = time.Now().String()
+	resized.Version++
+	t.recordSectionEvent(sectionResized, resized)
+	t.refreshSectionAvailability(section, time.Now())
+	t.publishSectionEvent(eventSectionResized, section)
+
+	return &pb.SectionChangeReport{Section: section, Tickets: report}, nil
+}
+
+func (t *trainServer) DeleteSection(ctx context.Context, req *pb.DeleteSectionRequest) (*pb.SectionChangeReport, error) {
+	sectionID := strings.TrimSpace(req.SectionID)
+	if sectionID == "" {
+		return nil, errors.New("Provide section id")
+	} else if req.Version <= 0 {
+		return nil, errors.New("Provide version")
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	section, ok := t.sections[sectionID]
+	if !ok {
+		return nil, errors.New("Invalid Section")
+	}
+	if section.Version != req.Version {
+		return nil, status.Error(codes.Aborted, "Section was modified by another request, reload and try again")
+	}
+
+	affected := t.ticketsInSection(sectionID, func(int32) bool { return true })
+	candidates := []string{}
+	maxSeat := map[string]int32{}
+	for _, id := range t.sortedSectionIDs() {
+		if id != sectionID && t.sections[id].Class == section.Class {
+			candidates = append(candidates, id)
+			maxSeat[id] = t.sections[id].TotalSeats
+		}
+	}
+	report, err := t.applyBookingPolicy(affected, req.Policy, candidates, maxSeat)
+	if err != nil {
+		return nil, err
+	}
+	t.releaseHolds(func(h *pb.Hold) bool { return h.Section == sectionID }, time.Now())
+	delete(t.seats, sectionID)
+	t.removeSeatBlocks(sectionID, func(int32) bool { return true })
+	t.recordSectionEvent(sectionDeleted, section)
+	t.publishSectionEvent(eventSectionDeleted, section)
+
+	return &pb.SectionChangeReport{Section: section, Tickets: report}, nil
+}
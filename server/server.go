@@ -5,28 +5,84 @@ package main
 import (
 	"context"
 	"errors"
+	"flag"
+	"fmt"
 	"log"
+	"log/slog"
 	"net"
+	"os"
+	"os/signal"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 
+	"project/ticketbook/boardingpass"
 	pb "project/ticketbook/ticket/generated"
 )
 
 type trainServer struct {
-	users          map[string]*pb.User
-	sections       map[string]*pb.Section
-	tickets        map[string]*pb.Ticket
-	seats          map[string][]int32
-	allocatedSeats map[string]string
-	mu             sync.RWMutex // Mutex to protect concurrent access to maps
+	// Users, sections, tickets and seat allocations, derived from the outbox: handlers only
+	// change them by recording events, and on startup they are rebuilt by replaying the log.
+	*bookingProjection
+	seats         map[string][]int32
+	blockedSeats  map[string]*pb.SeatBlock
+	promotions    map[string]*pb.Promotion
+	ledger        map[string][]*pb.LedgerEntry
+	holds         map[string]*pb.Hold
+	heldSeats     map[string]string
+	exchangeRates map[string]*pb.ExchangeRate
+	invoices      map[string]*pb.Invoice
+	userInvoices  map[string][]string
+	invoiceSeq    int64
+	creditNoteSeq int64
+	redemptions   map[string]map[string]int32
+	mu            sync.RWMutex // Mutex to protect concurrent access to maps
 	pb.UnimplementedTrainTicketingServer
+
+	// Outbox of the retained domain events in commit order, also guarded by mu; an event's
+	// Sequence is outboxBase plus its index plus one. Older events are trimmed into
+	// baseProjection. outboxChanged is closed and replaced whenever an event is recorded.
+	outbox         []*pb.DomainEvent
+	outboxBase     int64
+	baseProjection *bookingProjection
+	outboxChanged  chan struct{}
+	eventConsumers map[string]*pb.EventConsumer
+	closing        chan struct{} // closed on shutdown to end event streams
+	// Copies of the booking projection taken every snapshotInterval events, which bound the
+	// replay needed for time-travel queries.
+	snapshots []*bookingProjection
+	// Persistence of the wal and postgres storage backends, nil when the state is only kept in memory.
+	store *stateStore
+
+	// Settings from config, fixed once the server starts.
+	currency                string // base currency of fares and the loyalty ledger
+	fares                   map[pb.SeatClass]*pb.Money
+	concessions             map[pb.PassengerCategory]concessionRule
+	loyalty                 loyaltyConfig
+	pricing                 []routePricing
+	holdTTL                 time.Duration
+	invoicing               invoiceConfig
+	receipts                *receiptRenderer
+	passSigner              *boardingpass.Signer
+	passVerifier            *boardingpass.Verifier
+	notifier                *notifier
+	webhooks                *webhookDispatcher
+	snapshotInterval        int
+	retainEvents            int
+	accessibleReleaseCutoff time.Duration // How long before departure accessibility-reserved seats go on general sale
 }
 
 func IsValidEmail(email string) bool {
@@ -34,6 +90,35 @@ func IsValidEmail(email string) bool {
 	emailRegex := regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`)
 	return emailRegex.MatchString(email)
 }
+
+// emailUsed reports whether a user other than exceptID has the email. With a store shared with
+// other servers its unique index is the only check, as the users in memory may be stale.
+// Callers hold t.mu.
+func (t *trainServer) emailUsed(email, exceptID string) bool {
+	if t.sharedStore() {
+		return false
+	}
+	for _, user := range t.users {
+		if strings.ToLower(strings.TrimSpace(email)) == strings.ToLower(user.Email) && user.UserID != exceptID {
+			return true
+		}
+	}
+	return false
+}
+
+// sectionNameUsed reports whether a section other than exceptID has the name, leaving the check
+// to the unique index of a shared store like emailUsed. Callers hold t.mu.
+func (t *trainServer) sectionNameUsed(name, exceptID string) bool {
+	if t.sharedStore() {
+		return false
+	}
+	for _, section := range t.sections {
+		if strings.ToLower(strings.TrimSpace(name)) == strings.ToLower(section.Section) && section.SectionID != exceptID {
+			return true
+		}
+	}
+	return false
+}
 func (t *trainServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
 
 	if strings.TrimSpace(req.FirstName) == "" {
@@ -45,24 +130,30 @@ func (t *trainServer) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 	} else if !IsValidEmail(req.Email) {
 		return nil, errors.New("Provide valid email address")
 	}
-	for _, user := range t.users {
-		if strings.ToLower(strings.TrimSpace(req.Email)) == strings.ToLower(user.Email) {
-			return nil, errors.New("Email already used.")
-		}
+	if err := validatePassenger(req.Category, req.DateOfBirth); err != nil {
+		return nil, err
 	}
 	timenow := time.Now().String()
 	// Store User information
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	if t.emailUsed(req.Email, "") {
+		return nil, errors.New("Email already used.")
+	}
 	user := pb.User{
-		UserID:     uuid.NewString(),
-		FirstName:  strings.TrimSpace(req.FirstName),
-		LastName:   strings.TrimSpace(req.LastName),
-		Email:      strings.TrimSpace(req.Email),
-		CreatedOn:  timenow,
-		ModifiedOn: timenow,
-	}
-	t.users[user.UserID] = &user
+		UserID:           uuid.NewString(),
+		FirstName:        strings.TrimSpace(req.FirstName),
+		LastName:         strings.TrimSpace(req.LastName),
+		Email:            strings.TrimSpace(req.Email),
+		CreatedOn:        timenow,
+		ModifiedOn:       timenow,
+		Version:          1,
+		Category:         req.Category,
+		DateOfBirth:      strings.TrimSpace(req.DateOfBirth),
+		ConcessionCardID: strings.TrimSpace(req.ConcessionCardID),
+	}
+	t.recordUserEvent(domainUserCreated, &user)
+	t.publishUserEvent(eventUserCreated, &user)
 
 	return &user, nil
 }
@@ -96,35 +187,52 @@ func (t *trainServer) ModifyUser(ctx context.Context, req *pb.User) (*pb.User, e
 		return nil, errors.New("Provide email address")
 	} else if !IsValidEmail(req.Email) {
 		return nil, errors.New("Provide valid email address")
+	} else if req.Version <= 0 {
+		return nil, errors.New("Provide version")
 	}
+	if err := validatePassenger(req.Category, req.DateOfBirth); err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	oldData, ok := t.users[strings.TrimSpace(req.UserID)]
 	if !ok {
 		return nil, errors.New("Invalid User")
 	}
-	for _, user := range t.users {
-		if strings.ToLower(strings.TrimSpace(req.Email)) == strings.ToLower(user.Email) && strings.TrimSpace(req.UserID) != user.UserID {
-			return nil, errors.New("Email already used.")
-		}
+	if oldData.Version != req.Version {
+		return nil, status.Error(codes.Aborted, "User was modified by another request, reload and try again")
+	}
+	if t.emailUsed(req.Email, oldData.UserID) {
+		return nil, errors.New("Email already used.")
 	}
 	timenow := time.Now().String()
 	// Store User information
-	t.mu.Lock()
-	defer t.mu.Unlock()
 	user := pb.User{
-		UserID:     req.UserID,
-		FirstName:  strings.TrimSpace(req.FirstName),
-		LastName:   strings.TrimSpace(req.LastName),
-		Email:      strings.TrimSpace(req.Email),
-		CreatedOn:  oldData.CreatedOn,
-		ModifiedOn: timenow,
-	}
-	t.users[user.UserID] = &user
+		UserID:           oldData.UserID,
+		FirstName:        strings.TrimSpace(req.FirstName),
+		LastName:         strings.TrimSpace(req.LastName),
+		Email:            strings.TrimSpace(req.Email),
+		CreatedOn:        oldData.CreatedOn,
+		ModifiedOn:       timenow,
+		Version:          oldData.Version + 1,
+		Category:         req.Category,
+		DateOfBirth:      strings.TrimSpace(req.DateOfBirth),
+		ConcessionCardID: strings.TrimSpace(req.ConcessionCardID),
+	}
+	renamed := user.FirstName != oldData.FirstName || user.LastName != oldData.LastName
+	t.recordUserEvent(domainUserUpdated, &user)
+	if renamed {
+		t.reissueBoardingPasses(func(ticket *pb.Ticket) bool { return ticket.UserID == user.UserID })
+	}
+	t.publishUserEvent(eventUserUpdated, &user)
 
 	return &user, nil
 }
 func (t *trainServer) RemoveUser(ctx context.Context, req *pb.UseRequest) (*pb.EmptyResponse, error) {
 	userid := strings.TrimSpace(req.UserID)
-	_, ok := t.users[userid]
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	user, ok := t.users[userid]
 	if !ok {
 		return nil, errors.New("Invalid User")
 	}
@@ -132,42 +240,62 @@ func (t *trainServer) RemoveUser(ctx context.Context, req *pb.UseRequest) (*pb.E
 	if tOk {
 		return nil, errors.New("Cancel current tickets for this user then try again")
 	}
-	delete(t.users, userid)
-	return nil, nil
+	delete(t.ledger, userid)
+	t.touch(tableLedger, userid)
+	t.recordUserEvent(domainUserRemoved, user)
+	t.publishUserEvent(eventUserRemoved, user)
+	return &pb.EmptyResponse{}, nil
 }
 func (t *trainServer) CreateSection(ctx context.Context, req *pb.CreateSectionRequest) (*pb.Section, error) {
 	if strings.TrimSpace(req.Section) == "" {
 		return nil, errors.New("Provide section")
 	} else if req.TotalSeats <= 0 {
 		return nil, errors.New("Total seats must be greater than 0")
+	} else if !validSeatClass(req.Class) {
+		return nil, errors.New("Invalid seat class")
 	}
-	for _, section := range t.sections {
-		if strings.ToLower(strings.TrimSpace(req.Section)) == strings.ToLower(section.Section) {
-			return nil, errors.New("Section name already used.")
-		}
+	amenities, err := normalizeAmenities(req.Amenities)
+	if err != nil {
+		return nil, err
+	}
+	accessible, departure, err := validateAccessibleSeats(req.AccessibleSeats, req.TotalSeats, req.Departure)
+	if err != nil {
+		return nil, err
 	}
 	timenow := time.Now().String()
 	// Store User information
 	t.mu.Lock()
 	defer t.mu.Unlock()
+	if t.sectionNameUsed(req.Section, "") {
+		return nil, errors.New("Section name already used.")
+	}
 	section := pb.Section{
-		SectionID:      uuid.NewString(),
-		Section:        strings.TrimSpace(req.Section),
-		TotalSeats:     req.TotalSeats,
-		AvailableSeats: req.TotalSeats,
-		CreatedOn:      timenow,
-		ModifiedOn:     timenow,
-	}
-	t.sections[section.SectionID] = &section
+		SectionID:       uuid.NewString(),
+		Section:         strings.TrimSpace(req.Section),
+		TotalSeats:      req.TotalSeats,
+		AvailableSeats:  req.TotalSeats,
+		CreatedOn:       timenow,
+		ModifiedOn:      timenow,
+		Version:         1,
+		Class:           req.Class,
+		Amenities:       amenities,
+		AccessibleSeats: accessible,
+		Departure:       departure,
+	}
 	seats := []int32{}
 	for i := int32(1); i <= req.TotalSeats; i++ {
 		seats = append(seats, i)
 	}
 	t.seats[section.SectionID] = seats
+	t.recordSectionEvent(sectionCreated, &section)
+	t.publishSectionEvent(eventSectionCreated, &section)
 
 	return &section, nil
 }
 func (t *trainServer) ViewSections(ctx context.Context, req *pb.SectionRequest) (*pb.AllSections, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.refreshAvailability(time.Now())
 	if strings.TrimSpace(req.SectionID) != "" {
 		section, ok := t.sections[strings.TrimSpace(req.SectionID)]
 		if ok {
@@ -190,89 +318,152 @@ func (t *trainServer) ModifySections(ctx context.Context, req *pb.ModifySectionR
 		return nil, errors.New("Provide section id")
 	} else if strings.TrimSpace(req.Section) == "" {
 		return nil, errors.New("Provide section name")
+	} else if req.Version <= 0 {
+		return nil, errors.New("Provide version")
+	} else if !validSeatClass(req.Class) {
+		return nil, errors.New("Invalid seat class")
 	}
+	amenities, err := normalizeAmenities(req.Amenities)
+	if err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	oldData, ok := t.sections[strings.TrimSpace(req.SectionID)]
 	if !ok {
 		return nil, errors.New("Invalid Section")
 	}
-	for _, section := range t.sections {
-		if strings.ToLower(strings.TrimSpace(req.Section)) == strings.ToLower(section.Section) && strings.TrimSpace(req.SectionID) != section.SectionID {
-			return nil, errors.New("Section name already used.")
-		}
+	if oldData.Version != req.Version {
+		return nil, status.Error(codes.Aborted, "Section was modified by another request, reload and try again")
+	}
+	accessible, departure, err := validateAccessibleSeats(req.AccessibleSeats, oldData.TotalSeats, req.Departure)
+	if err != nil {
+		return nil, err
+	}
+	if t.sectionNameUsed(req.Section, oldData.SectionID) {
+		return nil, errors.New("Section name already used.")
 	}
 	timenow := time.Now().String()
 	// Store User information
-	t.mu.Lock()
-	defer t.mu.Unlock()
 	section := pb.Section{
-		SectionID:      oldData.SectionID,
-		Section:        strings.TrimSpace(req.Section),
-		TotalSeats:     oldData.TotalSeats,
-		AvailableSeats: oldData.AvailableSeats,
-		CreatedOn:      oldData.CreatedOn,
-		ModifiedOn:     timenow,
-	}
-	t.sections[section.SectionID] = &section
+		SectionID:       oldData.SectionID,
+		Section:         strings.TrimSpace(req.Section),
+		TotalSeats:      oldData.TotalSeats,
+		AvailableSeats:  oldData.AvailableSeats,
+		CreatedOn:       oldData.CreatedOn,
+		ModifiedOn:      timenow,
+		Version:         oldData.Version + 1,
+		Class:           req.Class,
+		Amenities:       amenities,
+		AccessibleSeats: accessible,
+		Departure:       departure,
+	}
+	section.Cancelled, section.DelayMinutes, section.DisruptionReason = oldData.Cancelled, oldData.DelayMinutes, oldData.DisruptionReason
+	renamed := section.Section != oldData.Section
+	t.recordSectionEvent(sectionUpdated, &section)
+	if renamed {
+		t.reissueBoardingPasses(func(ticket *pb.Ticket) bool { return ticket.Section == section.SectionID })
+	}
+	t.publishSectionEvent(eventSectionUpdated, &section)
 
 	return &section, nil
 }
 func (t *trainServer) PurchaseTicket(ctx context.Context, req *pb.TicketRequest) (*pb.Ticket, error) {
-	if strings.TrimSpace(req.From) == "" {
-		return nil, errors.New("From can not be blank")
-	} else if strings.TrimSpace(req.To) == "" {
-		return nil, errors.New("To can not be blank")
-	} else if strings.TrimSpace(req.UserID) == "" {
-		return nil, errors.New("UserID can not be blank")
-	} else if req.PricePaid < 0 {
-		return nil, errors.New("Price paid can not be less than 0")
-	} else if strings.TrimSpace(req.From) == strings.TrimSpace(req.To) {
-		return nil, errors.New("From and to can not be same")
-	}
-	if _, uok := t.users[strings.TrimSpace(req.UserID)]; !uok {
+	if err := validateTicketRequest(req); err != nil {
+		return nil, err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	user, uok := t.users[strings.TrimSpace(req.UserID)]
+	if !uok {
 		return nil, errors.New("Invalid user")
 	}
 	if _, tok := t.tickets[strings.TrimSpace(req.UserID)]; tok {
 		return nil, errors.New("Ticket already book for this user")
 	}
-	availableSection := ""
-	for _, section := range t.sections {
-		if section.AvailableSeats > 0 {
-			availableSection = strings.TrimSpace(section.SectionID)
-			break
+	now := time.Now()
+	var promo *pb.Promotion
+	if strings.TrimSpace(req.PromoCode) != "" {
+		var err error
+		if promo, err = t.redeemablePromotion(req.PromoCode, req, user.UserID, now); err != nil {
+			return nil, err
 		}
 	}
-	if availableSection == "" {
-		return nil, errors.New("All seats are booked!")
-	}
-	seats := t.seats[availableSection]
-	var seatnumber int32
-	for _, seat := range seats {
-		_, seatOK := t.allocatedSeats[availableSection+"_"+strconv.Itoa(int(seat))]
-		if !seatOK {
-			seatnumber = seat
-			break
+	var hold *pb.Hold
+	var allocation seatRef
+	var fare fareQuote
+	if strings.TrimSpace(req.HoldID) != "" {
+		var err error
+		if hold, allocation, fare, err = t.heldAllocation(req, now); err != nil {
+			return nil, err
+		}
+		if !promotionAllowsSection(promo, allocation.section) {
+			return nil, errors.New("Promo code is not valid for the held section")
+		}
+		if !t.claimSeats(allocation, user.UserID) {
+			return nil, status.Error(codes.Aborted, "Held seat was taken by another booking, hold a seat again")
+		}
+	} else {
+		t.refreshAvailability(now)
+		found := false
+		for _, id := range t.sortedSectionIDs() {
+			section := t.sections[id]
+			if section.Class == req.Class && section.AvailableSeats > 0 && promotionAllowsSection(promo, section.SectionID) {
+				if allocation, found = t.pickSeat(section, req.AccessibilityNeed, req.Companion, section.TotalSeats, now, nil, user.UserID); found {
+					break
+				}
+			}
+		}
+		if !found {
+			return nil, errors.New("All seats are booked in " + req.Class.String() + " class!")
+		}
+		var err error
+		if fare, err = t.quoteFare(req, t.sections[allocation.section], now); err != nil {
+			return nil, err
 		}
 	}
+	if err := t.applyConcession(&fare, user, now); err != nil {
+		return nil, err
+	}
+	if err := t.applyPromotion(&fare, promo); err != nil {
+		return nil, err
+	}
+	if err := t.applyPointsRedemption(&fare, user.UserID, req.RedeemPoints, now); err != nil {
+		return nil, err
+	}
 	timenow := time.Now().String()
 	ticket := &pb.Ticket{
-		TicketId:   uuid.NewString(),
-		From:       strings.TrimSpace(req.From),
-		To:         strings.TrimSpace(req.To),
-		UserID:     strings.TrimSpace(req.UserID),
-		PricePaid:  req.PricePaid,
-		Section:    availableSection,
-		SeatNumber: seatnumber,
-		CreatedOn:  timenow,
-		ModifiedOn: timenow,
-	}
-	// Store ticket information
-	t.mu.Lock()
-	defer t.mu.Unlock()
-	t.tickets[req.UserID] = ticket
-	section := t.sections[availableSection]
-	section.AvailableSeats = section.AvailableSeats - 1
-	t.sections[availableSection] = section
-	t.allocatedSeats[availableSection+"_"+strconv.Itoa(int(seatnumber))] = ticket.UserID
+		TicketId:          uuid.NewString(),
+		From:              strings.TrimSpace(req.From),
+		To:                strings.TrimSpace(req.To),
+		UserID:            strings.TrimSpace(req.UserID),
+		PricePaid:         newMoney(fare.currency, fare.total),
+		Section:           allocation.section,
+		SeatNumber:        allocation.seat,
+		CreatedOn:         timenow,
+		ModifiedOn:        timenow,
+		Version:           1,
+		Class:             req.Class,
+		AccessibilityNeed: req.AccessibilityNeed,
+		CompanionSeat:     allocation.companion,
+		Category:          user.Category,
+		FareBreakdown:     fare.lines,
+	}
+	if err := t.recordPurchasePoints(ticket, req.RedeemPoints, now); err != nil {
+		return nil, err
+	}
+	if promo != nil {
+		ticket.PromoCode = promo.Code
+		t.redeemPromotion(promo.Code, ticket.UserID)
+	}
+	if hold != nil {
+		t.releaseHold(hold)
+	}
+	t.issueInvoice(ticket, user, now)
+	t.issueBoardingPass(ticket, now)
+	t.recordTicketEvent(domainTicketPurchased, ticket, nil)
+	t.refreshSectionAvailability(t.sections[allocation.section], now)
+	t.notify(t.newBookingEvent(eventBooked, ticket, ""))
 	return ticket, nil
 }
 func (t *trainServer) ViewReceipt(ctx context.Context, req *pb.UseRequest) (*pb.Receipt, error) {
@@ -289,23 +480,37 @@ func (t *trainServer) ViewReceipt(ctx context.Context, req *pb.UseRequest) (*pb.
 		return nil, errors.New("Ticket not found")
 	}
 	receipt := &pb.Receipt{
-		From:       ticket.From,
-		To:         ticket.To,
-		User:       user,
-		PricePaid:  ticket.PricePaid,
-		Section:    ticket.Section,
-		SeatNumber: ticket.SeatNumber,
-		CreatedOn:  ticket.CreatedOn,
-		ModifiedOn: ticket.ModifiedOn,
+		From:          ticket.From,
+		To:            ticket.To,
+		User:          user,
+		PricePaid:     ticket.PricePaid,
+		Section:       ticket.Section,
+		SeatNumber:    ticket.SeatNumber,
+		CreatedOn:     ticket.CreatedOn,
+		ModifiedOn:    ticket.ModifiedOn,
+		Class:         ticket.Class,
+		CompanionSeat: ticket.CompanionSeat,
+		Category:      ticket.Category,
+		FareBreakdown: ticket.FareBreakdown,
+		CheckID:       ticket.Category != pb.PassengerCategory_ADULT,
+		PromoCode:     ticket.PromoCode,
+		InvoiceNumber: ticket.InvoiceNumber,
+		BoardingPass:  ticket.BoardingPass,
+	}
+	if section, ok := t.sections[ticket.Section]; ok {
+		receipt.Amenities = section.Amenities
 	}
 	return receipt, nil
 }
 func (t *trainServer) ViewSeatsBySection(ctx context.Context, req *pb.SectionRequest) (*pb.SeatAllocation, error) {
 	sectionId := strings.TrimSpace(req.SectionID)
+	t.mu.RLock()
+	defer t.mu.RUnlock()
 	_, sOk := t.sections[sectionId]
 	if !sOk {
 		return nil, errors.New("Invalid section")
 	}
+	now := time.Now()
 	seats := []*pb.SeatDetails{}
 	for key, userid := range t.allocatedSeats {
 		keyArr := strings.Split(key, "_")
@@ -317,28 +522,36 @@ func (t *trainServer) ViewSeatsBySection(ctx context.Context, req *pb.SectionReq
 				Email:      user.Email,
 				SeatNumber: int32(seatNumber),
 			}
+			if block, blocked := t.blockedSeats[key]; blocked && blockActive(block, now) {
+				seatdetail.Blocked = true
+				seatdetail.BlockReason = block.Reason
+			}
 			seats = append(seats, &seatdetail)
 		}
 	}
+	for key, block := range t.blockedSeats {
+		if block.SectionID != sectionId || !blockActive(block, now) {
+			continue
+		}
+		if _, allocated := t.allocatedSeats[key]; !allocated {
+			seats = append(seats, &pb.SeatDetails{SeatNumber: block.SeatNumber, Blocked: true, BlockReason: block.Reason})
+		}
+	}
 	if len(seats) == 0 {
 		return nil, errors.New("No seats allocated")
 	}
 	return &pb.SeatAllocation{Tickets: seats}, nil
 }
-func (t *trainServer) CancelReceipt(ctx context.Context, req *pb.UseRequest) (*pb.EmptyResponse, error) {
+func (t *trainServer) CancelReceipt(ctx context.Context, req *pb.CancelReceiptRequest) (*pb.EmptyResponse, error) {
 	userid := strings.TrimSpace(req.UserID)
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	ticket, ok := t.tickets[userid]
 	if !ok {
 		return nil, errors.New("No ticket found for user")
 	}
-	t.mu.Lock()
-	defer t.mu.Unlock()
-	section := t.sections[ticket.Section]
-	section.AvailableSeats += 1
-	t.sections[ticket.Section] = section
-	delete(t.allocatedSeats, ticket.Section+"_"+strconv.Itoa(int(ticket.SeatNumber)))
-	delete(t.tickets, userid)
-	return nil, nil
+	t.cancelTicket(ticket)
+	return &pb.EmptyResponse{}, nil
 }
 func (t *trainServer) ModifySeat(ctx context.Context, req *pb.ModifySeatRequest) (*pb.Ticket, error) {
 	userid := strings.TrimSpace(req.UserID)
@@ -349,52 +562,237 @@ func (t *trainServer) ModifySeat(ctx context.Context, req *pb.ModifySeatRequest)
 	if req.SeatNumber < 1 {
 		return nil, errors.New("Invalid seat number")
 	}
+	if req.Version <= 0 {
+		return nil, errors.New("Provide version")
+	}
 
+	t.mu.Lock()
+	defer t.mu.Unlock()
 	ticket, ok := t.tickets[userid]
 	if !ok {
 		return nil, errors.New("No ticket found for user")
 	}
+	if ticket.Version != req.Version {
+		return nil, status.Error(codes.Aborted, "Ticket was modified by another request, reload and try again")
+	}
 	section, sOk := t.sections[reqSection]
 	if !sOk {
 		return nil, errors.New("Invalid Section")
 	}
+	if section.Cancelled {
+		return nil, errors.New("Requested section has been cancelled")
+	}
 	if req.SeatNumber > section.TotalSeats {
 		return nil, errors.New("Seat number can not be more than total seats")
 	}
-	allocateduserid, sOk := t.allocatedSeats[reqSection+"_"+strconv.Itoa(int(req.SeatNumber))]
+	if section.Class != ticket.Class {
+		return nil, errors.New("Requested section is a different class than the ticket")
+	}
+	allocateduserid, sOk := t.allocatedSeats[seatKey(reqSection, req.SeatNumber)]
 	if sOk && allocateduserid != userid {
 		return nil, errors.New("Requested seat already allocated to other user")
 	}
-	delete(t.allocatedSeats, ticket.Section+"_"+strconv.Itoa(int(ticket.SeatNumber)))
-	if ticket.Section != reqSection {
-		prevSection := t.sections[ticket.Section]
-		prevSection.AvailableSeats += 1
-		t.sections[ticket.Section] = prevSection
-
-		currentSection := t.sections[reqSection]
-		currentSection.AvailableSeats -= 1
-		t.sections[reqSection] = currentSection
-	}
-	t.allocatedSeats[reqSection+"_"+strconv.Itoa(int(req.SeatNumber))] = userid
-	ticket.Section = reqSection
-	ticket.SeatNumber = req.SeatNumber
-	ticket.ModifiedOn = time.Now().String()
-	t.tickets[userid] = ticket
+	now := time.Now()
+	if t.seatBlocked(seatKey(reqSection, req.SeatNumber), now) {
+		return nil, errors.New("Requested seat is blocked")
+	}
+	if t.seatHeld(seatKey(reqSection, req.SeatNumber), now) {
+		return nil, errors.New("Requested seat is held by another passenger")
+	}
+	if !t.seatOffered(section, req.SeatNumber, ticket.AccessibilityNeed, now) {
+		return nil, errors.New("Requested seat is reserved for passengers with accessibility needs")
+	}
+	to := seatRef{section: reqSection, seat: req.SeatNumber}
+	if ticket.CompanionSeat != 0 {
+		if to.companion = t.companionSeatNear(section, req.SeatNumber, userid, now); to.companion == 0 {
+			return nil, errors.New("No free seat next to the requested seat for the companion")
+		}
+	}
+	if !t.claimSeats(to, userid) {
+		return nil, errors.New("Requested seat already allocated to other user")
+	}
+	t.moveTicket(ticket, to, false)
+	t.notify(t.newBookingEvent(eventSeatChanged, ticket, ""))
 	return ticket, nil
 }
+
+// newTrainServer builds a server from the loaded configuration, signing boarding passes with
+// signer and checking them with verifier.
+func newTrainServer(cfg *config, signer *boardingpass.Signer, verifier *boardingpass.Verifier) (*trainServer, error) {
+	receipts, err := newReceiptRenderer(cfg.ReceiptTemplateDir)
+	if err != nil {
+		return nil, fmt.Errorf("receipt templates: %w", err)
+	}
+	notifications := cfg.Notifications
+	notifier, err := newNotifier(notifications.notificationChannels(), notifications.TemplateDir, notifications.MaxAttempts, time.Duration(notifications.RetryBackoff))
+	if err != nil {
+		return nil, fmt.Errorf("notification templates: %w", err)
+	}
+	t := &trainServer{
+		outboxChanged:           make(chan struct{}),
+		closing:                 make(chan struct{}),
+		fares:                   cfg.fareTable(),
+		currency:                cfg.Currency,
+		concessions:             cfg.concessionRules(),
+		loyalty:                 cfg.Loyalty,
+		pricing:                 cfg.pricingRules(),
+		holdTTL:                 time.Duration(cfg.HoldTTL),
+		invoicing:               cfg.Invoicing,
+		receipts:                receipts,
+		passSigner:              signer,
+		passVerifier:            verifier,
+		notifier:                notifier,
+		webhooks:                newWebhookDispatcher(cfg.Webhooks),
+		accessibleReleaseCutoff: time.Duration(cfg.AccessibleReleaseCutoff),
+		snapshotInterval:        cfg.Storage.SnapshotInterval,
+		retainEvents:            cfg.Storage.RetainEvents,
+	}
+	t.resetState()
+	return t, nil
+}
+
+// resetState empties the users, bookings and other state the store persists. Callers hold
+// t.mu or have not shared t yet.
+func (t *trainServer) resetState() {
+	t.bookingProjection = newBookingProjection()
+	t.bookingProjection.live = true
+	t.seats = make(map[string][]int32)
+	t.blockedSeats = make(map[string]*pb.SeatBlock)
+	t.promotions = make(map[string]*pb.Promotion)
+	t.ledger = make(map[string][]*pb.LedgerEntry)
+	t.holds = make(map[string]*pb.Hold)
+	t.heldSeats = make(map[string]string)
+	t.redemptions = make(map[string]map[string]int32)
+	t.exchangeRates = make(map[string]*pb.ExchangeRate)
+	t.invoices = make(map[string]*pb.Invoice)
+	t.userInvoices = make(map[string][]string)
+	t.invoiceSeq, t.creditNoteSeq = 0, 0
+	t.outbox, t.outboxBase, t.baseProjection = nil, 0, newBookingProjection()
+	t.eventConsumers = make(map[string]*pb.EventConsumer)
+	t.snapshots = nil
+}
+
+// fatal logs an error and exits, like log.Fatal but through the configured slog handler.
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
 func main() {
-	lis, err := net.Listen("tcp", ":8080")
+	cfg, printConfig, err := loadConfig(os.Args[1:], os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	} else if err != nil {
+		log.Fatalf("invalid configuration: %v", err)
+	}
+	if printConfig {
+		if err := cfg.print(os.Stdout); err != nil {
+			log.Fatalf("failed to print config: %v", err)
+		}
+		return
+	}
+	level, _ := cfg.slogLevel()
+	slog.SetDefault(slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: level})))
+
+	lis, err := net.Listen("tcp", cfg.ListenAddr)
+	if err != nil {
+		fatal("failed to listen", "error", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts := []grpc.ServerOption{
+		grpc.ChainStreamInterceptor(tlsIdentityStreamInterceptor),
+	}
+	if cfg.TLS.CertFile != "" {
+		reloader, err := newCertReloader(cfg.TLS)
+		if err != nil {
+			fatal("failed to load TLS certificates", "error", err)
+		}
+		go reloader.watch(ctx, time.Duration(cfg.TLS.ReloadInterval))
+		opts = append(opts, grpc.Creds(credentials.NewTLS(reloader.tlsConfig())))
+	}
+
+	signer, verifier, ephemeral, err := cfg.BoardingPass.load()
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		fatal("failed to load boarding pass keys", "error", err)
+	}
+	if ephemeral {
+		slog.Warn("no boarding pass signing key configured, passes stop verifying after a restart", "key_id", signer.KeyID())
+	}
+	server, err := newTrainServer(cfg, signer, verifier)
+	if err != nil {
+		fatal("failed to set up the server", "error", err)
+	}
+	switch cfg.Storage.Backend {
+	case "wal":
+		recovery, err := server.openWALStore(cfg.Storage.Dir, cfg.Storage.CompactEvery)
+		if err != nil {
+			fatal("failed to recover from the write-ahead log", "error", err)
+		}
+		slog.Info("recovered write-ahead log", "records", recovery.Records, "dir", cfg.Storage.Dir)
+		if recovery.TruncatedBytes > 0 {
+			slog.Warn("truncated a record torn by a crash", "bytes", recovery.TruncatedBytes)
+		}
+	case "postgres":
+		pgConfig, err := pgxpool.ParseConfig(cfg.Storage.DSN)
+		if err != nil {
+			fatal("invalid storage dsn", "error", err)
+		}
+		if err := server.openPostgresStore(ctx, pgConfig); err != nil {
+			fatal("failed to restore from postgres", "error", err)
+		}
 	}
+	// Changes are made durable before the idempotency store keeps a response for replays.
+	opts = append(opts, grpc.ChainUnaryInterceptor(tlsIdentityUnaryInterceptor, newIdempotencyStore(time.Duration(cfg.IdempotencyWindow)).unaryInterceptor, server.persistUnaryInterceptor))
+	grpcServer := grpc.NewServer(opts...)
+	pb.RegisterTrainTicketingServer(grpcServer, server)
 
-	grpcServer := grpc.NewServer()
-	pb.RegisterTrainTicketingServer(grpcServer, &trainServer{
-		users:          make(map[string]*pb.User),
-		tickets:        make(map[string]*pb.Ticket),
-		sections:       make(map[string]*pb.Section),
-		seats:          make(map[string][]int32),
-		allocatedSeats: make(map[string]string),
-	})
-	grpcServer.Serve(lis)
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	// Not serving until the first check, which runs at once.
+	setServingStatus(healthServer, false)
+	if cfg.Features.Reflection {
+		reflection.Register(grpcServer)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
+		select {
+		case sig := <-stop:
+			slog.Info("draining in-flight requests", "signal", sig)
+		case <-server.storeFailed():
+			// Changes the store could not save are still in memory, so stop serving them.
+			slog.Error("stopping after a storage failure", "error", server.store.broken())
+		}
+		healthServer.Shutdown()
+		server.closeEventStreams()
+		gracefulStop(grpcServer, time.Duration(cfg.ShutdownTimeout))
+	}()
+	go server.watchHealth(healthServer, done)
+	if err := grpcServer.Serve(lis); err != nil {
+		fatal("failed to serve", "error", err)
+	}
+	<-done
+	if err := server.closeStore(); err != nil {
+		slog.Error("failed to persist the state on shutdown", "error", err)
+	}
+	// Let queued notifications and webhook deliveries finish, but not wait for ever on
+	// endpoints that keep failing.
+	drained := make(chan struct{})
+	go func() {
+		server.notifier.wait()
+		server.webhooks.wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+	case <-time.After(time.Duration(cfg.ShutdownTimeout)):
+		slog.Warn("gave up waiting for notifications and webhook deliveries")
+	}
+	if err := server.store.broken(); err != nil {
+		fatal("stopped after a storage failure", "error", err)
+	}
 }
@@ -0,0 +1,1124 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// setupTestServer returns a server with an ephemeral boarding pass key, no fares, concessions,
+// loyalty scheme, hold expiry or notification channels; tests set the ones they need.
+func setupTestServer() *trainServer {
+	cfg := defaultConfig()
+	cfg.Concessions, cfg.Loyalty, cfg.Invoicing = nil, loyaltyConfig{}, invoiceConfig{}
+	cfg.HoldTTL, cfg.AccessibleReleaseCutoff = 0, 0
+	cfg.Notifications = notificationConfig{MaxAttempts: 1}
+	cfg.Storage.SnapshotInterval = 0
+	signer, verifier, _, err := cfg.BoardingPass.load()
+	if err != nil {
+		panic("boarding pass key: " + err.Error())
+	}
+	s, err := newTrainServer(cfg, signer, verifier)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+func usd(cents int64) *pb.Money {
+	return &pb.Money{CurrencyCode: "USD", MinorUnits: cents}
+}
+
+func TestOptimisticConcurrency(t *testing.T) {
+	t.Run("ModifyUser", testModifyUserVersion)
+	t.Run("ModifySections", testModifySectionsVersion)
+	t.Run("ModifySeat", testModifySeatVersion)
+}
+func testModifyUserVersion(t *testing.T) {
+	s := setupTestServer()
+	user, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test@gmail.com"})
+	if err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+	first := &pb.User{UserID: user.UserID, FirstName: "Aman", LastName: "Jain", Email: "test@gmail.com", Version: user.Version}
+	updated, err := s.ModifyUser(context.Background(), first)
+	if err != nil {
+		t.Fatalf("ModifyUser failed: %v", err)
+	}
+	if updated.Version != user.Version+1 {
+		t.Errorf("Expected version %d, got %d", user.Version+1, updated.Version)
+	}
+	stale := &pb.User{UserID: user.UserID, FirstName: "A", LastName: "J", Email: "test@gmail.com", Version: user.Version}
+	if _, err := s.ModifyUser(context.Background(), stale); status.Code(err) != codes.Aborted {
+		t.Errorf("Expected Aborted for a stale version, got %v", err)
+	}
+}
+func testModifySectionsVersion(t *testing.T) {
+	s := setupTestServer()
+	section, err := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	if err != nil {
+		t.Fatalf("CreateSection failed: %v", err)
+	}
+	if _, err := s.ModifySections(context.Background(), &pb.ModifySectionRequest{SectionID: section.SectionID, Section: "B", Version: section.Version}); err != nil {
+		t.Fatalf("ModifySections failed: %v", err)
+	}
+	_, err = s.ModifySections(context.Background(), &pb.ModifySectionRequest{SectionID: section.SectionID, Section: "C", Version: section.Version})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("Expected Aborted for a stale version, got %v", err)
+	}
+}
+func testModifySeatVersion(t *testing.T) {
+	s := setupTestServer()
+	user, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test@gmail.com"})
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	version := ticket.Version
+	if _, err := s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: user.UserID, Section: section.SectionID, SeatNumber: 3, Version: version}); err != nil {
+		t.Fatalf("ModifySeat failed: %v", err)
+	}
+	_, err = s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: user.UserID, Section: section.SectionID, SeatNumber: 4, Version: version})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("Expected Aborted for a stale version, got %v", err)
+	}
+}
+
+func setupBookedSection(t *testing.T, s *trainServer, seats int32, bookings int) *pb.Section {
+	t.Helper()
+	section, err := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: seats})
+	if err != nil {
+		t.Fatalf("CreateSection failed: %v", err)
+	}
+	for i := 0; i < bookings; i++ {
+		user, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test" + strconv.Itoa(i) + "@gmail.com"})
+		if err != nil {
+			t.Fatalf("CreateUser failed: %v", err)
+		}
+		if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000)}); err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+	}
+	return section
+}
+
+func TestSectionChanges(t *testing.T) {
+	t.Run("Grow", testResizeSectionGrow)
+	t.Run("ShrinkBlocked", testResizeSectionShrinkBlocked)
+	t.Run("ShrinkReassign", testResizeSectionShrinkReassign)
+	t.Run("DeleteReassign", testDeleteSectionReassign)
+	t.Run("DeleteCancel", testDeleteSectionCancel)
+}
+func testResizeSectionGrow(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 2, 2)
+	report, err := s.ResizeSection(context.Background(), &pb.ResizeSectionRequest{SectionID: section.SectionID, TotalSeats: 4, Version: section.Version})
+	if err != nil {
+		t.Fatalf("ResizeSection failed: %v", err)
+	}
+	if report.Section.TotalSeats != 4 || report.Section.AvailableSeats != 2 || len(s.seats[section.SectionID]) != 4 {
+		t.Errorf("Expected 4 seats with 2 available, got %+v", report.Section)
+	}
+}
+func testResizeSectionShrinkBlocked(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 3, 3)
+	_, err := s.ResizeSection(context.Background(), &pb.ResizeSectionRequest{SectionID: section.SectionID, TotalSeats: 2, Version: section.Version, Policy: pb.BookingPolicy_BLOCK})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition while seats are booked, got %v", err)
+	}
+}
+func testResizeSectionShrinkReassign(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 10, 2)
+	// Move the second passenger to the back of the section so a shrink affects them.
+	for _, ticket := range s.tickets {
+		if ticket.SeatNumber == 2 {
+			s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: ticket.UserID, Section: section.SectionID, SeatNumber: 9, Version: ticket.Version})
+		}
+	}
+	report, err := s.ResizeSection(context.Background(), &pb.ResizeSectionRequest{SectionID: section.SectionID, TotalSeats: 5, Version: section.Version, Policy: pb.BookingPolicy_REASSIGN})
+	if err != nil {
+		t.Fatalf("ResizeSection failed: %v", err)
+	}
+	if len(report.Tickets) != 1 || report.Tickets[0].FromSeat != 9 || report.Tickets[0].ToSeat != 2 {
+		t.Errorf("Expected seat 9 to be moved to seat 2, got %+v", report.Tickets)
+	}
+	if report.Section.AvailableSeats != 3 {
+		t.Errorf("Expected 3 available seats, got %d", report.Section.AvailableSeats)
+	}
+}
+func testDeleteSectionReassign(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 2, 2)
+	other, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 5})
+	report, err := s.DeleteSection(context.Background(), &pb.DeleteSectionRequest{SectionID: section.SectionID, Version: section.Version, Policy: pb.BookingPolicy_REASSIGN})
+	if err != nil {
+		t.Fatalf("DeleteSection failed: %v", err)
+	}
+	if len(report.Tickets) != 2 || report.Tickets[0].ToSection != other.SectionID {
+		t.Errorf("Expected both tickets to move to section B, got %+v", report.Tickets)
+	}
+	if _, ok := s.sections[section.SectionID]; ok {
+		t.Errorf("Expected section to be deleted")
+	}
+	if s.sections[other.SectionID].AvailableSeats != 3 {
+		t.Errorf("Expected 3 available seats in section B, got %d", s.sections[other.SectionID].AvailableSeats)
+	}
+}
+func testDeleteSectionCancel(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 2, 2)
+	report, err := s.DeleteSection(context.Background(), &pb.DeleteSectionRequest{SectionID: section.SectionID, Version: section.Version, Policy: pb.BookingPolicy_CANCEL})
+	if err != nil {
+		t.Fatalf("DeleteSection failed: %v", err)
+	}
+	if len(report.Tickets) != 2 || report.Tickets[0].Refund.MinorUnits != 10000 || len(s.tickets) != 0 {
+		t.Errorf("Expected both tickets to be cancelled and refunded, got %+v", report.Tickets)
+	}
+}
+
+func TestSeatBlocks(t *testing.T) {
+	t.Run("ExcludedFromAllocation", testBlockedSeatsExcludedFromAllocation)
+	t.Run("ReportsConflicts", testBlockSeatsReportsConflicts)
+	t.Run("TimeWindow", testBlockSeatsTimeWindow)
+	t.Run("ReleasesHolds", testBlockSeatsReleasesHolds)
+}
+func testBlockedSeatsExcludedFromAllocation(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 3, 0)
+	resp, err := s.BlockSeats(context.Background(), &pb.BlockSeatsRequest{SectionID: section.SectionID, SeatNumbers: []int32{1, 2}, Reason: "Broken"})
+	if err != nil {
+		t.Fatalf("BlockSeats failed: %v", err)
+	}
+	if len(resp.Blocks) != 2 || s.sections[section.SectionID].AvailableSeats != 1 {
+		t.Errorf("Expected 2 blocks and 1 available seat, got %d available", s.sections[section.SectionID].AvailableSeats)
+	}
+	user, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test@gmail.com"})
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.SeatNumber != 3 {
+		t.Errorf("Expected the only unblocked seat 3, got %d", ticket.SeatNumber)
+	}
+	seats, err := s.ViewSeatsBySection(context.Background(), &pb.SectionRequest{SectionID: section.SectionID})
+	if err != nil {
+		t.Fatalf("ViewSeatsBySection failed: %v", err)
+	}
+	blocked := 0
+	for _, seat := range seats.Tickets {
+		if seat.Blocked && seat.BlockReason == "Broken" {
+			blocked++
+		}
+	}
+	if blocked != 2 {
+		t.Errorf("Expected 2 blocked seats in the seat view, got %d", blocked)
+	}
+	if _, err := s.UnblockSeats(context.Background(), &pb.UnblockSeatsRequest{SectionID: section.SectionID, SeatNumbers: []int32{1}}); err != nil {
+		t.Fatalf("UnblockSeats failed: %v", err)
+	}
+	if s.sections[section.SectionID].AvailableSeats != 1 {
+		t.Errorf("Expected seat 1 to be available again, got %d available", s.sections[section.SectionID].AvailableSeats)
+	}
+}
+func testBlockSeatsReportsConflicts(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 3, 1)
+	resp, err := s.BlockSeats(context.Background(), &pb.BlockSeatsRequest{SectionID: section.SectionID, SeatNumbers: []int32{1, 1}, Reason: "Crew"})
+	if err != nil {
+		t.Fatalf("BlockSeats failed: %v", err)
+	}
+	if len(resp.Blocks) != 1 || len(resp.Conflicts) != 1 || resp.Conflicts[0].SeatNumber != 1 {
+		t.Errorf("Expected the ticket on seat 1 to be reported, got %+v", resp.Conflicts)
+	}
+	if s.sections[section.SectionID].AvailableSeats != 2 {
+		t.Errorf("Expected 2 available seats, got %d", s.sections[section.SectionID].AvailableSeats)
+	}
+
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 3, AccessibleSeats: []int32{2}})
+	need := createPassenger(t, s, "need@gmail.com")
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: need.UserID, AccessibilityNeed: true, Companion: true})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	resp, err = s.BlockSeats(context.Background(), &pb.BlockSeatsRequest{SectionID: ticket.Section, SeatNumbers: []int32{ticket.SeatNumber, ticket.CompanionSeat}, Reason: "Crew"})
+	if err != nil {
+		t.Fatalf("BlockSeats failed: %v", err)
+	}
+	if len(resp.Conflicts) != 1 || resp.Conflicts[0].UserID != need.UserID {
+		t.Errorf("Expected a ticket with a companion seat to be reported once, got %+v", resp.Conflicts)
+	}
+}
+func testBlockSeatsTimeWindow(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 2, 0)
+	from := time.Now().Add(time.Hour).Format(time.RFC3339)
+	if _, err := s.BlockSeats(context.Background(), &pb.BlockSeatsRequest{SectionID: section.SectionID, SeatNumbers: []int32{1}, Reason: "Maintenance", From: from}); err != nil {
+		t.Fatalf("BlockSeats failed: %v", err)
+	}
+	all, _ := s.ViewSections(context.Background(), &pb.SectionRequest{SectionID: section.SectionID})
+	if all.Sections[0].AvailableSeats != 2 {
+		t.Errorf("Expected a future block to leave 2 seats available, got %d", all.Sections[0].AvailableSeats)
+	}
+	if _, err := s.BlockSeats(context.Background(), &pb.BlockSeatsRequest{SectionID: section.SectionID, SeatNumbers: []int32{2}, Reason: "Maintenance", From: from, Until: from}); err == nil {
+		t.Errorf("Expected an empty window to be rejected")
+	}
+}
+func testBlockSeatsReleasesHolds(t *testing.T) {
+	s := setupTestServer()
+	section := setupBookedSection(t, s, 3, 0)
+	user := createPassenger(t, s, "test@gmail.com")
+	request := &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000)}
+	hold, err := s.HoldSeat(context.Background(), request)
+	if err != nil {
+		t.Fatalf("HoldSeat failed: %v", err)
+	}
+	if _, err := s.BlockSeats(context.Background(), &pb.BlockSeatsRequest{SectionID: section.SectionID, SeatNumbers: []int32{hold.SeatNumber}, Reason: "Broken"}); err != nil {
+		t.Fatalf("BlockSeats failed: %v", err)
+	}
+	if len(s.holds) != 0 || len(s.heldSeats) != 0 {
+		t.Fatalf("Expected the hold on the blocked seat to be released")
+	}
+	request.HoldID = hold.HoldID
+	if _, err := s.PurchaseTicket(context.Background(), request); err == nil {
+		t.Errorf("Expected the released hold not to be confirmed on a blocked seat")
+	}
+}
+
+func TestSeatClasses(t *testing.T) {
+	s := setupTestServer()
+	s.fares[pb.SeatClass_FIRST] = usd(4500)
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	first, err := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "F", TotalSeats: 2, Class: pb.SeatClass_FIRST, Amenities: []pb.Amenity{pb.Amenity_WIFI, pb.Amenity_POWER_SOCKETS, pb.Amenity_WIFI}})
+	if err != nil {
+		t.Fatalf("CreateSection failed: %v", err)
+	}
+	if len(first.Amenities) != 2 {
+		t.Errorf("Expected duplicate amenities to be dropped, got %v", first.Amenities)
+	}
+	user, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test@gmail.com"})
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000), Class: pb.SeatClass_FIRST})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.Section != first.SectionID || ticket.PricePaid.MinorUnits != 4500 {
+		t.Errorf("Expected a first class seat at the configured fare, got section %s price %v", ticket.Section, ticket.PricePaid.MinorUnits)
+	}
+	receipt, err := s.ViewReceipt(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	if err != nil {
+		t.Fatalf("ViewReceipt failed: %v", err)
+	}
+	if receipt.Class != pb.SeatClass_FIRST || len(receipt.Amenities) != 2 {
+		t.Errorf("Expected class and amenities on the receipt, got %v %v", receipt.Class, receipt.Amenities)
+	}
+	other, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "test2@gmail.com"})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: other.UserID, Class: pb.SeatClass_QUIET}); err == nil {
+		t.Errorf("Expected purchase to fail without a quiet section")
+	}
+}
+
+func TestAccessibleSeating(t *testing.T) {
+	t.Run("ReservedSeats", testAccessibleSeatsReserved)
+	t.Run("Companion", testCompanionSeat)
+	t.Run("ReleaseCutoff", testAccessibleSeatsRelease)
+}
+func createPassenger(t *testing.T, s *trainServer, email string) *pb.User {
+	t.Helper()
+	user, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: email})
+	if err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+	return user
+}
+func testAccessibleSeatsReserved(t *testing.T) {
+	s := setupTestServer()
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 2, AccessibleSeats: []int32{1}})
+	general := createPassenger(t, s, "general@gmail.com")
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: general.UserID})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.SeatNumber != 2 {
+		t.Errorf("Expected the general passenger to skip the reserved seat, got seat %d", ticket.SeatNumber)
+	}
+	other := createPassenger(t, s, "other@gmail.com")
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: other.UserID}); err == nil {
+		t.Errorf("Expected the reserved seat to be withheld from general sale")
+	}
+	need := createPassenger(t, s, "need@gmail.com")
+	ticket, err = s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: need.UserID, AccessibilityNeed: true})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.SeatNumber != 1 {
+		t.Errorf("Expected the reserved seat for the passenger with a need, got seat %d", ticket.SeatNumber)
+	}
+}
+func testCompanionSeat(t *testing.T) {
+	s := setupTestServer()
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 4, AccessibleSeats: []int32{3}})
+	need := createPassenger(t, s, "need@gmail.com")
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: need.UserID, AccessibilityNeed: true, Companion: true})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.SeatNumber != 3 || (ticket.CompanionSeat != 2 && ticket.CompanionSeat != 4) {
+		t.Errorf("Expected seat 3 with an adjacent companion seat, got %d and %d", ticket.SeatNumber, ticket.CompanionSeat)
+	}
+	if s.sections[section.SectionID].AvailableSeats != 2 {
+		t.Errorf("Expected 2 available seats, got %d", s.sections[section.SectionID].AvailableSeats)
+	}
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: need.UserID})
+	if s.sections[section.SectionID].AvailableSeats != 4 || len(s.allocatedSeats) != 0 {
+		t.Errorf("Expected cancellation to release both seats")
+	}
+
+	// The companion has no need of their own, so another reserved seat is not theirs to take.
+	reserved, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 3, AccessibleSeats: []int32{1, 2}})
+	s.BlockSeats(context.Background(), &pb.BlockSeatsRequest{SectionID: section.SectionID, SeatNumbers: []int32{1, 2, 3, 4}, Reason: "Closed"})
+	ticket, err = s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: need.UserID, AccessibilityNeed: true, Companion: true})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.Section != reserved.SectionID || ticket.SeatNumber != 2 || ticket.CompanionSeat != 3 {
+		t.Errorf("Expected seat 2 with the general seat 3 for the companion, got %d and %d", ticket.SeatNumber, ticket.CompanionSeat)
+	}
+	if _, err := s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: need.UserID, Section: reserved.SectionID, SeatNumber: 1, Version: ticket.Version}); err == nil {
+		t.Errorf("Expected a move to seat 1 to fail with only the reserved seat 2 next to it")
+	}
+}
+func testAccessibleSeatsRelease(t *testing.T) {
+	s := setupTestServer()
+	s.accessibleReleaseCutoff = 2 * time.Hour
+	departure := time.Now().Add(time.Hour).Format(time.RFC3339)
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 1, AccessibleSeats: []int32{1}, Departure: departure})
+	general := createPassenger(t, s, "general@gmail.com")
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: general.UserID}); err != nil {
+		t.Errorf("Expected the reserved seat to be on general sale within the cutoff, got %v", err)
+	}
+}
+
+func TestAgeOn(t *testing.T) {
+	tests := []struct {
+		dob, on string
+		want    int
+	}{
+		{"2000-03-01", "2026-03-01", 26},
+		{"2000-03-01", "2026-02-28", 25},
+		{"2001-03-01", "2028-03-01", 27},
+		{"2001-03-01", "2028-02-29", 26},
+		{"2000-02-29", "2026-02-28", 25},
+		{"2000-02-29", "2026-03-01", 26},
+		{"2000-02-29", "2028-02-29", 28},
+		{"2000-12-31", "2026-12-31", 26},
+		{"2000-12-31", "2026-12-30", 25},
+		{"01/02/2015", "2026-01-01", -1},
+	}
+	for _, tt := range tests {
+		on, _ := time.Parse(time.DateOnly, tt.on)
+		if got := ageOn(tt.dob, on); got != tt.want {
+			t.Errorf("ageOn(%s, %s) = %d, want %d", tt.dob, tt.on, got, tt.want)
+		}
+	}
+}
+
+func TestConcessions(t *testing.T) {
+	s := setupTestServer()
+	s.concessions[pb.PassengerCategory_CHILD] = concessionRule{PercentOff: 5000, MaxAge: 15}
+	s.concessions[pb.PassengerCategory_STUDENT] = concessionRule{PercentOff: 2500, RequiresCard: true}
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	if _, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "bad@gmail.com", DateOfBirth: "01/02/2015"}); err == nil {
+		t.Errorf("Expected a malformed date of birth to be rejected")
+	}
+
+	dob := time.Now().AddDate(-8, 0, 0).Format(time.DateOnly)
+	child, err := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "child@gmail.com", Category: pb.PassengerCategory_CHILD, DateOfBirth: dob})
+	if err != nil {
+		t.Fatalf("CreateUser failed: %v", err)
+	}
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: child.UserID, PricePaid: usd(3000)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.PricePaid.MinorUnits != 1500 || len(ticket.FareBreakdown) != 2 || ticket.FareBreakdown[1].Amount.MinorUnits != -1500 {
+		t.Errorf("Expected a 50%% child discount, got %v %v", ticket.PricePaid, ticket.FareBreakdown)
+	}
+	receipt, err := s.ViewReceipt(context.Background(), &pb.UseRequest{UserID: child.UserID})
+	if err != nil {
+		t.Fatalf("ViewReceipt failed: %v", err)
+	}
+	if receipt.Category != pb.PassengerCategory_CHILD || !receipt.CheckID || len(receipt.FareBreakdown) != 2 {
+		t.Errorf("Expected an itemised receipt flagged for id check, got %v", receipt)
+	}
+
+	adult, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "adult@gmail.com", Category: pb.PassengerCategory_CHILD, DateOfBirth: "1980-01-01"})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: adult.UserID, PricePaid: usd(3000)}); err == nil {
+		t.Errorf("Expected an adult to be refused a child fare")
+	}
+	student, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "student@gmail.com", Category: pb.PassengerCategory_STUDENT})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: student.UserID, PricePaid: usd(3000)}); err == nil {
+		t.Errorf("Expected a student fare to require a concession card")
+	}
+	senior, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "senior@gmail.com", Category: pb.PassengerCategory_SENIOR, DateOfBirth: "1950-01-01"})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: senior.UserID, PricePaid: usd(3000)}); err == nil {
+		t.Errorf("Expected a category without a configured concession to be refused")
+	}
+}
+
+func TestPromotions(t *testing.T) {
+	s := setupTestServer()
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	b, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 10})
+	if _, err := s.CreatePromotion(context.Background(), &pb.CreatePromotionRequest{Code: "half", Type: pb.DiscountType_PERCENT, BasisPoints: 15000}); err == nil {
+		t.Errorf("Expected a discount over 100%% to be rejected")
+	}
+	promo, err := s.CreatePromotion(context.Background(), &pb.CreatePromotionRequest{Code: "half", Type: pb.DiscountType_PERCENT, BasisPoints: 5000, MaxUses: 2, MaxUsesPerUser: 1, From: "Location 1", Sections: []string{b.SectionID}})
+	if err != nil {
+		t.Fatalf("CreatePromotion failed: %v", err)
+	}
+	if _, err := s.CreatePromotion(context.Background(), &pb.CreatePromotionRequest{Code: "HALF", Type: pb.DiscountType_FIXED, Amount: usd(500)}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Expected AlreadyExists for a duplicate code, got %v", err)
+	}
+
+	first := createPassenger(t, s, "first@gmail.com")
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 3", To: "Location 2", UserID: first.UserID, PricePaid: usd(4000), PromoCode: "half"}); err == nil {
+		t.Errorf("Expected the code to be refused on another route")
+	}
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: first.UserID, PricePaid: usd(4000), PromoCode: "half"})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.PricePaid.MinorUnits != 2000 || ticket.Section != b.SectionID || ticket.PromoCode != promo.Code {
+		t.Errorf("Expected a half price seat in section B, got %v in %s", ticket.PricePaid, ticket.Section)
+	}
+	if promo.Redemptions != 1 {
+		t.Errorf("Expected one redemption, got %d", promo.Redemptions)
+	}
+
+	// Cancelling gives the redemption back, so the same user can use the code again.
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: first.UserID})
+	if promo.Redemptions != 0 {
+		t.Errorf("Expected the redemption to be released on cancel, got %d", promo.Redemptions)
+	}
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: first.UserID, PricePaid: usd(4000), PromoCode: "half"}); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	second := createPassenger(t, s, "second@gmail.com")
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: second.UserID, PricePaid: usd(4000), PromoCode: "half"}); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	third := createPassenger(t, s, "third@gmail.com")
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: third.UserID, PricePaid: usd(4000), PromoCode: "half"}); err == nil {
+		t.Errorf("Expected the global usage cap to be enforced")
+	}
+
+	s.CreatePromotion(context.Background(), &pb.CreatePromotionRequest{Code: "OLD", Type: pb.DiscountType_FIXED, Amount: usd(500), ValidUntil: time.Now().Add(-time.Hour).Format(time.RFC3339)})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: third.UserID, PricePaid: usd(4000), PromoCode: "OLD"}); err == nil {
+		t.Errorf("Expected an expired code to be refused")
+	}
+	s.CreatePromotion(context.Background(), &pb.CreatePromotionRequest{Code: "TENOFF", Type: pb.DiscountType_FIXED, Amount: usd(1000)})
+	s.DisablePromotion(context.Background(), &pb.PromotionRequest{Code: "tenoff"})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: third.UserID, PricePaid: usd(4000), PromoCode: "TENOFF"}); err == nil {
+		t.Errorf("Expected a disabled code to be refused")
+	}
+}
+
+func TestLoyalty(t *testing.T) {
+	s := setupTestServer()
+	s.loyalty = loyaltyConfig{PointsPerUnit: 1, PointValue: 10, SilverSpend: 15000, GoldSpend: 100000}
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "frequent@gmail.com")
+
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(20000)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.PointsEarned != 200 {
+		t.Errorf("Expected 200 points earned, got %d", ticket.PointsEarned)
+	}
+	balance, _ := s.GetLoyaltyBalance(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	if balance.Points != 200 || balance.Tier != pb.LoyaltyTier_SILVER || len(balance.Entries) != 1 {
+		t.Errorf("Expected 200 points at silver, got %d at %v", balance.Points, balance.Tier)
+	}
+
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: user.UserID})
+	balance, _ = s.GetLoyaltyBalance(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	if balance.Points != 0 || balance.Tier != pb.LoyaltyTier_BRONZE || len(balance.Entries) != 2 {
+		t.Errorf("Expected the cancellation to reverse the earning, got %d at %v", balance.Points, balance.Tier)
+	}
+
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000)})
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: user.UserID})
+	s.ledger[user.UserID] = append(s.ledger[user.UserID], &pb.LedgerEntry{Type: pb.LedgerEntryType_EARN, Points: 100, CreatedOn: time.Now().AddDate(-2, 0, 0).Format(time.RFC3339), Spend: usd(500000)})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000), RedeemPoints: 101}); err == nil {
+		t.Errorf("Expected redeeming more than the balance to fail")
+	}
+	ticket, err = s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(10000), RedeemPoints: 100})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.PricePaid.MinorUnits != 9000 || ticket.PointsRedeemed != 100 || ticket.PointsEarned != 90 {
+		t.Errorf("Expected 10 off and 90 points earned at bronze, got %v %d %d", ticket.PricePaid, ticket.PointsRedeemed, ticket.PointsEarned)
+	}
+
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: user.UserID})
+	balance, _ = s.GetLoyaltyBalance(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	if balance.Points != 100 {
+		t.Errorf("Expected redeemed points to be returned on cancel, got %d", balance.Points)
+	}
+}
+
+func TestDynamicPricing(t *testing.T) {
+	t.Run("Occupancy", testOccupancyPricing)
+	t.Run("DepartureAndHold", testDeparturePricingHold)
+}
+func testOccupancyPricing(t *testing.T) {
+	s := setupTestServer()
+	s.pricing = []routePricing{{class: "STANDARD", strategy: newOccupancyStrategy([]occupancyBand{{MinOccupancy: 0.5, Multiplier: 15000}, {MinOccupancy: 0.9, Multiplier: 20000}})}}
+	setupBookedSection(t, s, 4, 2)
+	user := createPassenger(t, s, "late@gmail.com")
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(2000)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.PricePaid.MinorUnits != 3000 || len(ticket.FareBreakdown) != 2 {
+		t.Errorf("Expected a 50%% surcharge at half occupancy, got %v %v", ticket.PricePaid, ticket.FareBreakdown)
+	}
+	other := createPassenger(t, s, "other@gmail.com")
+	ticket, _ = s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 3", To: "Location 2", UserID: other.UserID, PricePaid: usd(2000), Class: pb.SeatClass_STANDARD})
+	if ticket.PricePaid.MinorUnits != 3000 {
+		t.Errorf("Expected the 0.5 band below 90%% occupancy, got %v", ticket.PricePaid)
+	}
+
+	// Blocked and held seats are not sold, so they leave the price alone.
+	empty, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 4, Class: pb.SeatClass_QUIET})
+	s.pricing[0].class = "QUIET"
+	s.BlockSeats(context.Background(), &pb.BlockSeatsRequest{SectionID: empty.SectionID, SeatNumbers: []int32{1, 2}, Reason: "Broken"})
+	holder := createPassenger(t, s, "holder@gmail.com")
+	if _, err := s.HoldSeat(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: holder.UserID, PricePaid: usd(2000), Class: pb.SeatClass_QUIET}); err != nil {
+		t.Fatalf("HoldSeat failed: %v", err)
+	}
+	quiet := createPassenger(t, s, "quiet@gmail.com")
+	ticket, err = s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: quiet.UserID, PricePaid: usd(2000), Class: pb.SeatClass_QUIET})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.PricePaid.MinorUnits != 2000 {
+		t.Errorf("Expected the base fare in an unsold section, got %v %v", ticket.PricePaid, ticket.FareBreakdown)
+	}
+}
+
+func TestPurchaseSectionOrder(t *testing.T) {
+	s := setupTestServer()
+	ids := []string{}
+	for _, name := range []string{"A", "B", "C", "D", "E"} {
+		section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: name, TotalSeats: 1})
+		ids = append(ids, section.SectionID)
+	}
+	sort.Strings(ids)
+	for i, id := range ids {
+		user := createPassenger(t, s, "user"+strconv.Itoa(i)+"@gmail.com")
+		ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(2000)})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		if ticket.Section != id {
+			t.Errorf("Expected sections to fill in id order, got %s before %s", ticket.Section, id)
+		}
+	}
+}
+func testDeparturePricingHold(t *testing.T) {
+	s := setupTestServer()
+	s.holdTTL = time.Minute
+	s.pricing = []routePricing{{from: "Location 1", strategy: newDepartureStrategy([]departureBand{{Within: duration(24 * time.Hour), Multiplier: 12000}, {Within: duration(time.Hour), Multiplier: 20000}})}}
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 2, Departure: time.Now().Add(3 * time.Hour).Format(time.RFC3339)})
+	user := createPassenger(t, s, "holder@gmail.com")
+	req := &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(5000)}
+	hold, err := s.HoldSeat(context.Background(), req)
+	if err != nil {
+		t.Fatalf("HoldSeat failed: %v", err)
+	}
+	if free := s.sections[section.SectionID].AvailableSeats; hold.Fare.MinorUnits != 6000 || free != 1 {
+		t.Errorf("Expected a held seat at 60, got fare %v with %d seats free", hold.Fare, free)
+	}
+	other := createPassenger(t, s, "other@gmail.com")
+	otherTicket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 2", To: "Location 3", UserID: other.UserID, PricePaid: usd(5000)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if otherTicket.SeatNumber == hold.SeatNumber {
+		t.Errorf("Expected the held seat to be skipped")
+	}
+
+	// Departure moving into the tighter band must not change the locked price.
+	section.Departure = time.Now().Add(30 * time.Minute).Format(time.RFC3339)
+	req.HoldID = hold.HoldID
+	ticket, err := s.PurchaseTicket(context.Background(), req)
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.PricePaid.MinorUnits != 6000 || ticket.SeatNumber != hold.SeatNumber {
+		t.Errorf("Expected the held seat at the locked price, got seat %d at %v", ticket.SeatNumber, ticket.PricePaid)
+	}
+	if _, err := s.PurchaseTicket(context.Background(), req); err == nil {
+		t.Errorf("Expected a used hold to be refused")
+	}
+
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: user.UserID})
+	s.holdTTL = -time.Second
+	expired, _ := s.HoldSeat(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(5000)})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(5000), HoldID: expired.HoldID}); err == nil {
+		t.Errorf("Expected an expired hold to be refused")
+	}
+}
+
+func TestMoney(t *testing.T) {
+	s := setupTestServer()
+	s.fares[pb.SeatClass_STANDARD] = usd(1999)
+	s.concessions[pb.PassengerCategory_DISABLED] = concessionRule{PercentOff: 3300, RequiresCard: true}
+	if _, err := s.SetExchangeRate(context.Background(), &pb.ExchangeRate{CurrencyCode: "usd", Rate: "2"}); err == nil {
+		t.Errorf("Expected the base currency rate to be fixed")
+	}
+	if _, err := s.SetExchangeRate(context.Background(), &pb.ExchangeRate{CurrencyCode: "EUR", Rate: "0.925"}); err != nil {
+		t.Fatalf("SetExchangeRate failed: %v", err)
+	}
+	s.SetExchangeRate(context.Background(), &pb.ExchangeRate{CurrencyCode: "JPY", Rate: "150.5"})
+
+	yen, _ := s.convert(usd(1234), "JPY")
+	if yen.MinorUnits != 1857 {
+		t.Errorf("Expected 12.34 USD to be 1857 JPY, got %d", yen.MinorUnits)
+	}
+	cent, _ := s.convert(usd(-1), "EUR")
+	if cent.MinorUnits != -1 {
+		t.Errorf("Expected -0.925 EUR to round to -0.01, got %d", cent.MinorUnits)
+	}
+
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user, _ := s.CreateUser(context.Background(), &pb.CreateUserRequest{FirstName: "Aman", LastName: "jain", Email: "euro@gmail.com", Category: pb.PassengerCategory_DISABLED, ConcessionCardID: "card-1"})
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, CurrencyCode: "GBP"}); err == nil {
+		t.Errorf("Expected a currency without a rate to be refused")
+	}
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, CurrencyCode: "eur"})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	// 19.99 USD is 18.49 EUR, less 33% (6.10) leaves 12.39.
+	if ticket.PricePaid.CurrencyCode != "EUR" || ticket.PricePaid.MinorUnits != 1239 {
+		t.Errorf("Expected 12.39 EUR, got %v", ticket.PricePaid)
+	}
+	total := int64(0)
+	for _, line := range ticket.FareBreakdown {
+		total += line.Amount.MinorUnits
+	}
+	if total != ticket.PricePaid.MinorUnits {
+		t.Errorf("Expected the fare lines to add up to the price, got %d", total)
+	}
+
+	report, err := s.DeleteSection(context.Background(), &pb.DeleteSectionRequest{SectionID: ticket.Section, Version: 1, Policy: pb.BookingPolicy_CANCEL})
+	if err != nil {
+		t.Fatalf("DeleteSection failed: %v", err)
+	}
+	if refund := report.Tickets[0].Refund; refund.CurrencyCode != "EUR" || refund.MinorUnits != 1239 {
+		t.Errorf("Expected the exact amount paid to be refunded, got %v", refund)
+	}
+}
+
+func TestInvoices(t *testing.T) {
+	s := setupTestServer()
+	s.invoicing = invoiceConfig{
+		SellerName:       "Ticketbook Rail",
+		SellerTaxID:      "GB123456789",
+		InvoicePrefix:    "INV-",
+		CreditNotePrefix: "CN-",
+		TaxRules:         []taxRule{{Name: "VAT", Rate: 2000}, {Name: "Luxury levy", Rate: 500, Class: "FIRST"}},
+	}
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	first := createPassenger(t, s, "first@gmail.com")
+	second := createPassenger(t, s, "second@gmail.com")
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: first.UserID, PricePaid: usd(1000)})
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: second.UserID, PricePaid: usd(1999)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	if ticket.InvoiceNumber != "INV-000002" {
+		t.Errorf("Expected sequential invoice numbers, got %s", ticket.InvoiceNumber)
+	}
+	invoice, err := s.GetInvoice(context.Background(), &pb.InvoiceRequest{UserID: second.UserID})
+	if err != nil {
+		t.Fatalf("GetInvoice failed: %v", err)
+	}
+	// 19.99 including 20% VAT is 16.66 net and 3.33 tax.
+	if invoice.Net.MinorUnits != 1666 || invoice.Tax.MinorUnits != 333 || invoice.Gross.MinorUnits != 1999 {
+		t.Errorf("Expected 16.66 + 3.33 = 19.99, got %d + %d = %d", invoice.Net.MinorUnits, invoice.Tax.MinorUnits, invoice.Gross.MinorUnits)
+	}
+	if len(invoice.Taxes) != 1 || invoice.Seller.TaxID != "GB123456789" || len(invoice.Lines) != 1 {
+		t.Errorf("Expected one tax line and the seller details, got %v", invoice)
+	}
+	if _, err := s.GetInvoice(context.Background(), &pb.InvoiceRequest{UserID: first.UserID, InvoiceNumber: invoice.InvoiceNumber}); err == nil {
+		t.Errorf("Expected another user's invoice to be hidden")
+	}
+
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: second.UserID})
+	all, _ := s.ListInvoices(context.Background(), &pb.UseRequest{UserID: second.UserID})
+	if len(all.Invoices) != 2 {
+		t.Fatalf("Expected an invoice and a credit note, got %d documents", len(all.Invoices))
+	}
+	note := all.Invoices[1]
+	if note.Type != pb.InvoiceType_CREDIT_NOTE || note.InvoiceNumber != "CN-000001" || note.OriginalInvoiceNumber != invoice.InvoiceNumber {
+		t.Errorf("Expected credit note CN-000001 for %s, got %s for %s", invoice.InvoiceNumber, note.InvoiceNumber, note.OriginalInvoiceNumber)
+	}
+	if note.Gross.MinorUnits != -1999 || note.Tax.MinorUnits != -333 {
+		t.Errorf("Expected the credit note to reverse the invoice, got %d with tax %d", note.Gross.MinorUnits, note.Tax.MinorUnits)
+	}
+}
+
+func TestBoardingPasses(t *testing.T) {
+	t.Run("VerifyOffline", testBoardingPassVerify)
+	t.Run("Reissue", testBoardingPassReissue)
+	t.Run("KeyRotation", testBoardingPassKeyRotation)
+}
+func testBoardingPassVerify(t *testing.T) {
+	s := setupTestServer()
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+	if err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	receipt, _ := s.ViewReceipt(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	if ticket.BoardingPass == "" || receipt.BoardingPass != ticket.BoardingPass {
+		t.Fatalf("Expected the ticket and receipt to carry a boarding pass")
+	}
+
+	// Verification needs nothing but the keys, so a server with an empty store will do.
+	verifier := setupTestServer()
+	verifier.passVerifier = s.passVerifier
+	result, err := verifier.VerifyBoardingPass(context.Background(), &pb.VerifyBoardingPassRequest{Token: ticket.BoardingPass})
+	if err != nil {
+		t.Fatalf("VerifyBoardingPass failed: %v", err)
+	}
+	if !result.Valid || result.TicketID != ticket.TicketId || result.Section != "A" || result.SeatNumber != 1 || result.Passenger != "Aman jain" || result.From != "Location 1" {
+		t.Errorf("Expected a valid pass for seat A1 of Aman jain, got %v", result)
+	}
+
+	tampered := []byte(ticket.BoardingPass)
+	tampered[len(tampered)/2] ^= 1
+	if result, _ := s.VerifyBoardingPass(context.Background(), &pb.VerifyBoardingPassRequest{Token: string(tampered)}); result.Valid || result.Reason == "" {
+		t.Errorf("Expected a tampered pass to be rejected with a reason, got %v", result)
+	}
+	if result, _ := setupTestServer().VerifyBoardingPass(context.Background(), &pb.VerifyBoardingPassRequest{Token: ticket.BoardingPass}); result.Valid {
+		t.Errorf("Expected a pass from another issuer to be rejected")
+	}
+	keys, _ := s.GetBoardingPassKeys(context.Background(), &pb.EmptyResponse{})
+	if len(keys.Keys) != 1 || !keys.Keys[0].Current || keys.Keys[0].KeyID != result.KeyID || len(keys.Keys[0].PublicKey) != 32 {
+		t.Errorf("Expected the current Ed25519 key, got %v", keys.Keys)
+	}
+}
+func testBoardingPassReissue(t *testing.T) {
+	s := setupTestServer()
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	ticket, _ := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+
+	ticket, err := s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: user.UserID, Section: section.SectionID, SeatNumber: 5, Version: ticket.Version})
+	if err != nil {
+		t.Fatalf("ModifySeat failed: %v", err)
+	}
+	result, _ := s.VerifyBoardingPass(context.Background(), &pb.VerifyBoardingPassRequest{Token: ticket.BoardingPass})
+	if result.SeatNumber != 5 {
+		t.Errorf("Expected the pass to be reissued for seat 5, got %d", result.SeatNumber)
+	}
+
+	user.FirstName = "Amit"
+	if _, err := s.ModifyUser(context.Background(), user); err != nil {
+		t.Fatalf("ModifyUser failed: %v", err)
+	}
+	s.ModifySections(context.Background(), &pb.ModifySectionRequest{SectionID: section.SectionID, Section: "Coach B", Version: section.Version})
+	receipt, _ := s.ViewReceipt(context.Background(), &pb.UseRequest{UserID: user.UserID})
+	result, _ = s.VerifyBoardingPass(context.Background(), &pb.VerifyBoardingPassRequest{Token: receipt.BoardingPass})
+	if result.Passenger != "Amit jain" || result.Section != "Coach B" {
+		t.Errorf("Expected the pass to follow the renamed passenger and section, got %v", result)
+	}
+}
+func testBoardingPassKeyRotation(t *testing.T) {
+	dir := t.TempDir()
+	writeKey := func(name string) (string, string) {
+		_, priv, _ := ed25519.GenerateKey(rand.Reader)
+		der, _ := x509.MarshalPKCS8PrivateKey(priv)
+		privFile := filepath.Join(dir, name+".pem")
+		os.WriteFile(privFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600)
+		der, _ = x509.MarshalPKIXPublicKey(priv.Public())
+		pubFile := filepath.Join(dir, name+".pub.pem")
+		os.WriteFile(pubFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o644)
+		return privFile, pubFile
+	}
+	oldKey, oldPub := writeKey("old")
+	newKey, _ := writeKey("new")
+
+	s := setupTestServer()
+	var err error
+	if s.passSigner, s.passVerifier, _, err = (boardingPassConfig{SigningKeyFile: oldKey}).load(); err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	user := createPassenger(t, s, "test@gmail.com")
+	ticket, _ := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+
+	if s.passSigner, s.passVerifier, _, err = (boardingPassConfig{SigningKeyFile: newKey, PreviousPublicKeyFiles: []string{oldPub}}).load(); err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if result, _ := s.VerifyBoardingPass(context.Background(), &pb.VerifyBoardingPassRequest{Token: ticket.BoardingPass}); !result.Valid {
+		t.Errorf("Expected a pass signed before the rotation to stay valid, got %v", result.Reason)
+	}
+	keys, _ := s.GetBoardingPassKeys(context.Background(), &pb.EmptyResponse{})
+	if len(keys.Keys) != 2 || !keys.Keys[0].Current || keys.Keys[1].Current {
+		t.Errorf("Expected the current key followed by the retired one, got %v", keys.Keys)
+	}
+	if _, _, _, err := (boardingPassConfig{SigningKeyFile: oldPub}).load(); err == nil {
+		t.Errorf("Expected an error loading a public key as the signing key")
+	}
+}
+
+func TestBoarding(t *testing.T) {
+	s := setupTestServer()
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	var tickets []*pb.Ticket
+	for i := 0; i < 3; i++ {
+		user := createPassenger(t, s, "passenger"+strconv.Itoa(i)+"@gmail.com")
+		ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		}
+		tickets = append(tickets, ticket)
+	}
+	board := func(code, sectionID string, seat int32) *pb.BoardingResult {
+		t.Helper()
+		result, err := s.Board(context.Background(), &pb.BoardRequest{Code: code, From: "Location 1", To: "Location 2", Location: "Platform 4", SectionID: sectionID, SeatNumber: seat})
+		if err != nil {
+			t.Fatalf("Board failed: %v", err)
+		}
+		return result
+	}
+
+	first := board(tickets[0].BoardingPass, section.SectionID, tickets[0].SeatNumber)
+	if first.Outcome != pb.BoardingOutcome_BOARDED || first.Ticket.BoardedLocation != "Platform 4" || first.Passenger != "Aman jain" {
+		t.Errorf("Expected the passenger to board, got %v", first)
+	}
+	if again := board(tickets[0].TicketId, section.SectionID, tickets[0].SeatNumber); again.Outcome != pb.BoardingOutcome_ALREADY_BOARDED || !again.Boarded {
+		t.Errorf("Expected a duplicate scan, got %v", again.Outcome)
+	}
+	if wrongSeat := board(qrPayload(&pb.Ticket{TicketId: tickets[1].TicketId}), section.SectionID, 9); wrongSeat.Outcome != pb.BoardingOutcome_WRONG_SEAT || !wrongSeat.Boarded {
+		t.Errorf("Expected a wrong seat warning, got %v", wrongSeat.Outcome)
+	}
+	wrongTrain, _ := s.Board(context.Background(), &pb.BoardRequest{Code: tickets[2].TicketId, From: "Location 1", To: "Location 3"})
+	if wrongTrain.Outcome != pb.BoardingOutcome_WRONG_TRAIN || wrongTrain.Boarded {
+		t.Errorf("Expected a wrong train rejection, got %v", wrongTrain.Outcome)
+	}
+	if unknown := board("TB1.forged.token", "", 0); unknown.Outcome != pb.BoardingOutcome_NOT_RECOGNISED {
+		t.Errorf("Expected a forged pass to be rejected, got %v", unknown.Outcome)
+	}
+
+	manifest, err := s.ViewManifest(context.Background(), &pb.ManifestRequest{SectionID: section.SectionID})
+	if err != nil {
+		t.Fatalf("ViewManifest failed: %v", err)
+	}
+	if manifest.Expected != 3 || manifest.Boarded != 2 || len(manifest.Passengers) != 3 || manifest.Passengers[0].SeatNumber > manifest.Passengers[1].SeatNumber {
+		t.Errorf("Expected 2 of 3 boarded in seat order, got %v", manifest)
+	}
+	pending, _ := s.ViewManifest(context.Background(), &pb.ManifestRequest{SectionID: section.SectionID, PendingOnly: true})
+	if len(pending.Passengers) != 1 || pending.Passengers[0].TicketId != tickets[2].TicketId {
+		t.Errorf("Expected only the passenger who has not boarded, got %v", pending.Passengers)
+	}
+	other, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 10})
+	if wrongSection := board(tickets[2].TicketId, other.SectionID, 1); wrongSection.Outcome != pb.BoardingOutcome_WRONG_SECTION {
+		t.Errorf("Expected a wrong section warning, got %v", wrongSection.Outcome)
+	}
+}
+func TestBoardingDeparture(t *testing.T) {
+	s := setupTestServer()
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10, Departure: "2030-05-01T08:00:00Z"})
+	tickets := bookOnSection(t, s, section, 2)
+	nextDay, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 10, Class: pb.SeatClass_FIRST, Departure: "2030-05-02T08:00:00Z"})
+	board := func(ticket *pb.Ticket, departure, sectionID string) *pb.BoardingResult {
+		t.Helper()
+		result, err := s.Board(context.Background(), &pb.BoardRequest{Code: ticket.TicketId, From: "Location 1", To: "Location 2", Departure: departure, SectionID: sectionID})
+		if err != nil {
+			t.Fatalf("Board failed: %v", err)
+		}
+		return result
+	}
+
+	for _, tt := range []struct{ departure, sectionID string }{{"2030-05-02", ""}, {"2030-05-01T09:00:00Z", ""}, {"", nextDay.SectionID}} {
+		if result := board(tickets[0], tt.departure, tt.sectionID); result.Outcome != pb.BoardingOutcome_WRONG_TRAIN || result.Boarded {
+			t.Errorf("Expected a wrong train rejection for departure %q in section %q, got %v", tt.departure, tt.sectionID, result.Outcome)
+		}
+	}
+	if _, err := s.Board(context.Background(), &pb.BoardRequest{Code: tickets[0].TicketId, From: "Location 1", To: "Location 2", Departure: "tomorrow"}); err == nil {
+		t.Errorf("Expected an invalid departure to be rejected")
+	}
+	if result := board(tickets[0], "2030-05-01", section.SectionID); result.Outcome != pb.BoardingOutcome_BOARDED {
+		t.Errorf("Expected the passenger to board on the day of departure, got %v", result.Outcome)
+	}
+
+	s.sections[section.SectionID].Cancelled = true
+	if result := board(tickets[1], "2030-05-01T08:00:00Z", ""); result.Outcome != pb.BoardingOutcome_SECTION_CANCELLED || result.Boarded || result.Ticket.BoardedAt != "" {
+		t.Errorf("Expected a ticket for a cancelled section to be rejected, got %v", result.Outcome)
+	}
+}
+
+func TestDisruptions(t *testing.T) {
+	t.Run("CancelRun", testDisruptionCancelRun)
+	t.Run("RemoveSection", testDisruptionRemoveSection)
+	t.Run("Delay", testDisruptionDelay)
+	t.Run("Route", testDisruptionRoute)
+}
+
+// bookOnSection books passengers in the section's class, which must be the only section
+// of that class with free seats.
+func bookOnSection(t *testing.T, s *trainServer, section *pb.Section, passengers int) []*pb.Ticket {
+	t.Helper()
+	tickets := []*pb.Ticket{}
+	for i := 0; i < passengers; i++ {
+		user := createPassenger(t, s, section.Section+strconv.Itoa(i)+"@gmail.com")
+		ticket, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, Class: section.Class, PricePaid: usd(1000)})
+		if err != nil {
+			t.Fatalf("PurchaseTicket failed: %v", err)
+		} else if ticket.Section != section.SectionID {
+			t.Fatalf("Expected a seat in section %s, got %s", section.Section, ticket.Section)
+		}
+		tickets = append(tickets, ticket)
+	}
+	return tickets
+}
+func testDisruptionCancelRun(t *testing.T) {
+	s := setupTestServer()
+	early, late, later := "2030-01-01T08:00:00Z", "2030-01-01T09:00:00Z", "2030-01-01T10:00:00Z"
+	cancelled, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 3, Departure: early})
+	tickets := bookOnSection(t, s, cancelled, 3)
+	nine, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 1, Departure: late})
+	ten, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "C", TotalSeats: 1, Departure: later})
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "D", TotalSeats: 5, Departure: later, Class: pb.SeatClass_FIRST})
+
+	report, err := s.ReportDisruption(context.Background(), &pb.DisruptionRequest{Departure: early, Type: pb.DisruptionType_CANCELLATION, Reason: "Signal failure"})
+	if err != nil {
+		t.Fatalf("ReportDisruption failed: %v", err)
+	}
+	if report.Rebooked != 2 || report.Refunded != 1 || len(report.Passengers) != 3 {
+		t.Fatalf("Expected 2 rebooked and 1 refunded, got %v", report)
+	}
+	if p := report.Passengers[0]; p.ToSection != nine.SectionID || p.ToDeparture != late || p.FromSeat != 1 {
+		t.Errorf("Expected the first passenger on the next departure, got %v", p)
+	}
+	if p := report.Passengers[1]; p.ToSection != ten.SectionID {
+		t.Errorf("Expected the second passenger on the departure after, got %v", p)
+	}
+	if p := report.Passengers[2]; p.Outcome != pb.DisruptionOutcome_REFUNDED || p.Refund.GetMinorUnits() != 1000 || p.Email == "" {
+		t.Errorf("Expected the last passenger refunded instead of moved to first class, got %v", p)
+	}
+	if _, ok := s.tickets[tickets[2].UserID]; ok {
+		t.Errorf("Expected the refunded ticket to be cancelled")
+	}
+	if !s.sections[cancelled.SectionID].Cancelled || s.sections[cancelled.SectionID].AvailableSeats != 0 {
+		t.Errorf("Expected the cancelled section to be withdrawn from sale")
+	}
+	user := createPassenger(t, s, "late@gmail.com")
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: user.UserID, PricePaid: usd(1000)}); err == nil {
+		t.Errorf("Expected seats in a cancelled section not to be sold")
+	}
+	if _, err := s.ReportDisruption(context.Background(), &pb.DisruptionRequest{SectionID: cancelled.SectionID}); err == nil {
+		t.Errorf("Expected an error cancelling a section twice")
+	}
+}
+func testDisruptionRemoveSection(t *testing.T) {
+	s := setupTestServer()
+	departure := "2030-01-01T08:00:00Z"
+	coach, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 2, Departure: departure})
+	tickets := bookOnSection(t, s, coach, 2)
+	first, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "F", TotalSeats: 3, Departure: departure, Class: pb.SeatClass_FIRST})
+
+	report, err := s.ReportDisruption(context.Background(), &pb.DisruptionRequest{SectionID: coach.SectionID, Policy: pb.RebookingPolicy_ANY_CLASS})
+	if err != nil {
+		t.Fatalf("ReportDisruption failed: %v", err)
+	}
+	if report.Rebooked != 2 || report.Passengers[0].ToSection != first.SectionID || report.Passengers[0].ToDeparture != departure {
+		t.Errorf("Expected both passengers moved to first class on the same train, got %v", report.Passengers)
+	}
+	rebooked := s.tickets[tickets[0].UserID]
+	if rebooked.Class != pb.SeatClass_FIRST {
+		t.Errorf("Expected the rebooked ticket to take the class of its new section, got %v", rebooked.Class)
+	}
+	if _, err := s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: rebooked.UserID, Section: first.SectionID, SeatNumber: 3, Version: rebooked.Version}); err != nil {
+		t.Errorf("Expected a rebooked passenger to change seats within the new section, got %v", err)
+	}
+	if _, err := s.ModifySeat(context.Background(), &pb.ModifySeatRequest{UserID: rebooked.UserID, Section: coach.SectionID, SeatNumber: 1, Version: s.tickets[rebooked.UserID].Version}); err == nil {
+		t.Errorf("Expected seats in a cancelled section to be refused")
+	}
+
+	refund, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "R", TotalSeats: 1, Departure: departure})
+	bookOnSection(t, s, refund, 1)
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "S", TotalSeats: 5, Departure: departure})
+	if report, _ := s.ReportDisruption(context.Background(), &pb.DisruptionRequest{SectionID: refund.SectionID, Policy: pb.RebookingPolicy_REFUND_ONLY}); report.Refunded != 1 {
+		t.Errorf("Expected REFUND_ONLY to refund despite free seats, got %v", report)
+	}
+}
+func testDisruptionDelay(t *testing.T) {
+	s := setupTestServer()
+	section, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 5, Departure: "2030-01-01T08:00:00Z"})
+	tickets := bookOnSection(t, s, section, 2)
+
+	report, err := s.ReportDisruption(context.Background(), &pb.DisruptionRequest{Departure: "2030-01-01T08:00:00Z", Type: pb.DisruptionType_DELAY, DelayMinutes: 45, Reason: "Crew shortage"})
+	if err != nil {
+		t.Fatalf("ReportDisruption failed: %v", err)
+	}
+	if report.Delayed != 2 || report.Passengers[0].ToDeparture != "2030-01-01T08:45:00Z" || report.Passengers[0].ToSeat != tickets[0].SeatNumber {
+		t.Errorf("Expected both passengers delayed by 45 minutes in their seats, got %v", report.Passengers)
+	}
+	if got := s.sections[section.SectionID]; got.Departure != "2030-01-01T08:45:00Z" || got.DelayMinutes != 45 || got.DisruptionReason != "Crew shortage" || got.Cancelled {
+		t.Errorf("Expected the section to be delayed, got %v", got)
+	}
+	if _, err := s.ReportDisruption(context.Background(), &pb.DisruptionRequest{SectionID: section.SectionID, Type: pb.DisruptionType_DELAY}); err == nil {
+		t.Errorf("Expected an error for a delay without minutes")
+	}
+}
+func testDisruptionRoute(t *testing.T) {
+	s := setupTestServer()
+	setupNotifier(t, s, logChannel{})
+	cancelled, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 2, Departure: "2030-01-01T08:00:00Z"})
+	tickets := bookOnSection(t, s, cancelled, 2)
+	otherRoute, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "B", TotalSeats: 5, Departure: "2030-01-01T09:00:00Z", Class: pb.SeatClass_FIRST})
+	user := createPassenger(t, s, "elsewhere@gmail.com")
+	if _, err := s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 3", UserID: user.UserID, Class: pb.SeatClass_FIRST, PricePaid: usd(1000)}); err != nil {
+		t.Fatalf("PurchaseTicket failed: %v", err)
+	}
+	sameRoute, _ := s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "C", TotalSeats: 1, Departure: "2030-01-01T10:00:00Z"})
+
+	report, err := s.ReportDisruption(context.Background(), &pb.DisruptionRequest{SectionID: cancelled.SectionID, Policy: pb.RebookingPolicy_ANY_CLASS})
+	if err != nil {
+		t.Fatalf("ReportDisruption failed: %v", err)
+	}
+	if report.Rebooked != 1 || report.Passengers[0].ToSection != sameRoute.SectionID || report.Refunded != 1 {
+		t.Fatalf("Expected one passenger moved to the later train on their route instead of section %s, got %v", otherRoute.Section, report.Passengers)
+	}
+	s.notifier.wait()
+	for _, ticket := range tickets {
+		log, _ := s.ViewNotifications(context.Background(), &pb.UseRequest{UserID: ticket.UserID})
+		if len(log.Deliveries) != 2 || log.Deliveries[1].Event != eventDisrupted {
+			t.Errorf("Expected a booking and a single disruption notification, got %v", log.Deliveries)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+	kpem []byte
+}
+
+func issueCert(t *testing.T, tmpl *x509.Certificate, parent *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl.SerialNumber = big.NewInt(time.Now().UnixNano())
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	parentCert, parentKey := tmpl, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parentCert, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	keyDER, _ := x509.MarshalECPrivateKey(key)
+	return &testCert{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		kpem: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func writeFile(t *testing.T, path string, data []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTLS(t *testing.T) {
+	t.Run("MutualTLS", testMutualTLS)
+	t.Run("Reload", testCertReload)
+	t.Run("CallerIdentity", testCallerIdentity)
+}
+func testMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := issueCert(t, &x509.Certificate{Subject: pkix.Name{CommonName: "test ca"}, IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil)
+	srv := issueCert(t, &x509.Certificate{Subject: pkix.Name{CommonName: "localhost"}, IPAddresses: []net.IP{net.ParseIP("127.0.0.1")}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}, ca)
+	spiffe, _ := url.Parse("spiffe://ticketbook/agent")
+	client := issueCert(t, &x509.Certificate{Subject: pkix.Name{CommonName: "agent"}, URIs: []*url.URL{spiffe}, ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}, ca)
+	writeFile(t, filepath.Join(dir, "server.pem"), srv.pem)
+	writeFile(t, filepath.Join(dir, "server.key"), srv.kpem)
+	writeFile(t, filepath.Join(dir, "ca.pem"), ca.pem)
+
+	reloader, err := newCertReloader(tlsConfig{
+		CertFile:     filepath.Join(dir, "server.pem"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.pem"),
+		ClientAuth:   "require",
+	})
+	if err != nil {
+		t.Fatalf("newCertReloader failed: %v", err)
+	}
+	var seen caller
+	grpcServer := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(reloader.tlsConfig())),
+		grpc.ChainUnaryInterceptor(tlsIdentityUnaryInterceptor, func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			seen, _ = callerFromContext(ctx)
+			return handler(ctx, req)
+		}),
+	)
+	healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go grpcServer.Serve(lis)
+	defer grpcServer.Stop()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	clientPair, _ := tls.X509KeyPair(client.pem, client.kpem)
+	dial := func(certs []tls.Certificate) error {
+		conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{RootCAs: roots, Certificates: certs})))
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		return err
+	}
+
+	if err := dial([]tls.Certificate{clientPair}); err != nil {
+		t.Fatalf("Health check with client certificate failed: %v", err)
+	}
+	if seen.Subject != "spiffe://ticketbook/agent" || seen.Source != "mtls" {
+		t.Errorf("Expected client identity in context, got %+v", seen)
+	}
+	if err := dial(nil); err == nil {
+		t.Errorf("Expected connection without client certificate to be rejected")
+	}
+}
+func testCertReload(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "server.pem"), filepath.Join(dir, "server.key")
+	first := issueCert(t, &x509.Certificate{Subject: pkix.Name{CommonName: "first"}}, nil)
+	writeFile(t, certFile, first.pem)
+	writeFile(t, keyFile, first.kpem)
+
+	reloader, err := newCertReloader(tlsConfig{CertFile: certFile, KeyFile: keyFile})
+	if err != nil {
+		t.Fatalf("newCertReloader failed: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go reloader.watch(ctx, 10*time.Millisecond)
+
+	second := issueCert(t, &x509.Certificate{Subject: pkix.Name{CommonName: "second"}}, nil)
+	writeFile(t, certFile, second.pem)
+	writeFile(t, keyFile, second.kpem)
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, future, future)
+	os.Chtimes(keyFile, future, future)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		cfg, _ := reloader.tlsConfig().GetConfigForClient(nil)
+		leaf, _ := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+		if leaf.Subject.CommonName == "second" {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("Expected certificate to be reloaded after the files changed")
+}
+func testCallerIdentity(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "booking-service"}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}},
+	})
+	c, ok := callerFromContext(tlsCallerContext(ctx))
+	if !ok || c.Subject != "booking-service" {
+		t.Errorf("Expected common name identity, got %+v", c)
+	}
+	if _, ok := callerFromContext(tlsCallerContext(context.Background())); ok {
+		t.Errorf("Expected no identity without a peer certificate")
+	}
+}
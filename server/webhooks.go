@@ -0,0 +1,614 @@
+// webhooks.go
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+// User and section event types sent to webhook subscribers, alongside the ticket events.
+const (
+	eventUserCreated      = "user.created"
+	eventUserUpdated      = "user.updated"
+	eventUserRemoved      = "user.removed"
+	eventSectionCreated   = "section.created"
+	eventSectionUpdated   = "section.updated"
+	eventSectionResized   = "section.resized"
+	eventSectionDeleted   = "section.deleted"
+	eventSectionDisrupted = "section.disrupted"
+)
+
+var webhookEventTypes = []string{
+	eventBooked, eventSeatChanged, eventCancelled, eventRefunded, eventDisrupted,
+	eventUserCreated, eventUserUpdated, eventUserRemoved,
+	eventSectionCreated, eventSectionUpdated, eventSectionResized, eventSectionDeleted, eventSectionDisrupted,
+}
+
+// webhookEvent is the JSON body posted to subscribers.
+type webhookEvent struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+	userID    string    // passenger the event is about, empty for section events
+}
+
+type userEventData struct {
+	UserID    string `json:"user_id"`
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	Category  string `json:"category"`
+}
+
+type sectionEventData struct {
+	SectionID      string `json:"section_id"`
+	Section        string `json:"section"`
+	Class          string `json:"class"`
+	TotalSeats     int32  `json:"total_seats"`
+	AvailableSeats int32  `json:"available_seats"`
+	Departure      string `json:"departure,omitempty"`
+	Cancelled      bool   `json:"cancelled,omitempty"`
+	DelayMinutes   int32  `json:"delay_minutes,omitempty"`
+}
+
+// publishUserEvent sends a user change to webhook subscribers once it is stored; the change
+// is recorded in the outbox separately. Callers hold t.mu.
+func (t *trainServer) publishUserEvent(eventType string, user *pb.User) {
+	e := &webhookEvent{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		CreatedAt: time.Now(),
+		Data: userEventData{
+			UserID:    user.UserID,
+			FirstName: user.FirstName,
+			LastName:  user.LastName,
+			Email:     user.Email,
+			Category:  user.Category.String(),
+		},
+		userID: user.UserID,
+	}
+	t.afterStore(func() { t.webhooks.publish(e) })
+}
+
+// publishSectionEvent sends a section change to webhook subscribers once it is stored; the
+// change is recorded in the outbox separately. Callers hold t.mu.
+func (t *trainServer) publishSectionEvent(eventType string, section *pb.Section) {
+	e := &webhookEvent{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		CreatedAt: time.Now(),
+		Data: sectionEventData{
+			SectionID:      section.SectionID,
+			Section:        section.Section,
+			Class:          section.Class.String(),
+			TotalSeats:     section.TotalSeats,
+			AvailableSeats: section.AvailableSeats,
+			Departure:      section.Departure,
+			Cancelled:      section.Cancelled,
+			DelayMinutes:   section.DelayMinutes,
+		},
+	}
+	t.afterStore(func() { t.webhooks.publish(e) })
+}
+
+// signWebhook computes the X-Ticketbook-Signature header: an HMAC-SHA256 over the timestamp
+// and body, so receivers can reject forged and replayed requests.
+func signWebhook(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(payload)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// webhookSubscription is a subscription with its secret and delivery queue.
+type webhookSubscription struct {
+	info    *pb.WebhookSubscription
+	secret  string
+	pending []webhookDelivery
+	running bool
+}
+
+type webhookDelivery struct {
+	eventID   string
+	eventType string
+	payload   []byte
+}
+
+func (s *webhookSubscription) wants(e *webhookEvent) bool {
+	if len(s.info.UserIDs) > 0 {
+		found := false
+		for _, id := range s.info.UserIDs {
+			found = found || id == e.userID
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, pattern := range s.info.EventTypes {
+		if pattern == "*" || pattern == e.Type || (strings.HasSuffix(pattern, ".*") && strings.HasPrefix(e.Type, strings.TrimSuffix(pattern, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// internalPrefixes are address ranges that are not reachable on the internet but are not
+// covered by netip's checks: "this network" and the shared address space used for
+// carrier-grade NAT and by some cloud metadata services.
+var internalPrefixes = []netip.Prefix{netip.MustParsePrefix("0.0.0.0/8"), netip.MustParsePrefix("100.64.0.0/10")}
+
+// publicAddr reports whether addr is a unicast address on the internet, rather than a
+// loopback, private, link-local (such as the 169.254.169.254 metadata service) or multicast one.
+func publicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range internalPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// internalHost reports whether a URL host is an address that is not public or names the local
+// machine. Other names are checked when they are dialled.
+func internalHost(host string) bool {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return !publicAddr(addr)
+	}
+	return strings.EqualFold(host, "localhost")
+}
+
+// newWebhookClient returns the client deliveries are posted with. Unless allowPrivate is set,
+// it refuses to connect to addresses that are not public. The check runs on the address being
+// dialled, after DNS resolution and for every redirect, so a subscriber can not reach
+// internal services by pointing a host name at them.
+func newWebhookClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !publicAddr(addr) {
+				return fmt.Errorf("webhook address %s is not public", addr)
+			}
+			return nil
+		}
+	}
+	// No proxy: the check must see the subscriber's own address.
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		ForceAttemptHTTP2:   true,
+		MaxIdleConns:        100,
+		IdleConnTimeout:     90 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// webhookDispatcher delivers events to each subscription in order, retrying with exponential
+// backoff and moving events that still fail to a dead-letter queue for later replay.
+type webhookDispatcher struct {
+	client         *http.Client
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	allowPrivate   bool
+
+	mu            sync.Mutex
+	subscriptions map[string]*webhookSubscription
+	deadLetters   []*pb.DeadLetter
+	letterOrder   map[string]int64 // position of each dead letter, kept across restarts
+	letterSeq     int64
+	tracked       bool              // set once a store persists the subscriptions and dead letters
+	changed       map[stateKey]bool // changed since the store last took them
+	wg            sync.WaitGroup
+}
+
+func newWebhookDispatcher(cfg webhookConfig) *webhookDispatcher {
+	return &webhookDispatcher{
+		client:         newWebhookClient(time.Duration(cfg.Timeout), cfg.AllowPrivateAddresses),
+		maxAttempts:    cfg.MaxAttempts,
+		initialBackoff: time.Duration(cfg.InitialBackoff),
+		maxBackoff:     time.Duration(cfg.MaxBackoff),
+		allowPrivate:   cfg.AllowPrivateAddresses,
+		subscriptions:  make(map[string]*webhookSubscription),
+		letterOrder:    make(map[string]int64),
+		changed:        make(map[stateKey]bool),
+	}
+}
+
+// publish queues an event for every interested subscription without blocking.
+func (d *webhookDispatcher) publish(e *webhookEvent) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var payload []byte
+	for _, sub := range d.subscriptions {
+		if !sub.wants(e) {
+			continue
+		}
+		if payload == nil {
+			var err error
+			if payload, err = json.Marshal(e); err != nil {
+				slog.Error("webhook event not encoded", "event", e.Type, "error", err)
+				return
+			}
+		}
+		d.enqueue(sub, webhookDelivery{eventID: e.ID, eventType: e.Type, payload: payload})
+	}
+}
+
+// enqueue adds a delivery to a subscription's queue. Callers hold d.mu.
+func (d *webhookDispatcher) enqueue(sub *webhookSubscription, delivery webhookDelivery) {
+	sub.pending = append(sub.pending, delivery)
+	d.wg.Add(1)
+	if !sub.running {
+		sub.running = true
+		go d.run(sub)
+	}
+}
+
+// run delivers a subscription's queued events until its queue is empty or it is deleted.
+func (d *webhookDispatcher) run(sub *webhookSubscription) {
+	for {
+		d.mu.Lock()
+		if len(sub.pending) == 0 {
+			sub.running = false
+			d.mu.Unlock()
+			return
+		}
+		delivery := sub.pending[0]
+		sub.pending = sub.pending[1:]
+		d.mu.Unlock()
+
+		attempts, err := d.deliver(sub, delivery)
+
+		d.mu.Lock()
+		subscribed := d.subscriptions[sub.info.SubscriptionID] == sub
+		if err == nil {
+			sub.info.Delivered++
+		} else {
+			sub.info.DeadLettered++
+			sub.info.LastError = err.Error()
+			if subscribed {
+				letter := &pb.DeadLetter{
+					DeadLetterID:   uuid.NewString(),
+					SubscriptionID: sub.info.SubscriptionID,
+					EventID:        delivery.eventID,
+					Event:          delivery.eventType,
+					Payload:        delivery.payload,
+					Attempts:       int32(attempts),
+					LastError:      err.Error(),
+					FailedOn:       time.Now().String(),
+				}
+				d.letterSeq++
+				d.letterOrder[letter.DeadLetterID] = d.letterSeq
+				d.deadLetters = append(d.deadLetters, letter)
+				d.markChanged(tableDeadLetter, letter.DeadLetterID)
+			}
+		}
+		if subscribed {
+			d.markChanged(tableWebhook, sub.info.SubscriptionID)
+		}
+		d.mu.Unlock()
+		d.wg.Done()
+	}
+}
+
+// deliver posts one event, retrying until it is accepted, the attempts run out or the
+// subscription is deleted. It returns the number of attempts made.
+func (d *webhookDispatcher) deliver(sub *webhookSubscription, delivery webhookDelivery) (int, error) {
+	backoff := d.initialBackoff
+	var err error
+	for attempt := 1; attempt <= d.maxAttempts; attempt++ {
+		if attempt > 1 {
+			time.Sleep(backoff)
+			if backoff *= 2; backoff > d.maxBackoff {
+				backoff = d.maxBackoff
+			}
+			d.mu.Lock()
+			deleted := d.subscriptions[sub.info.SubscriptionID] != sub
+			d.mu.Unlock()
+			if deleted {
+				return attempt - 1, errors.New("subscription deleted")
+			}
+		}
+		if err = d.post(sub, delivery); err == nil {
+			return attempt, nil
+		}
+	}
+	return d.maxAttempts, err
+}
+
+func (d *webhookDispatcher) post(sub *webhookSubscription, delivery webhookDelivery) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, sub.info.URL, bytes.NewReader(delivery.payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ticketbook-webhooks")
+	req.Header.Set("X-Ticketbook-Event", delivery.eventType)
+	req.Header.Set("X-Ticketbook-Delivery", delivery.eventID)
+	req.Header.Set("X-Ticketbook-Signature", signWebhook(sub.secret, time.Now().Unix(), delivery.payload))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("subscriber returned %s", resp.Status)
+	}
+	return nil
+}
+
+// wait blocks until every queued delivery has been delivered or dead-lettered.
+func (d *webhookDispatcher) wait() {
+	d.wg.Wait()
+}
+
+// track starts collecting the subscriptions and dead letters that change, for a store to take.
+func (d *webhookDispatcher) track() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.tracked = true
+}
+
+// markChanged records a subscription or dead letter the store must write. Callers hold d.mu.
+func (d *webhookDispatcher) markChanged(table, key string) {
+	if d.tracked {
+		d.changed[stateKey{table, key}] = true
+	}
+}
+
+// takeChanged returns what changed since the last call. Deliveries change subscriptions and
+// dead letters in the background, so the store takes them on its next write, as with holds.
+func (d *webhookDispatcher) takeChanged() []stateKey {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	keys := make([]stateKey, 0, len(d.changed))
+	for k := range d.changed {
+		keys = append(keys, k)
+	}
+	clear(d.changed)
+	return keys
+}
+
+// stateEntry returns a copy of a subscription, with its secret, or of a dead letter, marked
+// deleted when it no longer exists.
+func (d *webhookDispatcher) stateEntry(k stateKey) *pb.StateEntry {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	e := &pb.StateEntry{Table: k.table, Key: k.key, Deleted: true}
+	switch k.table {
+	case tableWebhook:
+		if sub, ok := d.subscriptions[k.key]; ok {
+			e.Webhook, e.WebhookSecret, e.Deleted = proto.Clone(sub.info).(*pb.WebhookSubscription), sub.secret, false
+		}
+	case tableDeadLetter:
+		for _, letter := range d.deadLetters {
+			if letter.DeadLetterID == k.key {
+				e.DeadLetter, e.Count, e.Deleted = proto.Clone(letter).(*pb.DeadLetter), d.letterOrder[k.key], false
+			}
+		}
+	}
+	return e
+}
+
+// stateKeys lists every subscription and dead letter for a compacted snapshot.
+func (d *webhookDispatcher) stateKeys() []stateKey {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	keys := []stateKey{}
+	for id := range d.subscriptions {
+		keys = append(keys, stateKey{tableWebhook, id})
+	}
+	for _, letter := range d.deadLetters {
+		keys = append(keys, stateKey{tableDeadLetter, letter.DeadLetterID})
+	}
+	return keys
+}
+
+// restoreEntry sets a subscription or dead letter read back from the store. A subscription
+// already known keeps its queue; dead letters are kept in the order they failed.
+func (d *webhookDispatcher) restoreEntry(e *pb.StateEntry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch e.Table {
+	case tableWebhook:
+		if sub, ok := d.subscriptions[e.Key]; e.Deleted {
+			delete(d.subscriptions, e.Key)
+		} else if ok {
+			sub.info, sub.secret = e.Webhook, e.WebhookSecret
+		} else {
+			d.subscriptions[e.Key] = &webhookSubscription{info: e.Webhook, secret: e.WebhookSecret}
+		}
+	case tableDeadLetter:
+		d.deadLetters = slices.DeleteFunc(d.deadLetters, func(l *pb.DeadLetter) bool { return l.DeadLetterID == e.Key })
+		delete(d.letterOrder, e.Key)
+		if e.Deleted {
+			return
+		}
+		i := sort.Search(len(d.deadLetters), func(i int) bool { return d.letterOrder[d.deadLetters[i].DeadLetterID] > e.Count })
+		d.deadLetters = slices.Insert(d.deadLetters, i, e.DeadLetter)
+		d.letterOrder[e.Key] = e.Count
+		d.letterSeq = max(d.letterSeq, e.Count)
+	}
+}
+
+func (t *trainServer) CreateWebhookSubscription(ctx context.Context, req *pb.CreateWebhookSubscriptionRequest) (*pb.WebhookSubscription, error) {
+	target, err := url.Parse(strings.TrimSpace(req.URL))
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, errors.New("Provide an http or https URL")
+	} else if !t.webhooks.allowPrivate && internalHost(target.Hostname()) {
+		return nil, errors.New("URL must not point at an internal address")
+	} else if len(req.Secret) < 16 {
+		return nil, errors.New("Secret must be at least 16 characters")
+	} else if len(req.EventTypes) == 0 {
+		return nil, errors.New("Provide at least one event type")
+	}
+	eventTypes := []string{}
+	for _, pattern := range req.EventTypes {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		known := pattern == "*"
+		for _, eventType := range webhookEventTypes {
+			known = known || eventType == pattern || (strings.HasSuffix(pattern, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(pattern, "*")))
+		}
+		if !known {
+			return nil, errors.New("Unknown event type " + pattern)
+		}
+		eventTypes = append(eventTypes, pattern)
+	}
+	userIDs := []string{}
+	t.mu.RLock()
+	for _, id := range req.UserIDs {
+		id = strings.TrimSpace(id)
+		if _, ok := t.users[id]; !ok {
+			t.mu.RUnlock()
+			return nil, errors.New("Invalid user " + id)
+		}
+		userIDs = append(userIDs, id)
+	}
+	t.mu.RUnlock()
+
+	info := &pb.WebhookSubscription{
+		SubscriptionID: uuid.NewString(),
+		URL:            target.String(),
+		EventTypes:     eventTypes,
+		UserIDs:        userIDs,
+		Description:    strings.TrimSpace(req.Description),
+		CreatedOn:      time.Now().String(),
+	}
+	t.webhooks.mu.Lock()
+	defer t.webhooks.mu.Unlock()
+	t.webhooks.subscriptions[info.SubscriptionID] = &webhookSubscription{info: info, secret: req.Secret}
+	t.webhooks.markChanged(tableWebhook, info.SubscriptionID)
+	return proto.Clone(info).(*pb.WebhookSubscription), nil
+}
+
+func (t *trainServer) ViewWebhookSubscriptions(ctx context.Context, req *pb.EmptyResponse) (*pb.AllWebhookSubscriptions, error) {
+	t.webhooks.mu.Lock()
+	defer t.webhooks.mu.Unlock()
+	all := &pb.AllWebhookSubscriptions{}
+	for _, sub := range t.webhooks.subscriptions {
+		// Delivery counters keep changing, so return copies.
+		all.Subscriptions = append(all.Subscriptions, proto.Clone(sub.info).(*pb.WebhookSubscription))
+	}
+	sort.Slice(all.Subscriptions, func(i, j int) bool { return all.Subscriptions[i].CreatedOn < all.Subscriptions[j].CreatedOn })
+	return all, nil
+}
+
+// DeleteWebhookSubscription stops deliveries to a subscription and discards its dead letters.
+func (t *trainServer) DeleteWebhookSubscription(ctx context.Context, req *pb.WebhookSubscriptionRequest) (*pb.EmptyResponse, error) {
+	id := strings.TrimSpace(req.SubscriptionID)
+	t.webhooks.mu.Lock()
+	defer t.webhooks.mu.Unlock()
+	sub, ok := t.webhooks.subscriptions[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Subscription not found")
+	}
+	delete(t.webhooks.subscriptions, id)
+	t.webhooks.markChanged(tableWebhook, id)
+	for range sub.pending {
+		t.webhooks.wg.Done()
+	}
+	sub.pending = nil
+	kept := t.webhooks.deadLetters[:0]
+	for _, letter := range t.webhooks.deadLetters {
+		if letter.SubscriptionID != id {
+			kept = append(kept, letter)
+		} else {
+			delete(t.webhooks.letterOrder, letter.DeadLetterID)
+			t.webhooks.markChanged(tableDeadLetter, letter.DeadLetterID)
+		}
+	}
+	t.webhooks.deadLetters = kept
+	return &pb.EmptyResponse{}, nil
+}
+
+// ViewDeadLetters lists undeliverable events, oldest first, for one subscription or for all
+// when no subscription id is given.
+func (t *trainServer) ViewDeadLetters(ctx context.Context, req *pb.WebhookSubscriptionRequest) (*pb.DeadLetters, error) {
+	id := strings.TrimSpace(req.SubscriptionID)
+	t.webhooks.mu.Lock()
+	defer t.webhooks.mu.Unlock()
+	letters := &pb.DeadLetters{}
+	for _, letter := range t.webhooks.deadLetters {
+		if id == "" || letter.SubscriptionID == id {
+			letters.DeadLetters = append(letters.DeadLetters, letter)
+		}
+	}
+	return letters, nil
+}
+
+// ReplayDeadLetters moves dead letters back onto their subscription's queue. Payloads are
+// sent unchanged, so receivers can use the X-Ticketbook-Delivery id to drop duplicates.
+func (t *trainServer) ReplayDeadLetters(ctx context.Context, req *pb.ReplayDeadLettersRequest) (*pb.ReplayDeadLettersResponse, error) {
+	id := strings.TrimSpace(req.SubscriptionID)
+	t.webhooks.mu.Lock()
+	defer t.webhooks.mu.Unlock()
+	sub, ok := t.webhooks.subscriptions[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "Subscription not found")
+	}
+	all := len(req.DeadLetterIDs) == 0
+	wanted := map[string]bool{}
+	for _, letterID := range req.DeadLetterIDs {
+		wanted[strings.TrimSpace(letterID)] = true
+	}
+	found := map[string]bool{}
+	replay := []*pb.DeadLetter{}
+	kept := []*pb.DeadLetter{}
+	for _, letter := range t.webhooks.deadLetters {
+		if letter.SubscriptionID == id && (all || wanted[letter.DeadLetterID]) {
+			replay = append(replay, letter)
+			found[letter.DeadLetterID] = true
+		} else {
+			kept = append(kept, letter)
+		}
+	}
+	if len(found) < len(wanted) {
+		return nil, status.Error(codes.NotFound, "Dead letter not found")
+	}
+	t.webhooks.deadLetters = kept
+	for _, letter := range replay {
+		delete(t.webhooks.letterOrder, letter.DeadLetterID)
+		t.webhooks.markChanged(tableDeadLetter, letter.DeadLetterID)
+		t.webhooks.enqueue(sub, webhookDelivery{eventID: letter.EventID, eventType: letter.Event, payload: letter.Payload})
+	}
+	return &pb.ReplayDeadLettersResponse{Replayed: int32(len(replay))}, nil
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "project/ticketbook/ticket/generated"
+)
+
+const testWebhookSecret = "0123456789abcdef-secret"
+
+// webhookReceiver is an httptest subscriber that checks signatures and records the events.
+type webhookReceiver struct {
+	*httptest.Server
+	failing atomic.Bool
+
+	mu     sync.Mutex
+	events []webhookEvent
+	errors []string
+}
+
+func newWebhookReceiver(t *testing.T) *webhookReceiver {
+	r := &webhookReceiver{}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if r.failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		var timestamp int64
+		var signature string
+		for _, part := range strings.Split(req.Header.Get("X-Ticketbook-Signature"), ",") {
+			if v, ok := strings.CutPrefix(part, "t="); ok {
+				timestamp, _ = strconv.ParseInt(v, 10, 64)
+			} else if v, ok := strings.CutPrefix(part, "v1="); ok {
+				signature = v
+			}
+		}
+		want := signWebhook(testWebhookSecret, timestamp, body)
+		if !hmac.Equal([]byte(want), []byte("t="+strconv.FormatInt(timestamp, 10)+",v1="+signature)) || time.Since(time.Unix(timestamp, 0)) > time.Minute {
+			r.errors = append(r.errors, "bad signature")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var e webhookEvent
+		json.Unmarshal(body, &e)
+		if e.ID != req.Header.Get("X-Ticketbook-Delivery") || e.Type != req.Header.Get("X-Ticketbook-Event") {
+			r.errors = append(r.errors, "headers do not match the body")
+		}
+		r.events = append(r.events, e)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *webhookReceiver) received() ([]webhookEvent, []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]webhookEvent{}, r.events...), append([]string{}, r.errors...)
+}
+
+func (r *webhookReceiver) eventTypes() string {
+	events, _ := r.received()
+	types := []string{}
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	return strings.Join(types, " ")
+}
+
+func setupWebhooks(s *trainServer) {
+	s.webhooks = newWebhookDispatcher(webhookConfig{MaxAttempts: 3, InitialBackoff: duration(time.Millisecond), MaxBackoff: duration(4 * time.Millisecond), Timeout: duration(time.Second), AllowPrivateAddresses: true})
+}
+
+func TestWebhooks(t *testing.T) {
+	t.Run("SignedDelivery", testWebhooksSignedDelivery)
+	t.Run("DeadLetterReplay", testWebhooksDeadLetterReplay)
+	t.Run("Validation", testWebhooksValidation)
+	t.Run("InternalAddresses", testWebhooksInternalAddresses)
+	t.Run("Restart", testWebhooksRestart)
+}
+func testWebhooksSignedDelivery(t *testing.T) {
+	receiver := newWebhookReceiver(t)
+	others := newWebhookReceiver(t)
+	s := setupTestServer()
+	setupWebhooks(s)
+	customer := createPassenger(t, s, "customer@gmail.com")
+	sub, err := s.CreateWebhookSubscription(context.Background(), &pb.CreateWebhookSubscriptionRequest{URL: receiver.URL, EventTypes: []string{"ticket.*", "user.updated"}, UserIDs: []string{customer.UserID}, Secret: testWebhookSecret})
+	if err != nil {
+		t.Fatalf("CreateWebhookSubscription failed: %v", err)
+	}
+	s.CreateWebhookSubscription(context.Background(), &pb.CreateWebhookSubscriptionRequest{URL: others.URL, EventTypes: []string{"section.*"}, Secret: testWebhookSecret})
+
+	s.CreateSection(context.Background(), &pb.CreateSectionRequest{Section: "A", TotalSeats: 10})
+	stranger := createPassenger(t, s, "stranger@gmail.com")
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: stranger.UserID, PricePaid: usd(1000)})
+	s.PurchaseTicket(context.Background(), &pb.TicketRequest{From: "Location 1", To: "Location 2", UserID: customer.UserID, PricePaid: usd(1000)})
+	customer.FirstName = "Amit"
+	s.ModifyUser(context.Background(), customer)
+	s.CancelReceipt(context.Background(), &pb.CancelReceiptRequest{UserID: customer.UserID})
+	s.webhooks.wait()
+
+	if got, want := receiver.eventTypes(), "ticket.booked user.updated ticket.cancelled ticket.refunded"; got != want {
+		t.Errorf("Expected %q for the customer only, got %q", want, got)
+	}
+	if got := others.eventTypes(); got != "section.created" {
+		t.Errorf("Expected only section events on the second subscription, got %q", got)
+	}
+	events, errs := receiver.received()
+	if len(errs) > 0 {
+		t.Errorf("Expected valid signatures and headers, got %v", errs)
+	}
+	if data := events[0].Data.(map[string]any); data["user_id"] != customer.UserID || data["ticket_id"] == "" {
+		t.Errorf("Expected ticket details in the payload, got %v", data)
+	}
+	subs, _ := s.ViewWebhookSubscriptions(context.Background(), &pb.EmptyResponse{})
+	if len(subs.Subscriptions) != 2 || subs.Subscriptions[0].SubscriptionID != sub.SubscriptionID || subs.Subscriptions[0].Delivered != 4 {
+		t.Errorf("Expected 4 deliveries counted on the first subscription, got %v", subs.Subscriptions)
+	}
+}
+func testWebhooksDeadLetterReplay(t *testing.T) {
+	receiver := newWebhookReceiver(t)
+	receiver.failing.Store(true)
+	s := setupTestServer()
+	setupWebhooks(s)
+	sub, _ := s.CreateWebhookSubscription(context.Background(), &pb.CreateWebhookSubscriptionRequest{URL: receiver.URL, EventTypes: []string{"*"}, Secret: testWebhookSecret})
+	createPassenger(t, s, "first@gmail.com")
+	createPassenger(t, s, "second@gmail.com")
+	createPassenger(t, s, "third@gmail.com")
+	s.webhooks.wait()
+
+	letters, _ := s.ViewDeadLetters(context.Background(), &pb.WebhookSubscriptionRequest{SubscriptionID: sub.SubscriptionID})
+	if len(letters.DeadLetters) != 3 || letters.DeadLetters[0].Attempts != 3 || !strings.Contains(letters.DeadLetters[0].LastError, "502") {
+		t.Fatalf("Expected every event dead-lettered after 3 attempts, got %v", letters.DeadLetters)
+	}
+
+	receiver.failing.Store(false)
+	replayed, err := s.ReplayDeadLetters(context.Background(), &pb.ReplayDeadLettersRequest{SubscriptionID: sub.SubscriptionID, DeadLetterIDs: []string{letters.DeadLetters[0].DeadLetterID}})
+	if err != nil || replayed.Replayed != 1 {
+		t.Fatalf("Expected one dead letter replayed, got %v, %v", replayed, err)
+	}
+	s.webhooks.wait()
+	if events, _ := receiver.received(); len(events) != 1 || events[0].ID != letters.DeadLetters[0].EventID {
+		t.Errorf("Expected the replayed event with its original id, got %v", events)
+	}
+	if left, _ := s.ViewDeadLetters(context.Background(), &pb.WebhookSubscriptionRequest{}); len(left.DeadLetters) != 2 {
+		t.Errorf("Expected only the requested dead letter replayed, got %d left", len(left.DeadLetters))
+	}
+	if _, err := s.ReplayDeadLetters(context.Background(), &pb.ReplayDeadLettersRequest{SubscriptionID: sub.SubscriptionID, DeadLetterIDs: []string{"missing"}}); err == nil {
+		t.Errorf("Expected an error replaying an unknown dead letter")
+	}
+
+	s.DeleteWebhookSubscription(context.Background(), &pb.WebhookSubscriptionRequest{SubscriptionID: sub.SubscriptionID})
+	createPassenger(t, s, "fourth@gmail.com")
+	s.webhooks.wait()
+	if left, _ := s.ViewDeadLetters(context.Background(), &pb.WebhookSubscriptionRequest{}); len(left.DeadLetters) != 0 || receiver.eventTypes() != "user.created" {
+		t.Errorf("Expected a deleted subscription to get nothing and keep no dead letters")
+	}
+}
+func testWebhooksValidation(t *testing.T) {
+	s := setupTestServer()
+	tests := []struct {
+		name string
+		req  *pb.CreateWebhookSubscriptionRequest
+	}{
+		{"NoScheme", &pb.CreateWebhookSubscriptionRequest{URL: "partner.example/hook", EventTypes: []string{"*"}, Secret: testWebhookSecret}},
+		{"ShortSecret", &pb.CreateWebhookSubscriptionRequest{URL: "https://partner.example/hook", EventTypes: []string{"*"}, Secret: "short"}},
+		{"UnknownEvent", &pb.CreateWebhookSubscriptionRequest{URL: "https://partner.example/hook", EventTypes: []string{"train.*"}, Secret: testWebhookSecret}},
+		{"UnknownUser", &pb.CreateWebhookSubscriptionRequest{URL: "https://partner.example/hook", EventTypes: []string{"*"}, UserIDs: []string{"nobody"}, Secret: testWebhookSecret}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := s.CreateWebhookSubscription(context.Background(), tt.req); err == nil {
+				t.Errorf("Expected the subscription to be rejected")
+			}
+		})
+	}
+}
+func testWebhooksInternalAddresses(t *testing.T) {
+	receiver := newWebhookReceiver(t)
+	s := setupTestServer()
+	s.webhooks = newWebhookDispatcher(webhookConfig{MaxAttempts: 1, InitialBackoff: duration(time.Millisecond), MaxBackoff: duration(time.Millisecond), Timeout: duration(time.Second)})
+	for _, url := range []string{"http://169.254.169.254/latest/meta-data", "http://127.0.0.1:8080/", "http://[::1]/", "http://10.0.0.1/", "http://localhost/"} {
+		if _, err := s.CreateWebhookSubscription(context.Background(), &pb.CreateWebhookSubscriptionRequest{URL: url, EventTypes: []string{"*"}, Secret: testWebhookSecret}); err == nil {
+			t.Errorf("Expected a subscription to %s to be rejected", url)
+		}
+	}
+
+	// A host name is only resolved when dialled, so the address is checked there too, as for
+	// this subscription standing in for one whose name resolves to a loopback address.
+	sub := &pb.WebhookSubscription{SubscriptionID: "resolves-to-loopback", URL: receiver.URL, EventTypes: []string{"*"}}
+	s.webhooks.subscriptions[sub.SubscriptionID] = &webhookSubscription{info: sub, secret: testWebhookSecret}
+	req, _ := http.NewRequest(http.MethodPost, receiver.URL, nil)
+	if _, err := s.webhooks.client.Do(req); err == nil || !strings.Contains(err.Error(), "not public") {
+		t.Errorf("Expected a connection to a loopback address to be refused, got %v", err)
+	}
+	createPassenger(t, s, "test@gmail.com")
+	s.webhooks.wait()
+	if events, _ := receiver.received(); len(events) != 0 {
+		t.Errorf("Expected nothing delivered to a loopback address, got %v", events)
+	}
+	if letters, _ := s.ViewDeadLetters(context.Background(), &pb.WebhookSubscriptionRequest{SubscriptionID: sub.SubscriptionID}); len(letters.DeadLetters) != 1 {
+		t.Errorf("Expected the event dead-lettered, got %v", letters.DeadLetters)
+	}
+}
+func testWebhooksRestart(t *testing.T) {
+	receiver := newWebhookReceiver(t)
+	receiver.failing.Store(true)
+	dir := t.TempDir()
+	open := func() *trainServer {
+		s := setupTestServer()
+		setupWebhooks(s)
+		if _, err := s.openWALStore(dir, 1000); err != nil {
+			t.Fatalf("openWALStore failed: %v", err)
+		}
+		return s
+	}
+	s := open()
+	sub, _ := s.CreateWebhookSubscription(context.Background(), &pb.CreateWebhookSubscriptionRequest{URL: receiver.URL, EventTypes: []string{"*"}, Secret: testWebhookSecret})
+	s.persist()
+	for _, email := range []string{"first@gmail.com", "second@gmail.com", "third@gmail.com"} {
+		createPassenger(t, s, email)
+		s.persist()
+	}
+	s.webhooks.wait()
+	letters, _ := s.ViewDeadLetters(context.Background(), &pb.WebhookSubscriptionRequest{})
+	s.ReplayDeadLetters(context.Background(), &pb.ReplayDeadLettersRequest{SubscriptionID: sub.SubscriptionID, DeadLetterIDs: []string{letters.DeadLetters[1].DeadLetterID}})
+	s.webhooks.wait()
+	// The dead letters were queued in the background, and are written on shutdown.
+	if err := s.closeStore(); err != nil {
+		t.Fatalf("closeStore failed: %v", err)
+	}
+	letters, _ = s.ViewDeadLetters(context.Background(), &pb.WebhookSubscriptionRequest{})
+
+	restarted := open()
+	defer restarted.store.backend.close()
+	subs, _ := restarted.ViewWebhookSubscriptions(context.Background(), &pb.EmptyResponse{})
+	if len(subs.Subscriptions) != 1 || subs.Subscriptions[0].SubscriptionID != sub.SubscriptionID || subs.Subscriptions[0].DeadLettered != 4 {
+		t.Fatalf("Expected the subscription and its counters to be restored, got %v", subs.Subscriptions)
+	}
+	restored, _ := restarted.ViewDeadLetters(context.Background(), &pb.WebhookSubscriptionRequest{})
+	if !proto.Equal(restored, letters) {
+		t.Fatalf("Expected the dead letters restored in order\n%v\ngot\n%v", letters, restored)
+	}
+
+	// The secret is restored too, so replayed events are still signed for the receiver.
+	receiver.failing.Store(false)
+	if replayed, err := restarted.ReplayDeadLetters(context.Background(), &pb.ReplayDeadLettersRequest{SubscriptionID: sub.SubscriptionID}); err != nil || replayed.Replayed != 3 {
+		t.Fatalf("Expected every dead letter replayed, got %v, %v", replayed, err)
+	}
+	restarted.webhooks.wait()
+	if events, errs := receiver.received(); len(events) != 3 || len(errs) != 0 || events[0].ID != letters.DeadLetters[0].EventID {
+		t.Errorf("Expected the replayed events signed with the restored secret, got %v and %v", events, errs)
+	}
+}
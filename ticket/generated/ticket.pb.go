@@ -20,632 +20,790 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// Message for representing a user.
-type User struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Passenger category used to apply concession fares.
+type PassengerCategory int32
 
-	UserID     string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	FirstName  string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
-	LastName   string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
-	Email      string `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
-	CreatedOn  string `protobuf:"bytes,5,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
-	ModifiedOn string `protobuf:"bytes,6,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
-}
+const (
+	PassengerCategory_ADULT    PassengerCategory = 0
+	PassengerCategory_CHILD    PassengerCategory = 1
+	PassengerCategory_SENIOR   PassengerCategory = 2
+	PassengerCategory_STUDENT  PassengerCategory = 3
+	PassengerCategory_DISABLED PassengerCategory = 4
+)
 
-func (x *User) Reset() {
-	*x = User{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for PassengerCategory.
+var (
+	PassengerCategory_name = map[int32]string{
+		0: "ADULT",
+		1: "CHILD",
+		2: "SENIOR",
+		3: "STUDENT",
+		4: "DISABLED",
+	}
+	PassengerCategory_value = map[string]int32{
+		"ADULT":    0,
+		"CHILD":    1,
+		"SENIOR":   2,
+		"STUDENT":  3,
+		"DISABLED": 4,
 	}
+)
+
+func (x PassengerCategory) Enum() *PassengerCategory {
+	p := new(PassengerCategory)
+	*p = x
+	return p
 }
 
-func (x *User) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x PassengerCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*User) ProtoMessage() {}
+func (PassengerCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[0].Descriptor()
+}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (PassengerCategory) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[0]
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+func (x PassengerCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PassengerCategory.Descriptor instead.
+func (PassengerCategory) EnumDescriptor() ([]byte, []int) {
 	return file_ticket_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *User) GetUserID() string {
-	if x != nil {
-		return x.UserID
+// Class of travel offered by a section.
+type SeatClass int32
+
+const (
+	SeatClass_STANDARD SeatClass = 0
+	SeatClass_FIRST    SeatClass = 1
+	SeatClass_QUIET    SeatClass = 2
+	SeatClass_FAMILY   SeatClass = 3
+)
+
+// Enum value maps for SeatClass.
+var (
+	SeatClass_name = map[int32]string{
+		0: "STANDARD",
+		1: "FIRST",
+		2: "QUIET",
+		3: "FAMILY",
 	}
-	return ""
+	SeatClass_value = map[string]int32{
+		"STANDARD": 0,
+		"FIRST":    1,
+		"QUIET":    2,
+		"FAMILY":   3,
+	}
+)
+
+func (x SeatClass) Enum() *SeatClass {
+	p := new(SeatClass)
+	*p = x
+	return p
 }
 
-func (x *User) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+func (x SeatClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *User) GetLastName() string {
-	if x != nil {
-		return x.LastName
-	}
-	return ""
+func (SeatClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[1].Descriptor()
 }
 
-func (x *User) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (SeatClass) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[1]
 }
 
-func (x *User) GetCreatedOn() string {
-	if x != nil {
-		return x.CreatedOn
-	}
-	return ""
+func (x SeatClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *User) GetModifiedOn() string {
-	if x != nil {
-		return x.ModifiedOn
-	}
-	return ""
+// Deprecated: Use SeatClass.Descriptor instead.
+func (SeatClass) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{1}
 }
 
-type CreateUserRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type Amenity int32
 
-	FirstName string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
-	LastName  string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
-	Email     string `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
-}
+const (
+	Amenity_POWER_SOCKETS    Amenity = 0
+	Amenity_WIFI             Amenity = 1
+	Amenity_WHEELCHAIR_SPACE Amenity = 2
+	Amenity_BIKE_RACK        Amenity = 3
+)
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for Amenity.
+var (
+	Amenity_name = map[int32]string{
+		0: "POWER_SOCKETS",
+		1: "WIFI",
+		2: "WHEELCHAIR_SPACE",
+		3: "BIKE_RACK",
+	}
+	Amenity_value = map[string]int32{
+		"POWER_SOCKETS":    0,
+		"WIFI":             1,
+		"WHEELCHAIR_SPACE": 2,
+		"BIKE_RACK":        3,
 	}
+)
+
+func (x Amenity) Enum() *Amenity {
+	p := new(Amenity)
+	*p = x
+	return p
 }
 
-func (x *CreateUserRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x Amenity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (Amenity) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[2].Descriptor()
+}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (Amenity) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[2]
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{1}
+func (x Amenity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateUserRequest) GetFirstName() string {
-	if x != nil {
-		return x.FirstName
-	}
-	return ""
+// Deprecated: Use Amenity.Descriptor instead.
+func (Amenity) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateUserRequest) GetLastName() string {
-	if x != nil {
-		return x.LastName
+// Policy for existing bookings affected by resizing or deleting a section.
+type BookingPolicy int32
+
+const (
+	BookingPolicy_BLOCK    BookingPolicy = 0 // reject the change while any booking is affected
+	BookingPolicy_REASSIGN BookingPolicy = 1 // move affected tickets to free seats, failing if any can not be placed
+	BookingPolicy_CANCEL   BookingPolicy = 2 // cancel affected tickets and refund the price paid
+)
+
+// Enum value maps for BookingPolicy.
+var (
+	BookingPolicy_name = map[int32]string{
+		0: "BLOCK",
+		1: "REASSIGN",
+		2: "CANCEL",
 	}
-	return ""
+	BookingPolicy_value = map[string]int32{
+		"BLOCK":    0,
+		"REASSIGN": 1,
+		"CANCEL":   2,
+	}
+)
+
+func (x BookingPolicy) Enum() *BookingPolicy {
+	p := new(BookingPolicy)
+	*p = x
+	return p
 }
 
-func (x *CreateUserRequest) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x BookingPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// Message for representing a train ticket purchase.
-type Ticket struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (BookingPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[3].Descriptor()
+}
 
-	TicketId   string  `protobuf:"bytes,1,opt,name=TicketId,proto3" json:"TicketId,omitempty"`
-	From       string  `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	To         string  `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	UserID     string  `protobuf:"bytes,4,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	PricePaid  float32 `protobuf:"fixed32,5,opt,name=price_paid,json=pricePaid,proto3" json:"price_paid,omitempty"`
-	Section    string  `protobuf:"bytes,6,opt,name=section,proto3" json:"section,omitempty"`
-	SeatNumber int32   `protobuf:"varint,7,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	CreatedOn  string  `protobuf:"bytes,8,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
-	ModifiedOn string  `protobuf:"bytes,9,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
+func (BookingPolicy) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[3]
 }
 
-func (x *Ticket) Reset() {
-	*x = Ticket{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x BookingPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Ticket) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use BookingPolicy.Descriptor instead.
+func (BookingPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{3}
 }
 
-func (*Ticket) ProtoMessage() {}
+type TicketOutcome int32
 
-func (x *Ticket) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	TicketOutcome_REASSIGNED TicketOutcome = 0
+	TicketOutcome_CANCELLED  TicketOutcome = 1
+)
+
+// Enum value maps for TicketOutcome.
+var (
+	TicketOutcome_name = map[int32]string{
+		0: "REASSIGNED",
+		1: "CANCELLED",
 	}
-	return mi.MessageOf(x)
-}
+	TicketOutcome_value = map[string]int32{
+		"REASSIGNED": 0,
+		"CANCELLED":  1,
+	}
+)
 
-// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
-func (*Ticket) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{2}
+func (x TicketOutcome) Enum() *TicketOutcome {
+	p := new(TicketOutcome)
+	*p = x
+	return p
 }
 
-func (x *Ticket) GetTicketId() string {
-	if x != nil {
-		return x.TicketId
-	}
-	return ""
+func (x TicketOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Ticket) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+func (TicketOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[4].Descriptor()
 }
 
-func (x *Ticket) GetTo() string {
-	if x != nil {
-		return x.To
-	}
-	return ""
+func (TicketOutcome) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[4]
 }
 
-func (x *Ticket) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+func (x TicketOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Ticket) GetPricePaid() float32 {
-	if x != nil {
-		return x.PricePaid
-	}
-	return 0
+// Deprecated: Use TicketOutcome.Descriptor instead.
+func (TicketOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Ticket) GetSection() string {
-	if x != nil {
-		return x.Section
+type DiscountType int32
+
+const (
+	DiscountType_PERCENT DiscountType = 0
+	DiscountType_FIXED   DiscountType = 1
+)
+
+// Enum value maps for DiscountType.
+var (
+	DiscountType_name = map[int32]string{
+		0: "PERCENT",
+		1: "FIXED",
 	}
-	return ""
+	DiscountType_value = map[string]int32{
+		"PERCENT": 0,
+		"FIXED":   1,
+	}
+)
+
+func (x DiscountType) Enum() *DiscountType {
+	p := new(DiscountType)
+	*p = x
+	return p
 }
 
-func (x *Ticket) GetSeatNumber() int32 {
-	if x != nil {
-		return x.SeatNumber
-	}
-	return 0
+func (x DiscountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Ticket) GetCreatedOn() string {
-	if x != nil {
-		return x.CreatedOn
-	}
-	return ""
+func (DiscountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[5].Descriptor()
 }
 
-func (x *Ticket) GetModifiedOn() string {
-	if x != nil {
-		return x.ModifiedOn
-	}
-	return ""
+func (DiscountType) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[5]
 }
 
-type TicketRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x DiscountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	From      string  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To        string  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	UserID    string  `protobuf:"bytes,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	PricePaid float32 `protobuf:"fixed32,4,opt,name=price_paid,json=pricePaid,proto3" json:"price_paid,omitempty"`
+// Deprecated: Use DiscountType.Descriptor instead.
+func (DiscountType) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *TicketRequest) Reset() {
-	*x = TicketRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Loyalty tier derived from spend over the trailing 12 months.
+type LoyaltyTier int32
+
+const (
+	LoyaltyTier_BRONZE LoyaltyTier = 0
+	LoyaltyTier_SILVER LoyaltyTier = 1
+	LoyaltyTier_GOLD   LoyaltyTier = 2
+)
+
+// Enum value maps for LoyaltyTier.
+var (
+	LoyaltyTier_name = map[int32]string{
+		0: "BRONZE",
+		1: "SILVER",
+		2: "GOLD",
+	}
+	LoyaltyTier_value = map[string]int32{
+		"BRONZE": 0,
+		"SILVER": 1,
+		"GOLD":   2,
 	}
+)
+
+func (x LoyaltyTier) Enum() *LoyaltyTier {
+	p := new(LoyaltyTier)
+	*p = x
+	return p
 }
 
-func (x *TicketRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x LoyaltyTier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*TicketRequest) ProtoMessage() {}
+func (LoyaltyTier) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[6].Descriptor()
+}
 
-func (x *TicketRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (LoyaltyTier) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[6]
 }
 
-// Deprecated: Use TicketRequest.ProtoReflect.Descriptor instead.
-func (*TicketRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{3}
+func (x LoyaltyTier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *TicketRequest) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+// Deprecated: Use LoyaltyTier.Descriptor instead.
+func (LoyaltyTier) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TicketRequest) GetTo() string {
-	if x != nil {
-		return x.To
-	}
-	return ""
-}
+type LedgerEntryType int32
 
-func (x *TicketRequest) GetUserID() string {
-	if x != nil {
-		return x.UserID
+const (
+	LedgerEntryType_EARN     LedgerEntryType = 0
+	LedgerEntryType_REDEEM   LedgerEntryType = 1
+	LedgerEntryType_REVERSAL LedgerEntryType = 2
+)
+
+// Enum value maps for LedgerEntryType.
+var (
+	LedgerEntryType_name = map[int32]string{
+		0: "EARN",
+		1: "REDEEM",
+		2: "REVERSAL",
 	}
-	return ""
+	LedgerEntryType_value = map[string]int32{
+		"EARN":     0,
+		"REDEEM":   1,
+		"REVERSAL": 2,
+	}
+)
+
+func (x LedgerEntryType) Enum() *LedgerEntryType {
+	p := new(LedgerEntryType)
+	*p = x
+	return p
 }
 
-func (x *TicketRequest) GetPricePaid() float32 {
-	if x != nil {
-		return x.PricePaid
-	}
-	return 0
+func (x LedgerEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type Section struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (LedgerEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[7].Descriptor()
+}
 
-	SectionID      string `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
-	Section        string `protobuf:"bytes,2,opt,name=Section,proto3" json:"Section,omitempty"`
-	TotalSeats     int32  `protobuf:"varint,3,opt,name=TotalSeats,proto3" json:"TotalSeats,omitempty"`
-	AvailableSeats int32  `protobuf:"varint,4,opt,name=AvailableSeats,proto3" json:"AvailableSeats,omitempty"`
-	CreatedOn      string `protobuf:"bytes,5,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
-	ModifiedOn     string `protobuf:"bytes,6,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
+func (LedgerEntryType) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[7]
 }
 
-func (x *Section) Reset() {
-	*x = Section{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x LedgerEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Section) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use LedgerEntryType.Descriptor instead.
+func (LedgerEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{7}
 }
 
-func (*Section) ProtoMessage() {}
+type InvoiceType int32
 
-func (x *Section) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	InvoiceType_INVOICE     InvoiceType = 0
+	InvoiceType_CREDIT_NOTE InvoiceType = 1
+)
+
+// Enum value maps for InvoiceType.
+var (
+	InvoiceType_name = map[int32]string{
+		0: "INVOICE",
+		1: "CREDIT_NOTE",
 	}
-	return mi.MessageOf(x)
+	InvoiceType_value = map[string]int32{
+		"INVOICE":     0,
+		"CREDIT_NOTE": 1,
+	}
+)
+
+func (x InvoiceType) Enum() *InvoiceType {
+	p := new(InvoiceType)
+	*p = x
+	return p
 }
 
-// Deprecated: Use Section.ProtoReflect.Descriptor instead.
-func (*Section) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{4}
+func (x InvoiceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Section) GetSectionID() string {
-	if x != nil {
-		return x.SectionID
-	}
-	return ""
+func (InvoiceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[8].Descriptor()
 }
 
-func (x *Section) GetSection() string {
-	if x != nil {
-		return x.Section
-	}
-	return ""
+func (InvoiceType) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[8]
 }
 
-func (x *Section) GetTotalSeats() int32 {
-	if x != nil {
-		return x.TotalSeats
-	}
-	return 0
+func (x InvoiceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Section) GetAvailableSeats() int32 {
-	if x != nil {
-		return x.AvailableSeats
-	}
-	return 0
+// Deprecated: Use InvoiceType.Descriptor instead.
+func (InvoiceType) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Section) GetCreatedOn() string {
-	if x != nil {
-		return x.CreatedOn
+type ReceiptFormat int32
+
+const (
+	ReceiptFormat_PDF  ReceiptFormat = 0
+	ReceiptFormat_HTML ReceiptFormat = 1
+	ReceiptFormat_TEXT ReceiptFormat = 2
+)
+
+// Enum value maps for ReceiptFormat.
+var (
+	ReceiptFormat_name = map[int32]string{
+		0: "PDF",
+		1: "HTML",
+		2: "TEXT",
 	}
-	return ""
+	ReceiptFormat_value = map[string]int32{
+		"PDF":  0,
+		"HTML": 1,
+		"TEXT": 2,
+	}
+)
+
+func (x ReceiptFormat) Enum() *ReceiptFormat {
+	p := new(ReceiptFormat)
+	*p = x
+	return p
 }
 
-func (x *Section) GetModifiedOn() string {
-	if x != nil {
-		return x.ModifiedOn
-	}
-	return ""
+func (x ReceiptFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type CreateSectionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ReceiptFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[9].Descriptor()
+}
 
-	Section    string `protobuf:"bytes,1,opt,name=Section,proto3" json:"Section,omitempty"`
-	TotalSeats int32  `protobuf:"varint,2,opt,name=TotalSeats,proto3" json:"TotalSeats,omitempty"`
+func (ReceiptFormat) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[9]
 }
 
-func (x *CreateSectionRequest) Reset() {
-	*x = CreateSectionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ReceiptFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateSectionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ReceiptFormat.Descriptor instead.
+func (ReceiptFormat) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{9}
 }
 
-func (*CreateSectionRequest) ProtoMessage() {}
+type BoardingOutcome int32
 
-func (x *CreateSectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	BoardingOutcome_BOARDED           BoardingOutcome = 0
+	BoardingOutcome_ALREADY_BOARDED   BoardingOutcome = 1 // duplicate scan, the first scan stands
+	BoardingOutcome_WRONG_SECTION     BoardingOutcome = 2 // boarded, but sitting in another section
+	BoardingOutcome_WRONG_SEAT        BoardingOutcome = 3 // boarded, but sitting in another seat
+	BoardingOutcome_WRONG_TRAIN       BoardingOutcome = 4 // not boarded, the ticket is for another journey
+	BoardingOutcome_NOT_RECOGNISED    BoardingOutcome = 5 // not boarded, the code is invalid or the ticket no longer exists
+	BoardingOutcome_SECTION_CANCELLED BoardingOutcome = 6 // not boarded, the ticket's section was withdrawn by a disruption
+)
+
+// Enum value maps for BoardingOutcome.
+var (
+	BoardingOutcome_name = map[int32]string{
+		0: "BOARDED",
+		1: "ALREADY_BOARDED",
+		2: "WRONG_SECTION",
+		3: "WRONG_SEAT",
+		4: "WRONG_TRAIN",
+		5: "NOT_RECOGNISED",
+		6: "SECTION_CANCELLED",
 	}
-	return mi.MessageOf(x)
+	BoardingOutcome_value = map[string]int32{
+		"BOARDED":           0,
+		"ALREADY_BOARDED":   1,
+		"WRONG_SECTION":     2,
+		"WRONG_SEAT":        3,
+		"WRONG_TRAIN":       4,
+		"NOT_RECOGNISED":    5,
+		"SECTION_CANCELLED": 6,
+	}
+)
+
+func (x BoardingOutcome) Enum() *BoardingOutcome {
+	p := new(BoardingOutcome)
+	*p = x
+	return p
 }
 
-// Deprecated: Use CreateSectionRequest.ProtoReflect.Descriptor instead.
-func (*CreateSectionRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{5}
+func (x BoardingOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateSectionRequest) GetSection() string {
-	if x != nil {
-		return x.Section
-	}
-	return ""
+func (BoardingOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[10].Descriptor()
 }
 
-func (x *CreateSectionRequest) GetTotalSeats() int32 {
-	if x != nil {
-		return x.TotalSeats
-	}
-	return 0
+func (BoardingOutcome) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[10]
 }
 
-type ModifySectionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x BoardingOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	SectionID string `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
-	Section   string `protobuf:"bytes,2,opt,name=Section,proto3" json:"Section,omitempty"`
+// Deprecated: Use BoardingOutcome.Descriptor instead.
+func (BoardingOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ModifySectionRequest) Reset() {
-	*x = ModifySectionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type DisruptionType int32
+
+const (
+	DisruptionType_CANCELLATION DisruptionType = 0
+	DisruptionType_DELAY        DisruptionType = 1
+)
+
+// Enum value maps for DisruptionType.
+var (
+	DisruptionType_name = map[int32]string{
+		0: "CANCELLATION",
+		1: "DELAY",
+	}
+	DisruptionType_value = map[string]int32{
+		"CANCELLATION": 0,
+		"DELAY":        1,
 	}
+)
+
+func (x DisruptionType) Enum() *DisruptionType {
+	p := new(DisruptionType)
+	*p = x
+	return p
 }
 
-func (x *ModifySectionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DisruptionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*ModifySectionRequest) ProtoMessage() {}
+func (DisruptionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[11].Descriptor()
+}
 
-func (x *ModifySectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (DisruptionType) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[11]
 }
 
-// Deprecated: Use ModifySectionRequest.ProtoReflect.Descriptor instead.
-func (*ModifySectionRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{6}
+func (x DisruptionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *ModifySectionRequest) GetSectionID() string {
-	if x != nil {
-		return x.SectionID
-	}
-	return ""
+// Deprecated: Use DisruptionType.Descriptor instead.
+func (DisruptionType) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ModifySectionRequest) GetSection() string {
-	if x != nil {
-		return x.Section
+type RebookingPolicy int32
+
+const (
+	RebookingPolicy_SAME_CLASS  RebookingPolicy = 0 // rebook onto the next departure with a seat in the same class, refund otherwise
+	RebookingPolicy_ANY_CLASS   RebookingPolicy = 1 // as SAME_CLASS, falling back to other classes on the same departure at the fare paid
+	RebookingPolicy_REFUND_ONLY RebookingPolicy = 2
+)
+
+// Enum value maps for RebookingPolicy.
+var (
+	RebookingPolicy_name = map[int32]string{
+		0: "SAME_CLASS",
+		1: "ANY_CLASS",
+		2: "REFUND_ONLY",
 	}
-	return ""
+	RebookingPolicy_value = map[string]int32{
+		"SAME_CLASS":  0,
+		"ANY_CLASS":   1,
+		"REFUND_ONLY": 2,
+	}
+)
+
+func (x RebookingPolicy) Enum() *RebookingPolicy {
+	p := new(RebookingPolicy)
+	*p = x
+	return p
 }
 
-type ModifySeatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x RebookingPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	UserID     string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
-	Section    string `protobuf:"bytes,2,opt,name=Section,proto3" json:"Section,omitempty"`
-	SeatNumber int32  `protobuf:"varint,3,opt,name=SeatNumber,proto3" json:"SeatNumber,omitempty"`
+func (RebookingPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[12].Descriptor()
 }
 
-func (x *ModifySeatRequest) Reset() {
-	*x = ModifySeatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (RebookingPolicy) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[12]
 }
 
-func (x *ModifySeatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x RebookingPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*ModifySeatRequest) ProtoMessage() {}
+// Deprecated: Use RebookingPolicy.Descriptor instead.
+func (RebookingPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{12}
+}
 
-func (x *ModifySeatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type DisruptionOutcome int32
+
+const (
+	DisruptionOutcome_REBOOKED DisruptionOutcome = 0
+	DisruptionOutcome_REFUNDED DisruptionOutcome = 1
+	DisruptionOutcome_DELAYED  DisruptionOutcome = 2
+)
+
+// Enum value maps for DisruptionOutcome.
+var (
+	DisruptionOutcome_name = map[int32]string{
+		0: "REBOOKED",
+		1: "REFUNDED",
+		2: "DELAYED",
 	}
-	return mi.MessageOf(x)
+	DisruptionOutcome_value = map[string]int32{
+		"REBOOKED": 0,
+		"REFUNDED": 1,
+		"DELAYED":  2,
+	}
+)
+
+func (x DisruptionOutcome) Enum() *DisruptionOutcome {
+	p := new(DisruptionOutcome)
+	*p = x
+	return p
 }
 
-// Deprecated: Use ModifySeatRequest.ProtoReflect.Descriptor instead.
-func (*ModifySeatRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{7}
+func (x DisruptionOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *ModifySeatRequest) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+func (DisruptionOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[13].Descriptor()
 }
 
-func (x *ModifySeatRequest) GetSection() string {
-	if x != nil {
-		return x.Section
-	}
-	return ""
+func (DisruptionOutcome) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[13]
 }
 
-func (x *ModifySeatRequest) GetSeatNumber() int32 {
-	if x != nil {
-		return x.SeatNumber
+func (x DisruptionOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DisruptionOutcome.Descriptor instead.
+func (DisruptionOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{13}
+}
+
+type DeliveryStatus int32
+
+const (
+	DeliveryStatus_DELIVERED   DeliveryStatus = 0
+	DeliveryStatus_UNDELIVERED DeliveryStatus = 1 // every attempt failed
+)
+
+// Enum value maps for DeliveryStatus.
+var (
+	DeliveryStatus_name = map[int32]string{
+		0: "DELIVERED",
+		1: "UNDELIVERED",
 	}
-	return 0
+	DeliveryStatus_value = map[string]int32{
+		"DELIVERED":   0,
+		"UNDELIVERED": 1,
+	}
+)
+
+func (x DeliveryStatus) Enum() *DeliveryStatus {
+	p := new(DeliveryStatus)
+	*p = x
+	return p
 }
 
-type Receipt struct {
+func (x DeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_ticket_proto_enumTypes[14].Descriptor()
+}
+
+func (DeliveryStatus) Type() protoreflect.EnumType {
+	return &file_ticket_proto_enumTypes[14]
+}
+
+func (x DeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryStatus.Descriptor instead.
+func (DeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{14}
+}
+
+// Message for representing a user.
+type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From       string  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To         string  `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	User       *User   `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	PricePaid  float32 `protobuf:"fixed32,4,opt,name=price_paid,json=pricePaid,proto3" json:"price_paid,omitempty"`
-	Section    string  `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`
-	SeatNumber int32   `protobuf:"varint,6,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
-	CreatedOn  string  `protobuf:"bytes,7,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
-	ModifiedOn string  `protobuf:"bytes,8,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
+	UserID     string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	FirstName  string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
+	LastName   string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
+	Email      string `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
+	CreatedOn  string `protobuf:"bytes,5,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+	ModifiedOn string `protobuf:"bytes,6,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
+	// Incremented on every modification; ModifyUser must send the version it read.
+	Version          int64             `protobuf:"varint,7,opt,name=Version,proto3" json:"Version,omitempty"`
+	Category         PassengerCategory `protobuf:"varint,8,opt,name=Category,proto3,enum=train_ticketing.PassengerCategory" json:"Category,omitempty"`
+	DateOfBirth      string            `protobuf:"bytes,9,opt,name=DateOfBirth,proto3" json:"DateOfBirth,omitempty"`            // YYYY-MM-DD, needed for age based concessions
+	ConcessionCardID string            `protobuf:"bytes,10,opt,name=ConcessionCardID,proto3" json:"ConcessionCardID,omitempty"` // student or disability card checked by conductors
 }
 
-func (x *Receipt) Reset() {
-	*x = Receipt{}
+func (x *User) Reset() {
+	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[8]
+		mi := &file_ticket_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Receipt) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Receipt) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *Receipt) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[8]
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,92 +814,113 @@ func (x *Receipt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
-func (*Receipt) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Receipt) GetFrom() string {
+func (x *User) GetUserID() string {
 	if x != nil {
-		return x.From
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *Receipt) GetTo() string {
+func (x *User) GetFirstName() string {
 	if x != nil {
-		return x.To
+		return x.FirstName
 	}
 	return ""
 }
 
-func (x *Receipt) GetUser() *User {
+func (x *User) GetLastName() string {
 	if x != nil {
-		return x.User
+		return x.LastName
 	}
-	return nil
+	return ""
 }
 
-func (x *Receipt) GetPricePaid() float32 {
+func (x *User) GetEmail() string {
 	if x != nil {
-		return x.PricePaid
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *Receipt) GetSection() string {
+func (x *User) GetCreatedOn() string {
 	if x != nil {
-		return x.Section
+		return x.CreatedOn
 	}
 	return ""
 }
 
-func (x *Receipt) GetSeatNumber() int32 {
+func (x *User) GetModifiedOn() string {
 	if x != nil {
-		return x.SeatNumber
+		return x.ModifiedOn
+	}
+	return ""
+}
+
+func (x *User) GetVersion() int64 {
+	if x != nil {
+		return x.Version
 	}
 	return 0
 }
 
-func (x *Receipt) GetCreatedOn() string {
+func (x *User) GetCategory() PassengerCategory {
 	if x != nil {
-		return x.CreatedOn
+		return x.Category
+	}
+	return PassengerCategory_ADULT
+}
+
+func (x *User) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
 	}
 	return ""
 }
 
-func (x *Receipt) GetModifiedOn() string {
+func (x *User) GetConcessionCardID() string {
 	if x != nil {
-		return x.ModifiedOn
+		return x.ConcessionCardID
 	}
 	return ""
 }
 
-type AllSections struct {
+type CreateUserRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sections []*Section `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+	FirstName string `protobuf:"bytes,2,opt,name=FirstName,proto3" json:"FirstName,omitempty"`
+	LastName  string `protobuf:"bytes,3,opt,name=LastName,proto3" json:"LastName,omitempty"`
+	Email     string `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
+	// Optional key that makes retries of this request safe; see idempotency-key metadata.
+	IdempotencyKey   string            `protobuf:"bytes,5,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
+	Category         PassengerCategory `protobuf:"varint,6,opt,name=Category,proto3,enum=train_ticketing.PassengerCategory" json:"Category,omitempty"`
+	DateOfBirth      string            `protobuf:"bytes,7,opt,name=DateOfBirth,proto3" json:"DateOfBirth,omitempty"`
+	ConcessionCardID string            `protobuf:"bytes,8,opt,name=ConcessionCardID,proto3" json:"ConcessionCardID,omitempty"`
 }
 
-func (x *AllSections) Reset() {
-	*x = AllSections{}
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[9]
+		mi := &file_ticket_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllSections) String() string {
+func (x *CreateUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllSections) ProtoMessage() {}
+func (*CreateUserRequest) ProtoMessage() {}
 
-func (x *AllSections) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[9]
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -752,93 +931,87 @@ func (x *AllSections) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllSections.ProtoReflect.Descriptor instead.
-func (*AllSections) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *AllSections) GetSections() []*Section {
+func (x *CreateUserRequest) GetFirstName() string {
 	if x != nil {
-		return x.Sections
+		return x.FirstName
 	}
-	return nil
+	return ""
 }
 
-type AllUsers struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+func (x *CreateUserRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
 }
 
-func (x *AllUsers) Reset() {
-	*x = AllUsers{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
 	}
+	return ""
 }
 
-func (x *AllUsers) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateUserRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
 }
 
-func (*AllUsers) ProtoMessage() {}
-
-func (x *AllUsers) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *CreateUserRequest) GetCategory() PassengerCategory {
+	if x != nil {
+		return x.Category
 	}
-	return mi.MessageOf(x)
+	return PassengerCategory_ADULT
 }
 
-// Deprecated: Use AllUsers.ProtoReflect.Descriptor instead.
-func (*AllUsers) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{10}
+func (x *CreateUserRequest) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
 }
 
-func (x *AllUsers) GetUsers() []*User {
+func (x *CreateUserRequest) GetConcessionCardID() string {
 	if x != nil {
-		return x.Users
+		return x.ConcessionCardID
 	}
-	return nil
+	return ""
 }
 
-// Message for representing seat allocation
-type SeatDetails struct {
+// Message for representing an exact amount of money.
+type Money struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserName   string `protobuf:"bytes,1,opt,name=UserName,proto3" json:"UserName,omitempty"`
-	Email      string `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
-	SeatNumber int32  `protobuf:"varint,3,opt,name=SeatNumber,proto3" json:"SeatNumber,omitempty"`
+	CurrencyCode string `protobuf:"bytes,1,opt,name=CurrencyCode,proto3" json:"CurrencyCode,omitempty"` // ISO 4217, e.g. "USD"
+	MinorUnits   int64  `protobuf:"varint,2,opt,name=MinorUnits,proto3" json:"MinorUnits,omitempty"`    // amount in the currency's smallest unit, e.g. cents
 }
 
-func (x *SeatDetails) Reset() {
-	*x = SeatDetails{}
+func (x *Money) Reset() {
+	*x = Money{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[11]
+		mi := &file_ticket_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SeatDetails) String() string {
+func (x *Money) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatDetails) ProtoMessage() {}
+func (*Money) ProtoMessage() {}
 
-func (x *SeatDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[11]
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -849,57 +1022,52 @@ func (x *SeatDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatDetails.ProtoReflect.Descriptor instead.
-func (*SeatDetails) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{11}
-}
-
-func (x *SeatDetails) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *SeatDetails) GetEmail() string {
+func (x *Money) GetCurrencyCode() string {
 	if x != nil {
-		return x.Email
+		return x.CurrencyCode
 	}
 	return ""
 }
 
-func (x *SeatDetails) GetSeatNumber() int32 {
+func (x *Money) GetMinorUnits() int64 {
 	if x != nil {
-		return x.SeatNumber
+		return x.MinorUnits
 	}
 	return 0
 }
 
-type SeatAllocation struct {
+// Message for representing one line of a fare calculation.
+type FareLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tickets []*SeatDetails `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+	Description string `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
+	Amount      *Money `protobuf:"bytes,3,opt,name=Amount,proto3" json:"Amount,omitempty"`
 }
 
-func (x *SeatAllocation) Reset() {
-	*x = SeatAllocation{}
+func (x *FareLine) Reset() {
+	*x = FareLine{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[12]
+		mi := &file_ticket_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SeatAllocation) String() string {
+func (x *FareLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeatAllocation) ProtoMessage() {}
+func (*FareLine) ProtoMessage() {}
 
-func (x *SeatAllocation) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[12]
+func (x *FareLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,44 +1078,72 @@ func (x *SeatAllocation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeatAllocation.ProtoReflect.Descriptor instead.
-func (*SeatAllocation) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FareLine.ProtoReflect.Descriptor instead.
+func (*FareLine) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SeatAllocation) GetTickets() []*SeatDetails {
+func (x *FareLine) GetDescription() string {
 	if x != nil {
-		return x.Tickets
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FareLine) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
 	}
 	return nil
 }
 
-// Boolean message type
-type Bool struct {
+// Message for representing a train ticket purchase.
+type Ticket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	TicketId          string            `protobuf:"bytes,1,opt,name=TicketId,proto3" json:"TicketId,omitempty"`
+	From              string            `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                string            `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	UserID            string            `protobuf:"bytes,4,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	PricePaid         *Money            `protobuf:"bytes,19,opt,name=price_paid,json=pricePaid,proto3" json:"price_paid,omitempty"`
+	Section           string            `protobuf:"bytes,6,opt,name=section,proto3" json:"section,omitempty"`
+	SeatNumber        int32             `protobuf:"varint,7,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	CreatedOn         string            `protobuf:"bytes,8,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+	ModifiedOn        string            `protobuf:"bytes,9,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
+	Version           int64             `protobuf:"varint,10,opt,name=Version,proto3" json:"Version,omitempty"`
+	Class             SeatClass         `protobuf:"varint,11,opt,name=Class,proto3,enum=train_ticketing.SeatClass" json:"Class,omitempty"`
+	AccessibilityNeed bool              `protobuf:"varint,12,opt,name=AccessibilityNeed,proto3" json:"AccessibilityNeed,omitempty"`
+	CompanionSeat     int32             `protobuf:"varint,13,opt,name=CompanionSeat,proto3" json:"CompanionSeat,omitempty"` // 0 when no companion seat was booked
+	Category          PassengerCategory `protobuf:"varint,14,opt,name=Category,proto3,enum=train_ticketing.PassengerCategory" json:"Category,omitempty"`
+	FareBreakdown     []*FareLine       `protobuf:"bytes,15,rep,name=FareBreakdown,proto3" json:"FareBreakdown,omitempty"`
+	PromoCode         string            `protobuf:"bytes,16,opt,name=PromoCode,proto3" json:"PromoCode,omitempty"` // promotion redeemed with this ticket, if any
+	PointsRedeemed    int64             `protobuf:"varint,17,opt,name=PointsRedeemed,proto3" json:"PointsRedeemed,omitempty"`
+	PointsEarned      int64             `protobuf:"varint,18,opt,name=PointsEarned,proto3" json:"PointsEarned,omitempty"`
+	InvoiceNumber     string            `protobuf:"bytes,20,opt,name=InvoiceNumber,proto3" json:"InvoiceNumber,omitempty"`
+	BoardingPass      string            `protobuf:"bytes,21,opt,name=BoardingPass,proto3" json:"BoardingPass,omitempty"` // signed token for the QR code, see VerifyBoardingPass
+	BoardedAt         string            `protobuf:"bytes,22,opt,name=BoardedAt,proto3" json:"BoardedAt,omitempty"`       // RFC 3339, empty until the passenger boards
+	BoardedLocation   string            `protobuf:"bytes,23,opt,name=BoardedLocation,proto3" json:"BoardedLocation,omitempty"`
 }
 
-func (x *Bool) Reset() {
-	*x = Bool{}
+func (x *Ticket) Reset() {
+	*x = Ticket{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[13]
+		mi := &file_ticket_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Bool) String() string {
+func (x *Ticket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bool) ProtoMessage() {}
+func (*Ticket) ProtoMessage() {}
 
-func (x *Bool) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[13]
+func (x *Ticket) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -958,405 +1154,8607 @@ func (x *Bool) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bool.ProtoReflect.Descriptor instead.
-func (*Bool) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Ticket.ProtoReflect.Descriptor instead.
+func (*Ticket) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Bool) GetValue() bool {
+func (x *Ticket) GetTicketId() string {
 	if x != nil {
-		return x.Value
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *Ticket) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Ticket) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Ticket) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Ticket) GetPricePaid() *Money {
+	if x != nil {
+		return x.PricePaid
+	}
+	return nil
+}
+
+func (x *Ticket) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Ticket) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *Ticket) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+func (x *Ticket) GetModifiedOn() string {
+	if x != nil {
+		return x.ModifiedOn
+	}
+	return ""
+}
+
+func (x *Ticket) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Ticket) GetClass() SeatClass {
+	if x != nil {
+		return x.Class
+	}
+	return SeatClass_STANDARD
+}
+
+func (x *Ticket) GetAccessibilityNeed() bool {
+	if x != nil {
+		return x.AccessibilityNeed
 	}
 	return false
 }
 
-type UseRequest struct {
+func (x *Ticket) GetCompanionSeat() int32 {
+	if x != nil {
+		return x.CompanionSeat
+	}
+	return 0
+}
+
+func (x *Ticket) GetCategory() PassengerCategory {
+	if x != nil {
+		return x.Category
+	}
+	return PassengerCategory_ADULT
+}
+
+func (x *Ticket) GetFareBreakdown() []*FareLine {
+	if x != nil {
+		return x.FareBreakdown
+	}
+	return nil
+}
+
+func (x *Ticket) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Ticket) GetPointsRedeemed() int64 {
+	if x != nil {
+		return x.PointsRedeemed
+	}
+	return 0
+}
+
+func (x *Ticket) GetPointsEarned() int64 {
+	if x != nil {
+		return x.PointsEarned
+	}
+	return 0
+}
+
+func (x *Ticket) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *Ticket) GetBoardingPass() string {
+	if x != nil {
+		return x.BoardingPass
+	}
+	return ""
+}
+
+func (x *Ticket) GetBoardedAt() string {
+	if x != nil {
+		return x.BoardedAt
+	}
+	return ""
+}
+
+func (x *Ticket) GetBoardedLocation() string {
+	if x != nil {
+		return x.BoardedLocation
+	}
+	return ""
+}
+
+type TicketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	From      string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	UserID    string `protobuf:"bytes,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	PricePaid *Money `protobuf:"bytes,13,opt,name=price_paid,json=pricePaid,proto3" json:"price_paid,omitempty"`
+	// Currency to pay in; defaults to the server's base currency.
+	CurrencyCode      string    `protobuf:"bytes,12,opt,name=CurrencyCode,proto3" json:"CurrencyCode,omitempty"`
+	IdempotencyKey    string    `protobuf:"bytes,5,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
+	Class             SeatClass `protobuf:"varint,6,opt,name=Class,proto3,enum=train_ticketing.SeatClass" json:"Class,omitempty"`
+	AccessibilityNeed bool      `protobuf:"varint,7,opt,name=AccessibilityNeed,proto3" json:"AccessibilityNeed,omitempty"`
+	// Also book an adjacent seat for a companion; requires AccessibilityNeed.
+	Companion bool   `protobuf:"varint,8,opt,name=Companion,proto3" json:"Companion,omitempty"`
+	PromoCode string `protobuf:"bytes,9,opt,name=PromoCode,proto3" json:"PromoCode,omitempty"`
+	// Loyalty points to spend against the fare.
+	RedeemPoints int64 `protobuf:"varint,10,opt,name=RedeemPoints,proto3" json:"RedeemPoints,omitempty"`
+	// Buy the seat held by HoldSeat at the price it quoted.
+	HoldID string `protobuf:"bytes,11,opt,name=HoldID,proto3" json:"HoldID,omitempty"`
 }
 
-func (x *UseRequest) Reset() {
-	*x = UseRequest{}
+func (x *TicketRequest) Reset() {
+	*x = TicketRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[14]
+		mi := &file_ticket_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UseRequest) String() string {
+func (x *TicketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseRequest) ProtoMessage() {}
+func (*TicketRequest) ProtoMessage() {}
 
-func (x *UseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[14]
+func (x *TicketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketRequest.ProtoReflect.Descriptor instead.
+func (*TicketRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TicketRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TicketRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TicketRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *TicketRequest) GetPricePaid() *Money {
+	if x != nil {
+		return x.PricePaid
+	}
+	return nil
+}
+
+func (x *TicketRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *TicketRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TicketRequest) GetClass() SeatClass {
+	if x != nil {
+		return x.Class
+	}
+	return SeatClass_STANDARD
+}
+
+func (x *TicketRequest) GetAccessibilityNeed() bool {
+	if x != nil {
+		return x.AccessibilityNeed
+	}
+	return false
+}
+
+func (x *TicketRequest) GetCompanion() bool {
+	if x != nil {
+		return x.Companion
+	}
+	return false
+}
+
+func (x *TicketRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *TicketRequest) GetRedeemPoints() int64 {
+	if x != nil {
+		return x.RedeemPoints
+	}
+	return 0
+}
+
+func (x *TicketRequest) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+// Message for representing a seat held at a locked price until it expires.
+type Hold struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldID            string      `protobuf:"bytes,1,opt,name=HoldID,proto3" json:"HoldID,omitempty"`
+	UserID            string      `protobuf:"bytes,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	From              string      `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To                string      `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Class             SeatClass   `protobuf:"varint,5,opt,name=Class,proto3,enum=train_ticketing.SeatClass" json:"Class,omitempty"`
+	AccessibilityNeed bool        `protobuf:"varint,6,opt,name=AccessibilityNeed,proto3" json:"AccessibilityNeed,omitempty"`
+	Section           string      `protobuf:"bytes,7,opt,name=section,proto3" json:"section,omitempty"`
+	SeatNumber        int32       `protobuf:"varint,8,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	CompanionSeat     int32       `protobuf:"varint,9,opt,name=CompanionSeat,proto3" json:"CompanionSeat,omitempty"`
+	Fare              *Money      `protobuf:"bytes,14,opt,name=Fare,proto3" json:"Fare,omitempty"`
+	FareBreakdown     []*FareLine `protobuf:"bytes,11,rep,name=FareBreakdown,proto3" json:"FareBreakdown,omitempty"`
+	ExpiresAt         string      `protobuf:"bytes,12,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"` // RFC 3339
+	CreatedOn         string      `protobuf:"bytes,13,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+}
+
+func (x *Hold) Reset() {
+	*x = Hold{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hold) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hold) ProtoMessage() {}
+
+func (x *Hold) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UseRequest.ProtoReflect.Descriptor instead.
-func (*UseRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *UseRequest) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-type SectionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SectionID string `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
-}
-
-func (x *SectionRequest) Reset() {
-	*x = SectionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SectionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SectionRequest) ProtoMessage() {}
-
-func (x *SectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hold.ProtoReflect.Descriptor instead.
+func (*Hold) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Hold) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+func (x *Hold) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Hold) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Hold) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Hold) GetClass() SeatClass {
+	if x != nil {
+		return x.Class
+	}
+	return SeatClass_STANDARD
+}
+
+func (x *Hold) GetAccessibilityNeed() bool {
+	if x != nil {
+		return x.AccessibilityNeed
+	}
+	return false
+}
+
+func (x *Hold) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Hold) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *Hold) GetCompanionSeat() int32 {
+	if x != nil {
+		return x.CompanionSeat
+	}
+	return 0
+}
+
+func (x *Hold) GetFare() *Money {
+	if x != nil {
+		return x.Fare
+	}
+	return nil
+}
+
+func (x *Hold) GetFareBreakdown() []*FareLine {
+	if x != nil {
+		return x.FareBreakdown
+	}
+	return nil
+}
+
+func (x *Hold) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Hold) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+type HoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoldID string `protobuf:"bytes,1,opt,name=HoldID,proto3" json:"HoldID,omitempty"`
+	UserID string `protobuf:"bytes,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+}
+
+func (x *HoldRequest) Reset() {
+	*x = HoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HoldRequest) ProtoMessage() {}
+
+func (x *HoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HoldRequest.ProtoReflect.Descriptor instead.
+func (*HoldRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HoldRequest) GetHoldID() string {
+	if x != nil {
+		return x.HoldID
+	}
+	return ""
+}
+
+func (x *HoldRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type Section struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID      string    `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	Section        string    `protobuf:"bytes,2,opt,name=Section,proto3" json:"Section,omitempty"`
+	TotalSeats     int32     `protobuf:"varint,3,opt,name=TotalSeats,proto3" json:"TotalSeats,omitempty"`
+	AvailableSeats int32     `protobuf:"varint,4,opt,name=AvailableSeats,proto3" json:"AvailableSeats,omitempty"`
+	CreatedOn      string    `protobuf:"bytes,5,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+	ModifiedOn     string    `protobuf:"bytes,6,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
+	Version        int64     `protobuf:"varint,7,opt,name=Version,proto3" json:"Version,omitempty"`
+	Class          SeatClass `protobuf:"varint,8,opt,name=Class,proto3,enum=train_ticketing.SeatClass" json:"Class,omitempty"`
+	Amenities      []Amenity `protobuf:"varint,9,rep,packed,name=Amenities,proto3,enum=train_ticketing.Amenity" json:"Amenities,omitempty"`
+	// Seats held for passengers with an accessibility need.
+	AccessibleSeats  []int32 `protobuf:"varint,10,rep,packed,name=AccessibleSeats,proto3" json:"AccessibleSeats,omitempty"`
+	Departure        string  `protobuf:"bytes,11,opt,name=Departure,proto3" json:"Departure,omitempty"`        // RFC 3339, empty when not scheduled
+	Cancelled        bool    `protobuf:"varint,12,opt,name=Cancelled,proto3" json:"Cancelled,omitempty"`       // withdrawn by a disruption, no longer sold
+	DelayMinutes     int32   `protobuf:"varint,13,opt,name=DelayMinutes,proto3" json:"DelayMinutes,omitempty"` // total delay applied to Departure by disruptions
+	DisruptionReason string  `protobuf:"bytes,14,opt,name=DisruptionReason,proto3" json:"DisruptionReason,omitempty"`
+}
+
+func (x *Section) Reset() {
+	*x = Section{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Section) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Section) ProtoMessage() {}
+
+func (x *Section) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Section.ProtoReflect.Descriptor instead.
+func (*Section) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Section) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *Section) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Section) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *Section) GetAvailableSeats() int32 {
+	if x != nil {
+		return x.AvailableSeats
+	}
+	return 0
+}
+
+func (x *Section) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+func (x *Section) GetModifiedOn() string {
+	if x != nil {
+		return x.ModifiedOn
+	}
+	return ""
+}
+
+func (x *Section) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Section) GetClass() SeatClass {
+	if x != nil {
+		return x.Class
+	}
+	return SeatClass_STANDARD
+}
+
+func (x *Section) GetAmenities() []Amenity {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *Section) GetAccessibleSeats() []int32 {
+	if x != nil {
+		return x.AccessibleSeats
+	}
+	return nil
+}
+
+func (x *Section) GetDeparture() string {
+	if x != nil {
+		return x.Departure
+	}
+	return ""
+}
+
+func (x *Section) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *Section) GetDelayMinutes() int32 {
+	if x != nil {
+		return x.DelayMinutes
+	}
+	return 0
+}
+
+func (x *Section) GetDisruptionReason() string {
+	if x != nil {
+		return x.DisruptionReason
+	}
+	return ""
+}
+
+type CreateSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section         string    `protobuf:"bytes,1,opt,name=Section,proto3" json:"Section,omitempty"`
+	TotalSeats      int32     `protobuf:"varint,2,opt,name=TotalSeats,proto3" json:"TotalSeats,omitempty"`
+	Class           SeatClass `protobuf:"varint,3,opt,name=Class,proto3,enum=train_ticketing.SeatClass" json:"Class,omitempty"`
+	Amenities       []Amenity `protobuf:"varint,4,rep,packed,name=Amenities,proto3,enum=train_ticketing.Amenity" json:"Amenities,omitempty"`
+	AccessibleSeats []int32   `protobuf:"varint,5,rep,packed,name=AccessibleSeats,proto3" json:"AccessibleSeats,omitempty"`
+	Departure       string    `protobuf:"bytes,6,opt,name=Departure,proto3" json:"Departure,omitempty"`
+}
+
+func (x *CreateSectionRequest) Reset() {
+	*x = CreateSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSectionRequest) ProtoMessage() {}
+
+func (x *CreateSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSectionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateSectionRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *CreateSectionRequest) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *CreateSectionRequest) GetClass() SeatClass {
+	if x != nil {
+		return x.Class
+	}
+	return SeatClass_STANDARD
+}
+
+func (x *CreateSectionRequest) GetAmenities() []Amenity {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *CreateSectionRequest) GetAccessibleSeats() []int32 {
+	if x != nil {
+		return x.AccessibleSeats
+	}
+	return nil
+}
+
+func (x *CreateSectionRequest) GetDeparture() string {
+	if x != nil {
+		return x.Departure
+	}
+	return ""
+}
+
+type ModifySectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID       string    `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	Section         string    `protobuf:"bytes,2,opt,name=Section,proto3" json:"Section,omitempty"`
+	Version         int64     `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	Class           SeatClass `protobuf:"varint,4,opt,name=Class,proto3,enum=train_ticketing.SeatClass" json:"Class,omitempty"`
+	Amenities       []Amenity `protobuf:"varint,5,rep,packed,name=Amenities,proto3,enum=train_ticketing.Amenity" json:"Amenities,omitempty"`
+	AccessibleSeats []int32   `protobuf:"varint,6,rep,packed,name=AccessibleSeats,proto3" json:"AccessibleSeats,omitempty"`
+	Departure       string    `protobuf:"bytes,7,opt,name=Departure,proto3" json:"Departure,omitempty"`
+}
+
+func (x *ModifySectionRequest) Reset() {
+	*x = ModifySectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifySectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifySectionRequest) ProtoMessage() {}
+
+func (x *ModifySectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifySectionRequest.ProtoReflect.Descriptor instead.
+func (*ModifySectionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ModifySectionRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *ModifySectionRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ModifySectionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ModifySectionRequest) GetClass() SeatClass {
+	if x != nil {
+		return x.Class
+	}
+	return SeatClass_STANDARD
+}
+
+func (x *ModifySectionRequest) GetAmenities() []Amenity {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *ModifySectionRequest) GetAccessibleSeats() []int32 {
+	if x != nil {
+		return x.AccessibleSeats
+	}
+	return nil
+}
+
+func (x *ModifySectionRequest) GetDeparture() string {
+	if x != nil {
+		return x.Departure
+	}
+	return ""
+}
+
+type ResizeSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID  string        `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	TotalSeats int32         `protobuf:"varint,2,opt,name=TotalSeats,proto3" json:"TotalSeats,omitempty"`
+	Version    int64         `protobuf:"varint,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	Policy     BookingPolicy `protobuf:"varint,4,opt,name=Policy,proto3,enum=train_ticketing.BookingPolicy" json:"Policy,omitempty"`
+}
+
+func (x *ResizeSectionRequest) Reset() {
+	*x = ResizeSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResizeSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResizeSectionRequest) ProtoMessage() {}
+
+func (x *ResizeSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResizeSectionRequest.ProtoReflect.Descriptor instead.
+func (*ResizeSectionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResizeSectionRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *ResizeSectionRequest) GetTotalSeats() int32 {
+	if x != nil {
+		return x.TotalSeats
+	}
+	return 0
+}
+
+func (x *ResizeSectionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ResizeSectionRequest) GetPolicy() BookingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return BookingPolicy_BLOCK
+}
+
+type DeleteSectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID string        `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	Version   int64         `protobuf:"varint,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	Policy    BookingPolicy `protobuf:"varint,3,opt,name=Policy,proto3,enum=train_ticketing.BookingPolicy" json:"Policy,omitempty"`
+}
+
+func (x *DeleteSectionRequest) Reset() {
+	*x = DeleteSectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSectionRequest) ProtoMessage() {}
+
+func (x *DeleteSectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSectionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteSectionRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *DeleteSectionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DeleteSectionRequest) GetPolicy() BookingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return BookingPolicy_BLOCK
+}
+
+// Message for representing what happened to a ticket affected by a section change.
+type AffectedTicket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketId    string        `protobuf:"bytes,1,opt,name=TicketId,proto3" json:"TicketId,omitempty"`
+	UserID      string        `protobuf:"bytes,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Outcome     TicketOutcome `protobuf:"varint,3,opt,name=Outcome,proto3,enum=train_ticketing.TicketOutcome" json:"Outcome,omitempty"`
+	FromSection string        `protobuf:"bytes,4,opt,name=FromSection,proto3" json:"FromSection,omitempty"`
+	FromSeat    int32         `protobuf:"varint,5,opt,name=FromSeat,proto3" json:"FromSeat,omitempty"`
+	ToSection   string        `protobuf:"bytes,6,opt,name=ToSection,proto3" json:"ToSection,omitempty"`
+	ToSeat      int32         `protobuf:"varint,7,opt,name=ToSeat,proto3" json:"ToSeat,omitempty"`
+	Refund      *Money        `protobuf:"bytes,9,opt,name=Refund,proto3" json:"Refund,omitempty"`
+}
+
+func (x *AffectedTicket) Reset() {
+	*x = AffectedTicket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AffectedTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AffectedTicket) ProtoMessage() {}
+
+func (x *AffectedTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AffectedTicket.ProtoReflect.Descriptor instead.
+func (*AffectedTicket) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AffectedTicket) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *AffectedTicket) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AffectedTicket) GetOutcome() TicketOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return TicketOutcome_REASSIGNED
+}
+
+func (x *AffectedTicket) GetFromSection() string {
+	if x != nil {
+		return x.FromSection
+	}
+	return ""
+}
+
+func (x *AffectedTicket) GetFromSeat() int32 {
+	if x != nil {
+		return x.FromSeat
+	}
+	return 0
+}
+
+func (x *AffectedTicket) GetToSection() string {
+	if x != nil {
+		return x.ToSection
+	}
+	return ""
+}
+
+func (x *AffectedTicket) GetToSeat() int32 {
+	if x != nil {
+		return x.ToSeat
+	}
+	return 0
+}
+
+func (x *AffectedTicket) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type SectionChangeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Section *Section          `protobuf:"bytes,1,opt,name=section,proto3" json:"section,omitempty"`
+	Tickets []*AffectedTicket `protobuf:"bytes,2,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *SectionChangeReport) Reset() {
+	*x = SectionChangeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionChangeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionChangeReport) ProtoMessage() {}
+
+func (x *SectionChangeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionChangeReport.ProtoReflect.Descriptor instead.
+func (*SectionChangeReport) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SectionChangeReport) GetSection() *Section {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+func (x *SectionChangeReport) GetTickets() []*AffectedTicket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// Message for representing a seat taken out of service.
+type SeatBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID  string `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	SeatNumber int32  `protobuf:"varint,2,opt,name=SeatNumber,proto3" json:"SeatNumber,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	From       string `protobuf:"bytes,4,opt,name=From,proto3" json:"From,omitempty"`   // RFC 3339, empty means immediately
+	Until      string `protobuf:"bytes,5,opt,name=Until,proto3" json:"Until,omitempty"` // RFC 3339, empty means until unblocked
+	CreatedOn  string `protobuf:"bytes,6,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+}
+
+func (x *SeatBlock) Reset() {
+	*x = SeatBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatBlock) ProtoMessage() {}
+
+func (x *SeatBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatBlock.ProtoReflect.Descriptor instead.
+func (*SeatBlock) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SeatBlock) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *SeatBlock) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *SeatBlock) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SeatBlock) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *SeatBlock) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+func (x *SeatBlock) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+type BlockSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID   string  `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	SeatNumbers []int32 `protobuf:"varint,2,rep,packed,name=SeatNumbers,proto3" json:"SeatNumbers,omitempty"`
+	Reason      string  `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	From        string  `protobuf:"bytes,4,opt,name=From,proto3" json:"From,omitempty"`
+	Until       string  `protobuf:"bytes,5,opt,name=Until,proto3" json:"Until,omitempty"`
+}
+
+func (x *BlockSeatsRequest) Reset() {
+	*x = BlockSeatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSeatsRequest) ProtoMessage() {}
+
+func (x *BlockSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSeatsRequest.ProtoReflect.Descriptor instead.
+func (*BlockSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BlockSeatsRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *BlockSeatsRequest) GetSeatNumbers() []int32 {
+	if x != nil {
+		return x.SeatNumbers
+	}
+	return nil
+}
+
+func (x *BlockSeatsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockSeatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *BlockSeatsRequest) GetUntil() string {
+	if x != nil {
+		return x.Until
+	}
+	return ""
+}
+
+type BlockSeatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*SeatBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// Tickets already booked on the newly blocked seats.
+	Conflicts []*Ticket `protobuf:"bytes,2,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *BlockSeatsResponse) Reset() {
+	*x = BlockSeatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockSeatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSeatsResponse) ProtoMessage() {}
+
+func (x *BlockSeatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSeatsResponse.ProtoReflect.Descriptor instead.
+func (*BlockSeatsResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BlockSeatsResponse) GetBlocks() []*SeatBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *BlockSeatsResponse) GetConflicts() []*Ticket {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type UnblockSeatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID   string  `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	SeatNumbers []int32 `protobuf:"varint,2,rep,packed,name=SeatNumbers,proto3" json:"SeatNumbers,omitempty"`
+}
+
+func (x *UnblockSeatsRequest) Reset() {
+	*x = UnblockSeatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockSeatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockSeatsRequest) ProtoMessage() {}
+
+func (x *UnblockSeatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockSeatsRequest.ProtoReflect.Descriptor instead.
+func (*UnblockSeatsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnblockSeatsRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *UnblockSeatsRequest) GetSeatNumbers() []int32 {
+	if x != nil {
+		return x.SeatNumbers
+	}
+	return nil
+}
+
+// Message for representing a promotion code.
+type Promotion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code           string       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Type           DiscountType `protobuf:"varint,2,opt,name=Type,proto3,enum=train_ticketing.DiscountType" json:"Type,omitempty"`
+	BasisPoints    int32        `protobuf:"varint,15,opt,name=BasisPoints,proto3" json:"BasisPoints,omitempty"`      // percentage off for PERCENT, in hundredths of a percent
+	Amount         *Money       `protobuf:"bytes,14,opt,name=Amount,proto3" json:"Amount,omitempty"`                 // amount off for FIXED
+	ValidFrom      string       `protobuf:"bytes,4,opt,name=ValidFrom,proto3" json:"ValidFrom,omitempty"`            // RFC 3339, empty means immediately
+	ValidUntil     string       `protobuf:"bytes,5,opt,name=ValidUntil,proto3" json:"ValidUntil,omitempty"`          // RFC 3339, empty means no expiry
+	MaxUses        int32        `protobuf:"varint,6,opt,name=MaxUses,proto3" json:"MaxUses,omitempty"`               // 0 means unlimited
+	MaxUsesPerUser int32        `protobuf:"varint,7,opt,name=MaxUsesPerUser,proto3" json:"MaxUsesPerUser,omitempty"` // 0 means unlimited
+	From           string       `protobuf:"bytes,8,opt,name=From,proto3" json:"From,omitempty"`                      // restricts the code to a route when set
+	To             string       `protobuf:"bytes,9,opt,name=To,proto3" json:"To,omitempty"`
+	Sections       []string     `protobuf:"bytes,10,rep,name=Sections,proto3" json:"Sections,omitempty"` // restricts the code to these sections when set
+	Redemptions    int32        `protobuf:"varint,11,opt,name=Redemptions,proto3" json:"Redemptions,omitempty"`
+	Disabled       bool         `protobuf:"varint,12,opt,name=Disabled,proto3" json:"Disabled,omitempty"`
+	CreatedOn      string       `protobuf:"bytes,13,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+}
+
+func (x *Promotion) Reset() {
+	*x = Promotion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Promotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Promotion) ProtoMessage() {}
+
+func (x *Promotion) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Promotion.ProtoReflect.Descriptor instead.
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Promotion) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Promotion) GetType() DiscountType {
+	if x != nil {
+		return x.Type
+	}
+	return DiscountType_PERCENT
+}
+
+func (x *Promotion) GetBasisPoints() int32 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
+func (x *Promotion) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Promotion) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *Promotion) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *Promotion) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Promotion) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *Promotion) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Promotion) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Promotion) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *Promotion) GetRedemptions() int32 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+func (x *Promotion) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Promotion) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+type CreatePromotionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code           string       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Type           DiscountType `protobuf:"varint,2,opt,name=Type,proto3,enum=train_ticketing.DiscountType" json:"Type,omitempty"`
+	BasisPoints    int32        `protobuf:"varint,12,opt,name=BasisPoints,proto3" json:"BasisPoints,omitempty"`
+	Amount         *Money       `protobuf:"bytes,11,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	ValidFrom      string       `protobuf:"bytes,4,opt,name=ValidFrom,proto3" json:"ValidFrom,omitempty"`
+	ValidUntil     string       `protobuf:"bytes,5,opt,name=ValidUntil,proto3" json:"ValidUntil,omitempty"`
+	MaxUses        int32        `protobuf:"varint,6,opt,name=MaxUses,proto3" json:"MaxUses,omitempty"`
+	MaxUsesPerUser int32        `protobuf:"varint,7,opt,name=MaxUsesPerUser,proto3" json:"MaxUsesPerUser,omitempty"`
+	From           string       `protobuf:"bytes,8,opt,name=From,proto3" json:"From,omitempty"`
+	To             string       `protobuf:"bytes,9,opt,name=To,proto3" json:"To,omitempty"`
+	Sections       []string     `protobuf:"bytes,10,rep,name=Sections,proto3" json:"Sections,omitempty"`
+}
+
+func (x *CreatePromotionRequest) Reset() {
+	*x = CreatePromotionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePromotionRequest) ProtoMessage() {}
+
+func (x *CreatePromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePromotionRequest.ProtoReflect.Descriptor instead.
+func (*CreatePromotionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreatePromotionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetType() DiscountType {
+	if x != nil {
+		return x.Type
+	}
+	return DiscountType_PERCENT
+}
+
+func (x *CreatePromotionRequest) GetBasisPoints() int32 {
+	if x != nil {
+		return x.BasisPoints
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *CreatePromotionRequest) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *CreatePromotionRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CreatePromotionRequest) GetSections() []string {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type PromotionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+}
+
+func (x *PromotionRequest) Reset() {
+	*x = PromotionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromotionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionRequest) ProtoMessage() {}
+
+func (x *PromotionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionRequest.ProtoReflect.Descriptor instead.
+func (*PromotionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PromotionRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type AllPromotions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Promotions []*Promotion `protobuf:"bytes,1,rep,name=promotions,proto3" json:"promotions,omitempty"`
+}
+
+func (x *AllPromotions) Reset() {
+	*x = AllPromotions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllPromotions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllPromotions) ProtoMessage() {}
+
+func (x *AllPromotions) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllPromotions.ProtoReflect.Descriptor instead.
+func (*AllPromotions) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AllPromotions) GetPromotions() []*Promotion {
+	if x != nil {
+		return x.Promotions
+	}
+	return nil
+}
+
+// Message for representing one movement of loyalty points.
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryID     string          `protobuf:"bytes,1,opt,name=EntryID,proto3" json:"EntryID,omitempty"`
+	Type        LedgerEntryType `protobuf:"varint,2,opt,name=Type,proto3,enum=train_ticketing.LedgerEntryType" json:"Type,omitempty"`
+	Points      int64           `protobuf:"varint,3,opt,name=Points,proto3" json:"Points,omitempty"` // negative for redemptions and reversed earnings
+	TicketId    string          `protobuf:"bytes,4,opt,name=TicketId,proto3" json:"TicketId,omitempty"`
+	Spend       *Money          `protobuf:"bytes,8,opt,name=Spend,proto3" json:"Spend,omitempty"` // fare paid in the base currency for EARN, negated for the REVERSAL of an earning
+	Description string          `protobuf:"bytes,6,opt,name=Description,proto3" json:"Description,omitempty"`
+	CreatedOn   string          `protobuf:"bytes,7,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"` // RFC 3339
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *LedgerEntry) GetEntryID() string {
+	if x != nil {
+		return x.EntryID
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetType() LedgerEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return LedgerEntryType_EARN
+}
+
+func (x *LedgerEntry) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetSpend() *Money {
+	if x != nil {
+		return x.Spend
+	}
+	return nil
+}
+
+func (x *LedgerEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *LedgerEntry) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+type LoyaltyBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID        string         `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Points        int64          `protobuf:"varint,2,opt,name=Points,proto3" json:"Points,omitempty"`
+	Tier          LoyaltyTier    `protobuf:"varint,3,opt,name=Tier,proto3,enum=train_ticketing.LoyaltyTier" json:"Tier,omitempty"`
+	TrailingSpend *Money         `protobuf:"bytes,6,opt,name=TrailingSpend,proto3" json:"TrailingSpend,omitempty"`
+	Entries       []*LedgerEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"` // oldest first
+}
+
+func (x *LoyaltyBalance) Reset() {
+	*x = LoyaltyBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoyaltyBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoyaltyBalance) ProtoMessage() {}
+
+func (x *LoyaltyBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoyaltyBalance.ProtoReflect.Descriptor instead.
+func (*LoyaltyBalance) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LoyaltyBalance) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *LoyaltyBalance) GetPoints() int64 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *LoyaltyBalance) GetTier() LoyaltyTier {
+	if x != nil {
+		return x.Tier
+	}
+	return LoyaltyTier_BRONZE
+}
+
+func (x *LoyaltyBalance) GetTrailingSpend() *Money {
+	if x != nil {
+		return x.TrailingSpend
+	}
+	return nil
+}
+
+func (x *LoyaltyBalance) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Message for representing the value of a currency in units of the base currency.
+type ExchangeRate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrencyCode string `protobuf:"bytes,1,opt,name=CurrencyCode,proto3" json:"CurrencyCode,omitempty"`
+	Rate         string `protobuf:"bytes,2,opt,name=Rate,proto3" json:"Rate,omitempty"` // decimal amount of CurrencyCode per unit of the base currency, e.g. "0.92"
+	UpdatedOn    string `protobuf:"bytes,3,opt,name=UpdatedOn,proto3" json:"UpdatedOn,omitempty"`
+}
+
+func (x *ExchangeRate) Reset() {
+	*x = ExchangeRate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRate) ProtoMessage() {}
+
+func (x *ExchangeRate) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRate.ProtoReflect.Descriptor instead.
+func (*ExchangeRate) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ExchangeRate) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *ExchangeRate) GetUpdatedOn() string {
+	if x != nil {
+		return x.UpdatedOn
+	}
+	return ""
+}
+
+type CurrencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrencyCode string `protobuf:"bytes,1,opt,name=CurrencyCode,proto3" json:"CurrencyCode,omitempty"` // empty for every currency
+}
+
+func (x *CurrencyRequest) Reset() {
+	*x = CurrencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyRequest) ProtoMessage() {}
+
+func (x *CurrencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CurrencyRequest) GetCurrencyCode() string {
+	if x != nil {
+		return x.CurrencyCode
+	}
+	return ""
+}
+
+type ExchangeRates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseCurrency string          `protobuf:"bytes,1,opt,name=BaseCurrency,proto3" json:"BaseCurrency,omitempty"`
+	Rates        []*ExchangeRate `protobuf:"bytes,2,rep,name=rates,proto3" json:"rates,omitempty"`
+}
+
+func (x *ExchangeRates) Reset() {
+	*x = ExchangeRates{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExchangeRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExchangeRates) ProtoMessage() {}
+
+func (x *ExchangeRates) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExchangeRates.ProtoReflect.Descriptor instead.
+func (*ExchangeRates) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExchangeRates) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *ExchangeRates) GetRates() []*ExchangeRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+// Message for representing the business issuing invoices.
+type Seller struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	TaxID   string `protobuf:"bytes,3,opt,name=TaxID,proto3" json:"TaxID,omitempty"`
+}
+
+func (x *Seller) Reset() {
+	*x = Seller{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Seller) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seller) ProtoMessage() {}
+
+func (x *Seller) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seller.ProtoReflect.Descriptor instead.
+func (*Seller) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Seller) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Seller) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Seller) GetTaxID() string {
+	if x != nil {
+		return x.TaxID
+	}
+	return ""
+}
+
+// Message for representing one line of an invoice; Gross includes tax.
+type InvoiceLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Description string `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
+	Net         *Money `protobuf:"bytes,2,opt,name=Net,proto3" json:"Net,omitempty"`
+	Tax         *Money `protobuf:"bytes,3,opt,name=Tax,proto3" json:"Tax,omitempty"`
+	Gross       *Money `protobuf:"bytes,4,opt,name=Gross,proto3" json:"Gross,omitempty"`
+}
+
+func (x *InvoiceLine) Reset() {
+	*x = InvoiceLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceLine) ProtoMessage() {}
+
+func (x *InvoiceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceLine.ProtoReflect.Descriptor instead.
+func (*InvoiceLine) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InvoiceLine) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InvoiceLine) GetNet() *Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *InvoiceLine) GetGross() *Money {
+	if x != nil {
+		return x.Gross
+	}
+	return nil
+}
+
+// Message for representing the total charged for one tax.
+type TaxLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	RateBasisPoints int32  `protobuf:"varint,4,opt,name=RateBasisPoints,proto3" json:"RateBasisPoints,omitempty"` // in hundredths of a percent
+	Tax             *Money `protobuf:"bytes,3,opt,name=Tax,proto3" json:"Tax,omitempty"`
+}
+
+func (x *TaxLine) Reset() {
+	*x = TaxLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaxLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaxLine) ProtoMessage() {}
+
+func (x *TaxLine) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaxLine.ProtoReflect.Descriptor instead.
+func (*TaxLine) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TaxLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TaxLine) GetRateBasisPoints() int32 {
+	if x != nil {
+		return x.RateBasisPoints
+	}
+	return 0
+}
+
+func (x *TaxLine) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+// Message for representing an invoice or a credit note reversing one.
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvoiceNumber         string         `protobuf:"bytes,1,opt,name=InvoiceNumber,proto3" json:"InvoiceNumber,omitempty"`
+	Type                  InvoiceType    `protobuf:"varint,2,opt,name=Type,proto3,enum=train_ticketing.InvoiceType" json:"Type,omitempty"`
+	OriginalInvoiceNumber string         `protobuf:"bytes,3,opt,name=OriginalInvoiceNumber,proto3" json:"OriginalInvoiceNumber,omitempty"` // invoice a credit note reverses
+	IssuedOn              string         `protobuf:"bytes,4,opt,name=IssuedOn,proto3" json:"IssuedOn,omitempty"`                           // RFC 3339
+	Seller                *Seller        `protobuf:"bytes,5,opt,name=Seller,proto3" json:"Seller,omitempty"`
+	UserID                string         `protobuf:"bytes,6,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	BuyerName             string         `protobuf:"bytes,7,opt,name=BuyerName,proto3" json:"BuyerName,omitempty"`
+	BuyerEmail            string         `protobuf:"bytes,8,opt,name=BuyerEmail,proto3" json:"BuyerEmail,omitempty"`
+	TicketId              string         `protobuf:"bytes,9,opt,name=TicketId,proto3" json:"TicketId,omitempty"`
+	Lines                 []*InvoiceLine `protobuf:"bytes,10,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	Taxes                 []*TaxLine     `protobuf:"bytes,11,rep,name=Taxes,proto3" json:"Taxes,omitempty"`
+	Net                   *Money         `protobuf:"bytes,12,opt,name=Net,proto3" json:"Net,omitempty"`
+	Tax                   *Money         `protobuf:"bytes,13,opt,name=Tax,proto3" json:"Tax,omitempty"`
+	Gross                 *Money         `protobuf:"bytes,14,opt,name=Gross,proto3" json:"Gross,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Invoice) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *Invoice) GetType() InvoiceType {
+	if x != nil {
+		return x.Type
+	}
+	return InvoiceType_INVOICE
+}
+
+func (x *Invoice) GetOriginalInvoiceNumber() string {
+	if x != nil {
+		return x.OriginalInvoiceNumber
+	}
+	return ""
+}
+
+func (x *Invoice) GetIssuedOn() string {
+	if x != nil {
+		return x.IssuedOn
+	}
+	return ""
+}
+
+func (x *Invoice) GetSeller() *Seller {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Invoice) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *Invoice) GetBuyerName() string {
+	if x != nil {
+		return x.BuyerName
+	}
+	return ""
+}
+
+func (x *Invoice) GetBuyerEmail() string {
+	if x != nil {
+		return x.BuyerEmail
+	}
+	return ""
+}
+
+func (x *Invoice) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *Invoice) GetLines() []*InvoiceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Invoice) GetTaxes() []*TaxLine {
+	if x != nil {
+		return x.Taxes
+	}
+	return nil
+}
+
+func (x *Invoice) GetNet() *Money {
+	if x != nil {
+		return x.Net
+	}
+	return nil
+}
+
+func (x *Invoice) GetTax() *Money {
+	if x != nil {
+		return x.Tax
+	}
+	return nil
+}
+
+func (x *Invoice) GetGross() *Money {
+	if x != nil {
+		return x.Gross
+	}
+	return nil
+}
+
+type InvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID        string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	InvoiceNumber string `protobuf:"bytes,2,opt,name=InvoiceNumber,proto3" json:"InvoiceNumber,omitempty"` // empty for the invoice of the user's current ticket
+}
+
+func (x *InvoiceRequest) Reset() {
+	*x = InvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceRequest) ProtoMessage() {}
+
+func (x *InvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceRequest.ProtoReflect.Descriptor instead.
+func (*InvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InvoiceRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *InvoiceRequest) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+type AllInvoices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+}
+
+func (x *AllInvoices) Reset() {
+	*x = AllInvoices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllInvoices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllInvoices) ProtoMessage() {}
+
+func (x *AllInvoices) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllInvoices.ProtoReflect.Descriptor instead.
+func (*AllInvoices) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AllInvoices) GetInvoices() []*Invoice {
+	if x != nil {
+		return x.Invoices
+	}
+	return nil
+}
+
+type RenderReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string        `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Format ReceiptFormat `protobuf:"varint,2,opt,name=Format,proto3,enum=train_ticketing.ReceiptFormat" json:"Format,omitempty"`
+}
+
+func (x *RenderReceiptRequest) Reset() {
+	*x = RenderReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderReceiptRequest) ProtoMessage() {}
+
+func (x *RenderReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderReceiptRequest.ProtoReflect.Descriptor instead.
+func (*RenderReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RenderReceiptRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RenderReceiptRequest) GetFormat() ReceiptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReceiptFormat_PDF
+}
+
+// Message for representing a receipt rendered for printing or email.
+type RenderedReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format      ReceiptFormat `protobuf:"varint,1,opt,name=Format,proto3,enum=train_ticketing.ReceiptFormat" json:"Format,omitempty"`
+	ContentType string        `protobuf:"bytes,2,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	FileName    string        `protobuf:"bytes,3,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	Content     []byte        `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (x *RenderedReceipt) Reset() {
+	*x = RenderedReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderedReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderedReceipt) ProtoMessage() {}
+
+func (x *RenderedReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderedReceipt.ProtoReflect.Descriptor instead.
+func (*RenderedReceipt) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RenderedReceipt) GetFormat() ReceiptFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ReceiptFormat_PDF
+}
+
+func (x *RenderedReceipt) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RenderedReceipt) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *RenderedReceipt) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ModifySeatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Section        string `protobuf:"bytes,2,opt,name=Section,proto3" json:"Section,omitempty"`
+	SeatNumber     int32  `protobuf:"varint,3,opt,name=SeatNumber,proto3" json:"SeatNumber,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,4,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
+	// Version of the ticket being moved.
+	Version int64 `protobuf:"varint,5,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *ModifySeatRequest) Reset() {
+	*x = ModifySeatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifySeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifySeatRequest) ProtoMessage() {}
+
+func (x *ModifySeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifySeatRequest.ProtoReflect.Descriptor instead.
+func (*ModifySeatRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ModifySeatRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ModifySeatRequest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *ModifySeatRequest) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *ModifySeatRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ModifySeatRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type BoardingPassKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KeyID     string `protobuf:"bytes,1,opt,name=KeyID,proto3" json:"KeyID,omitempty"`
+	Algorithm string `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"` // always "Ed25519"
+	PublicKey []byte `protobuf:"bytes,3,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	Current   bool   `protobuf:"varint,4,opt,name=Current,proto3" json:"Current,omitempty"` // false for keys kept only to verify passes issued before a rotation
+}
+
+func (x *BoardingPassKey) Reset() {
+	*x = BoardingPassKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardingPassKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardingPassKey) ProtoMessage() {}
+
+func (x *BoardingPassKey) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardingPassKey.ProtoReflect.Descriptor instead.
+func (*BoardingPassKey) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *BoardingPassKey) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *BoardingPassKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *BoardingPassKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *BoardingPassKey) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type BoardingPassKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*BoardingPassKey `protobuf:"bytes,1,rep,name=Keys,proto3" json:"Keys,omitempty"`
+}
+
+func (x *BoardingPassKeys) Reset() {
+	*x = BoardingPassKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardingPassKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardingPassKeys) ProtoMessage() {}
+
+func (x *BoardingPassKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardingPassKeys.ProtoReflect.Descriptor instead.
+func (*BoardingPassKeys) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BoardingPassKeys) GetKeys() []*BoardingPassKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type VerifyBoardingPassRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *VerifyBoardingPassRequest) Reset() {
+	*x = VerifyBoardingPassRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyBoardingPassRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBoardingPassRequest) ProtoMessage() {}
+
+func (x *VerifyBoardingPassRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyBoardingPassRequest.ProtoReflect.Descriptor instead.
+func (*VerifyBoardingPassRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VerifyBoardingPassRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Result of checking a boarding pass signature. The ticket store is not consulted, so a
+// valid pass may belong to a ticket that has since been cancelled or moved.
+type BoardingPassVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Valid         bool   `protobuf:"varint,1,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	Reason        string `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"` // why the pass was rejected when Valid is false
+	KeyID         string `protobuf:"bytes,3,opt,name=KeyID,proto3" json:"KeyID,omitempty"`
+	TicketID      string `protobuf:"bytes,4,opt,name=TicketID,proto3" json:"TicketID,omitempty"`
+	From          string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Section       string `protobuf:"bytes,7,opt,name=section,proto3" json:"section,omitempty"`
+	SeatNumber    int32  `protobuf:"varint,8,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	CompanionSeat int32  `protobuf:"varint,9,opt,name=CompanionSeat,proto3" json:"CompanionSeat,omitempty"`
+	Passenger     string `protobuf:"bytes,10,opt,name=Passenger,proto3" json:"Passenger,omitempty"`
+	IssuedAt      string `protobuf:"bytes,11,opt,name=IssuedAt,proto3" json:"IssuedAt,omitempty"` // RFC 3339
+}
+
+func (x *BoardingPassVerification) Reset() {
+	*x = BoardingPassVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardingPassVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardingPassVerification) ProtoMessage() {}
+
+func (x *BoardingPassVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardingPassVerification.ProtoReflect.Descriptor instead.
+func (*BoardingPassVerification) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BoardingPassVerification) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *BoardingPassVerification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BoardingPassVerification) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *BoardingPassVerification) GetTicketID() string {
+	if x != nil {
+		return x.TicketID
+	}
+	return ""
+}
+
+func (x *BoardingPassVerification) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *BoardingPassVerification) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *BoardingPassVerification) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *BoardingPassVerification) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *BoardingPassVerification) GetCompanionSeat() int32 {
+	if x != nil {
+		return x.CompanionSeat
+	}
+	return 0
+}
+
+func (x *BoardingPassVerification) GetPassenger() string {
+	if x != nil {
+		return x.Passenger
+	}
+	return ""
+}
+
+func (x *BoardingPassVerification) GetIssuedAt() string {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return ""
+}
+
+// Message for a conductor's scan of a passenger.
+type BoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Boarding pass token, receipt QR payload or ticket ID.
+	Code string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	// Journey of the train being boarded; a mismatch is reported as WRONG_TRAIN.
+	From       string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To         string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Location   string `protobuf:"bytes,4,opt,name=Location,proto3" json:"Location,omitempty"`      // where the scan happened, e.g. a station or platform
+	SectionID  string `protobuf:"bytes,5,opt,name=SectionID,proto3" json:"SectionID,omitempty"`    // section the passenger was found in, empty to skip the check
+	SeatNumber int32  `protobuf:"varint,6,opt,name=SeatNumber,proto3" json:"SeatNumber,omitempty"` // seat the passenger was found in, 0 to skip the check
+	// Departure of the train being boarded, an RFC 3339 time or a YYYY-MM-DD date, empty to
+	// skip the check; a ticket for another departure is reported as WRONG_TRAIN.
+	Departure string `protobuf:"bytes,7,opt,name=Departure,proto3" json:"Departure,omitempty"`
+}
+
+func (x *BoardRequest) Reset() {
+	*x = BoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRequest) ProtoMessage() {}
+
+func (x *BoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRequest.ProtoReflect.Descriptor instead.
+func (*BoardRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *BoardRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BoardRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *BoardRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *BoardRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *BoardRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *BoardRequest) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *BoardRequest) GetDeparture() string {
+	if x != nil {
+		return x.Departure
+	}
+	return ""
+}
+
+type BoardingResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Outcome   BoardingOutcome `protobuf:"varint,1,opt,name=Outcome,proto3,enum=train_ticketing.BoardingOutcome" json:"Outcome,omitempty"`
+	Boarded   bool            `protobuf:"varint,2,opt,name=Boarded,proto3" json:"Boarded,omitempty"` // whether the ticket is now marked as boarded
+	Message   string          `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	Ticket    *Ticket         `protobuf:"bytes,4,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Passenger string          `protobuf:"bytes,5,opt,name=Passenger,proto3" json:"Passenger,omitempty"`
+}
+
+func (x *BoardingResult) Reset() {
+	*x = BoardingResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardingResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardingResult) ProtoMessage() {}
+
+func (x *BoardingResult) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardingResult.ProtoReflect.Descriptor instead.
+func (*BoardingResult) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BoardingResult) GetOutcome() BoardingOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return BoardingOutcome_BOARDED
+}
+
+func (x *BoardingResult) GetBoarded() bool {
+	if x != nil {
+		return x.Boarded
+	}
+	return false
+}
+
+func (x *BoardingResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BoardingResult) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *BoardingResult) GetPassenger() string {
+	if x != nil {
+		return x.Passenger
+	}
+	return ""
+}
+
+type ManifestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID   string `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	PendingOnly bool   `protobuf:"varint,2,opt,name=PendingOnly,proto3" json:"PendingOnly,omitempty"` // only list passengers who have not boarded yet
+}
+
+func (x *ManifestRequest) Reset() {
+	*x = ManifestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestRequest) ProtoMessage() {}
+
+func (x *ManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestRequest.ProtoReflect.Descriptor instead.
+func (*ManifestRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ManifestRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *ManifestRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+type ManifestEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketId          string `protobuf:"bytes,1,opt,name=TicketId,proto3" json:"TicketId,omitempty"`
+	UserID            string `protobuf:"bytes,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Passenger         string `protobuf:"bytes,3,opt,name=Passenger,proto3" json:"Passenger,omitempty"`
+	SeatNumber        int32  `protobuf:"varint,4,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	CompanionSeat     int32  `protobuf:"varint,5,opt,name=CompanionSeat,proto3" json:"CompanionSeat,omitempty"`
+	From              string `protobuf:"bytes,6,opt,name=from,proto3" json:"from,omitempty"`
+	To                string `protobuf:"bytes,7,opt,name=to,proto3" json:"to,omitempty"`
+	Boarded           bool   `protobuf:"varint,8,opt,name=Boarded,proto3" json:"Boarded,omitempty"`
+	BoardedAt         string `protobuf:"bytes,9,opt,name=BoardedAt,proto3" json:"BoardedAt,omitempty"`
+	BoardedLocation   string `protobuf:"bytes,10,opt,name=BoardedLocation,proto3" json:"BoardedLocation,omitempty"`
+	AccessibilityNeed bool   `protobuf:"varint,11,opt,name=AccessibilityNeed,proto3" json:"AccessibilityNeed,omitempty"`
+}
+
+func (x *ManifestEntry) Reset() {
+	*x = ManifestEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestEntry) ProtoMessage() {}
+
+func (x *ManifestEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestEntry.ProtoReflect.Descriptor instead.
+func (*ManifestEntry) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ManifestEntry) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetPassenger() string {
+	if x != nil {
+		return x.Passenger
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *ManifestEntry) GetCompanionSeat() int32 {
+	if x != nil {
+		return x.CompanionSeat
+	}
+	return 0
+}
+
+func (x *ManifestEntry) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetBoarded() bool {
+	if x != nil {
+		return x.Boarded
+	}
+	return false
+}
+
+func (x *ManifestEntry) GetBoardedAt() string {
+	if x != nil {
+		return x.BoardedAt
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetBoardedLocation() string {
+	if x != nil {
+		return x.BoardedLocation
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetAccessibilityNeed() bool {
+	if x != nil {
+		return x.AccessibilityNeed
+	}
+	return false
+}
+
+type Manifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID  string           `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	Section    string           `protobuf:"bytes,2,opt,name=Section,proto3" json:"Section,omitempty"`
+	Expected   int32            `protobuf:"varint,3,opt,name=Expected,proto3" json:"Expected,omitempty"`
+	Boarded    int32            `protobuf:"varint,4,opt,name=Boarded,proto3" json:"Boarded,omitempty"`
+	Passengers []*ManifestEntry `protobuf:"bytes,5,rep,name=Passengers,proto3" json:"Passengers,omitempty"`
+}
+
+func (x *Manifest) Reset() {
+	*x = Manifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manifest) ProtoMessage() {}
+
+func (x *Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manifest.ProtoReflect.Descriptor instead.
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Manifest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *Manifest) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Manifest) GetExpected() int32 {
+	if x != nil {
+		return x.Expected
+	}
+	return 0
+}
+
+func (x *Manifest) GetBoarded() int32 {
+	if x != nil {
+		return x.Boarded
+	}
+	return 0
+}
+
+func (x *Manifest) GetPassengers() []*ManifestEntry {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+// Message for reporting that a train run or a single section is cancelled or delayed.
+type DisruptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID    string          `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"` // a single section, e.g. a coach taken out of the train
+	Departure    string          `protobuf:"bytes,2,opt,name=Departure,proto3" json:"Departure,omitempty"` // RFC 3339, every section departing at this time
+	Type         DisruptionType  `protobuf:"varint,3,opt,name=Type,proto3,enum=train_ticketing.DisruptionType" json:"Type,omitempty"`
+	DelayMinutes int32           `protobuf:"varint,4,opt,name=DelayMinutes,proto3" json:"DelayMinutes,omitempty"`                          // required for DELAY
+	Policy       RebookingPolicy `protobuf:"varint,5,opt,name=Policy,proto3,enum=train_ticketing.RebookingPolicy" json:"Policy,omitempty"` // for CANCELLATION
+	Reason       string          `protobuf:"bytes,6,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *DisruptionRequest) Reset() {
+	*x = DisruptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisruptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionRequest) ProtoMessage() {}
+
+func (x *DisruptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionRequest.ProtoReflect.Descriptor instead.
+func (*DisruptionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DisruptionRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *DisruptionRequest) GetDeparture() string {
+	if x != nil {
+		return x.Departure
+	}
+	return ""
+}
+
+func (x *DisruptionRequest) GetType() DisruptionType {
+	if x != nil {
+		return x.Type
+	}
+	return DisruptionType_CANCELLATION
+}
+
+func (x *DisruptionRequest) GetDelayMinutes() int32 {
+	if x != nil {
+		return x.DelayMinutes
+	}
+	return 0
+}
+
+func (x *DisruptionRequest) GetPolicy() RebookingPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return RebookingPolicy_SAME_CLASS
+}
+
+func (x *DisruptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PassengerDisruption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TicketId      string            `protobuf:"bytes,1,opt,name=TicketId,proto3" json:"TicketId,omitempty"`
+	UserID        string            `protobuf:"bytes,2,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Passenger     string            `protobuf:"bytes,3,opt,name=Passenger,proto3" json:"Passenger,omitempty"`
+	Email         string            `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
+	Outcome       DisruptionOutcome `protobuf:"varint,5,opt,name=Outcome,proto3,enum=train_ticketing.DisruptionOutcome" json:"Outcome,omitempty"`
+	FromSection   string            `protobuf:"bytes,6,opt,name=FromSection,proto3" json:"FromSection,omitempty"`
+	FromSeat      int32             `protobuf:"varint,7,opt,name=FromSeat,proto3" json:"FromSeat,omitempty"`
+	FromDeparture string            `protobuf:"bytes,8,opt,name=FromDeparture,proto3" json:"FromDeparture,omitempty"`
+	ToSection     string            `protobuf:"bytes,9,opt,name=ToSection,proto3" json:"ToSection,omitempty"`
+	ToSeat        int32             `protobuf:"varint,10,opt,name=ToSeat,proto3" json:"ToSeat,omitempty"`
+	ToDeparture   string            `protobuf:"bytes,11,opt,name=ToDeparture,proto3" json:"ToDeparture,omitempty"`
+	Refund        *Money            `protobuf:"bytes,12,opt,name=Refund,proto3" json:"Refund,omitempty"`
+}
+
+func (x *PassengerDisruption) Reset() {
+	*x = PassengerDisruption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassengerDisruption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassengerDisruption) ProtoMessage() {}
+
+func (x *PassengerDisruption) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassengerDisruption.ProtoReflect.Descriptor instead.
+func (*PassengerDisruption) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PassengerDisruption) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *PassengerDisruption) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *PassengerDisruption) GetPassenger() string {
+	if x != nil {
+		return x.Passenger
+	}
+	return ""
+}
+
+func (x *PassengerDisruption) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PassengerDisruption) GetOutcome() DisruptionOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return DisruptionOutcome_REBOOKED
+}
+
+func (x *PassengerDisruption) GetFromSection() string {
+	if x != nil {
+		return x.FromSection
+	}
+	return ""
+}
+
+func (x *PassengerDisruption) GetFromSeat() int32 {
+	if x != nil {
+		return x.FromSeat
+	}
+	return 0
+}
+
+func (x *PassengerDisruption) GetFromDeparture() string {
+	if x != nil {
+		return x.FromDeparture
+	}
+	return ""
+}
+
+func (x *PassengerDisruption) GetToSection() string {
+	if x != nil {
+		return x.ToSection
+	}
+	return ""
+}
+
+func (x *PassengerDisruption) GetToSeat() int32 {
+	if x != nil {
+		return x.ToSeat
+	}
+	return 0
+}
+
+func (x *PassengerDisruption) GetToDeparture() string {
+	if x != nil {
+		return x.ToDeparture
+	}
+	return ""
+}
+
+func (x *PassengerDisruption) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type DisruptionReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       DisruptionType         `protobuf:"varint,1,opt,name=Type,proto3,enum=train_ticketing.DisruptionType" json:"Type,omitempty"`
+	Reason     string                 `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	Sections   []*Section             `protobuf:"bytes,3,rep,name=Sections,proto3" json:"Sections,omitempty"` // the disrupted sections after the change
+	Passengers []*PassengerDisruption `protobuf:"bytes,4,rep,name=Passengers,proto3" json:"Passengers,omitempty"`
+	Rebooked   int32                  `protobuf:"varint,5,opt,name=Rebooked,proto3" json:"Rebooked,omitempty"`
+	Refunded   int32                  `protobuf:"varint,6,opt,name=Refunded,proto3" json:"Refunded,omitempty"`
+	Delayed    int32                  `protobuf:"varint,7,opt,name=Delayed,proto3" json:"Delayed,omitempty"`
+}
+
+func (x *DisruptionReport) Reset() {
+	*x = DisruptionReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisruptionReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisruptionReport) ProtoMessage() {}
+
+func (x *DisruptionReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisruptionReport.ProtoReflect.Descriptor instead.
+func (*DisruptionReport) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DisruptionReport) GetType() DisruptionType {
+	if x != nil {
+		return x.Type
+	}
+	return DisruptionType_CANCELLATION
+}
+
+func (x *DisruptionReport) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DisruptionReport) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *DisruptionReport) GetPassengers() []*PassengerDisruption {
+	if x != nil {
+		return x.Passengers
+	}
+	return nil
+}
+
+func (x *DisruptionReport) GetRebooked() int32 {
+	if x != nil {
+		return x.Rebooked
+	}
+	return 0
+}
+
+func (x *DisruptionReport) GetRefunded() int32 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+func (x *DisruptionReport) GetDelayed() int32 {
+	if x != nil {
+		return x.Delayed
+	}
+	return 0
+}
+
+// Message for one notification sent, or given up on, through one channel.
+type NotificationDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          string         `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	EventID     string         `protobuf:"bytes,2,opt,name=EventID,proto3" json:"EventID,omitempty"`
+	Event       string         `protobuf:"bytes,3,opt,name=Event,proto3" json:"Event,omitempty"` // e.g. ticket.booked
+	Channel     string         `protobuf:"bytes,4,opt,name=Channel,proto3" json:"Channel,omitempty"`
+	UserID      string         `protobuf:"bytes,5,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Recipient   string         `protobuf:"bytes,6,opt,name=Recipient,proto3" json:"Recipient,omitempty"`
+	Subject     string         `protobuf:"bytes,7,opt,name=Subject,proto3" json:"Subject,omitempty"`
+	Status      DeliveryStatus `protobuf:"varint,8,opt,name=Status,proto3,enum=train_ticketing.DeliveryStatus" json:"Status,omitempty"`
+	Attempts    int32          `protobuf:"varint,9,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	LastError   string         `protobuf:"bytes,10,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	CreatedOn   string         `protobuf:"bytes,11,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+	CompletedOn string         `protobuf:"bytes,12,opt,name=CompletedOn,proto3" json:"CompletedOn,omitempty"`
+}
+
+func (x *NotificationDelivery) Reset() {
+	*x = NotificationDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationDelivery) ProtoMessage() {}
+
+func (x *NotificationDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationDelivery.ProtoReflect.Descriptor instead.
+func (*NotificationDelivery) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NotificationDelivery) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetStatus() DeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DeliveryStatus_DELIVERED
+}
+
+func (x *NotificationDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *NotificationDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+func (x *NotificationDelivery) GetCompletedOn() string {
+	if x != nil {
+		return x.CompletedOn
+	}
+	return ""
+}
+
+type NotificationLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deliveries []*NotificationDelivery `protobuf:"bytes,1,rep,name=Deliveries,proto3" json:"Deliveries,omitempty"`
+}
+
+func (x *NotificationLog) Reset() {
+	*x = NotificationLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationLog) ProtoMessage() {}
+
+func (x *NotificationLog) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationLog.ProtoReflect.Descriptor instead.
+func (*NotificationLog) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NotificationLog) GetDeliveries() []*NotificationDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// Message for a partner endpoint that receives signed event payloads.
+type WebhookSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionID string `protobuf:"bytes,1,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty"`
+	URL            string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	// Event types such as ticket.booked, or a prefix wildcard such as ticket.*; * for all.
+	EventTypes []string `protobuf:"bytes,3,rep,name=EventTypes,proto3" json:"EventTypes,omitempty"`
+	// Only events about these users, all events when empty. Section events are not sent
+	// to subscriptions limited to users.
+	UserIDs      []string `protobuf:"bytes,4,rep,name=UserIDs,proto3" json:"UserIDs,omitempty"`
+	Description  string   `protobuf:"bytes,5,opt,name=Description,proto3" json:"Description,omitempty"`
+	CreatedOn    string   `protobuf:"bytes,6,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+	Delivered    int64    `protobuf:"varint,7,opt,name=Delivered,proto3" json:"Delivered,omitempty"`
+	DeadLettered int64    `protobuf:"varint,8,opt,name=DeadLettered,proto3" json:"DeadLettered,omitempty"`
+	LastError    string   `protobuf:"bytes,9,opt,name=LastError,proto3" json:"LastError,omitempty"`
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *WebhookSubscription) GetSubscriptionID() string {
+	if x != nil {
+		return x.SubscriptionID
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetDelivered() int64 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetDeadLettered() int64 {
+	if x != nil {
+		return x.DeadLettered
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	URL        string   `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	EventTypes []string `protobuf:"bytes,2,rep,name=EventTypes,proto3" json:"EventTypes,omitempty"`
+	UserIDs    []string `protobuf:"bytes,3,rep,name=UserIDs,proto3" json:"UserIDs,omitempty"`
+	// Shared secret for the X-Ticketbook-Signature HMAC; at least 16 bytes, never returned.
+	Secret      string `protobuf:"bytes,4,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	Description string `protobuf:"bytes,5,opt,name=Description,proto3" json:"Description,omitempty"`
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type AllWebhookSubscriptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=Subscriptions,proto3" json:"Subscriptions,omitempty"`
+}
+
+func (x *AllWebhookSubscriptions) Reset() {
+	*x = AllWebhookSubscriptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllWebhookSubscriptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllWebhookSubscriptions) ProtoMessage() {}
+
+func (x *AllWebhookSubscriptions) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllWebhookSubscriptions.ProtoReflect.Descriptor instead.
+func (*AllWebhookSubscriptions) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *AllWebhookSubscriptions) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type WebhookSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionID string `protobuf:"bytes,1,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty"`
+}
+
+func (x *WebhookSubscriptionRequest) Reset() {
+	*x = WebhookSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *WebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*WebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *WebhookSubscriptionRequest) GetSubscriptionID() string {
+	if x != nil {
+		return x.SubscriptionID
+	}
+	return ""
+}
+
+// Message for an event that could not be delivered to a subscription.
+type DeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetterID   string `protobuf:"bytes,1,opt,name=DeadLetterID,proto3" json:"DeadLetterID,omitempty"`
+	SubscriptionID string `protobuf:"bytes,2,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty"`
+	EventID        string `protobuf:"bytes,3,opt,name=EventID,proto3" json:"EventID,omitempty"`
+	Event          string `protobuf:"bytes,4,opt,name=Event,proto3" json:"Event,omitempty"`
+	Payload        []byte `protobuf:"bytes,5,opt,name=Payload,proto3" json:"Payload,omitempty"` // the JSON body that was sent
+	Attempts       int32  `protobuf:"varint,6,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
+	LastError      string `protobuf:"bytes,7,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	FailedOn       string `protobuf:"bytes,8,opt,name=FailedOn,proto3" json:"FailedOn,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeadLetter) GetDeadLetterID() string {
+	if x != nil {
+		return x.DeadLetterID
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetSubscriptionID() string {
+	if x != nil {
+		return x.SubscriptionID
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadLetter) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetFailedOn() string {
+	if x != nil {
+		return x.FailedOn
+	}
+	return ""
+}
+
+type DeadLetters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetters []*DeadLetter `protobuf:"bytes,1,rep,name=DeadLetters,proto3" json:"DeadLetters,omitempty"`
+}
+
+func (x *DeadLetters) Reset() {
+	*x = DeadLetters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetters) ProtoMessage() {}
+
+func (x *DeadLetters) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetters.ProtoReflect.Descriptor instead.
+func (*DeadLetters) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeadLetters) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+type ReplayDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionID string   `protobuf:"bytes,1,opt,name=SubscriptionID,proto3" json:"SubscriptionID,omitempty"`
+	DeadLetterIDs  []string `protobuf:"bytes,2,rep,name=DeadLetterIDs,proto3" json:"DeadLetterIDs,omitempty"` // all of the subscription's dead letters when empty
+}
+
+func (x *ReplayDeadLettersRequest) Reset() {
+	*x = ReplayDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReplayDeadLettersRequest) GetSubscriptionID() string {
+	if x != nil {
+		return x.SubscriptionID
+	}
+	return ""
+}
+
+func (x *ReplayDeadLettersRequest) GetDeadLetterIDs() []string {
+	if x != nil {
+		return x.DeadLetterIDs
+	}
+	return nil
+}
+
+type ReplayDeadLettersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Replayed int32 `protobuf:"varint,1,opt,name=Replayed,proto3" json:"Replayed,omitempty"`
+}
+
+func (x *ReplayDeadLettersResponse) Reset() {
+	*x = ReplayDeadLettersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLettersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLettersResponse) ProtoMessage() {}
+
+func (x *ReplayDeadLettersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLettersResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReplayDeadLettersResponse) GetReplayed() int32 {
+	if x != nil {
+		return x.Replayed
+	}
+	return 0
+}
+
+// Message for a change recorded in the outbox together with the mutation that caused it.
+// Exactly one of User, Ticket or Section holds the state after the change.
+type DomainEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence int64  `protobuf:"varint,1,opt,name=Sequence,proto3" json:"Sequence,omitempty"` // position in the outbox, starting at 1 with no gaps
+	EventID  string `protobuf:"bytes,2,opt,name=EventID,proto3" json:"EventID,omitempty"`
+	// UserCreated, UserUpdated, UserRemoved, TicketPurchased, SeatModified, TicketBoarded,
+	// BoardingPassReissued, TicketCancelled or SectionChanged.
+	Type        string   `protobuf:"bytes,3,opt,name=Type,proto3" json:"Type,omitempty"`
+	AggregateID string   `protobuf:"bytes,4,opt,name=AggregateID,proto3" json:"AggregateID,omitempty"` // id of the user, ticket or section that changed
+	OccurredOn  string   `protobuf:"bytes,5,opt,name=OccurredOn,proto3" json:"OccurredOn,omitempty"`   // RFC 3339
+	User        *User    `protobuf:"bytes,6,opt,name=User,proto3" json:"User,omitempty"`
+	Ticket      *Ticket  `protobuf:"bytes,7,opt,name=Ticket,proto3" json:"Ticket,omitempty"`
+	Section     *Section `protobuf:"bytes,8,opt,name=Section,proto3" json:"Section,omitempty"`
+	Change      string   `protobuf:"bytes,9,opt,name=Change,proto3" json:"Change,omitempty"`  // for SectionChanged: created, updated, resized, deleted or disrupted
+	Refund      *Money   `protobuf:"bytes,10,opt,name=Refund,proto3" json:"Refund,omitempty"` // for TicketCancelled
+}
+
+func (x *DomainEvent) Reset() {
+	*x = DomainEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DomainEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DomainEvent) ProtoMessage() {}
+
+func (x *DomainEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DomainEvent.ProtoReflect.Descriptor instead.
+func (*DomainEvent) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DomainEvent) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DomainEvent) GetEventID() string {
+	if x != nil {
+		return x.EventID
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetAggregateID() string {
+	if x != nil {
+		return x.AggregateID
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetOccurredOn() string {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetTicket() *Ticket {
+	if x != nil {
+		return x.Ticket
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetSection() *Section {
+	if x != nil {
+		return x.Section
+	}
+	return nil
+}
+
+func (x *DomainEvent) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+func (x *DomainEvent) GetRefund() *Money {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerID string   `protobuf:"bytes,1,opt,name=ConsumerID,proto3" json:"ConsumerID,omitempty"`
+	EventTypes []string `protobuf:"bytes,2,rep,name=EventTypes,proto3" json:"EventTypes,omitempty"` // all types when empty
+	// Replay from this sequence instead of the consumer's committed offset.
+	FromSequence int64 `protobuf:"varint,3,opt,name=FromSequence,proto3" json:"FromSequence,omitempty"`
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SubscribeEventsRequest) GetConsumerID() string {
+	if x != nil {
+		return x.ConsumerID
+	}
+	return ""
+}
+
+func (x *SubscribeEventsRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) GetFromSequence() int64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+type AckEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerID string `protobuf:"bytes,1,opt,name=ConsumerID,proto3" json:"ConsumerID,omitempty"`
+	Sequence   int64  `protobuf:"varint,2,opt,name=Sequence,proto3" json:"Sequence,omitempty"` // every event up to and including this one has been processed
+}
+
+func (x *AckEventsRequest) Reset() {
+	*x = AckEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AckEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckEventsRequest) ProtoMessage() {}
+
+func (x *AckEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckEventsRequest.ProtoReflect.Descriptor instead.
+func (*AckEventsRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AckEventsRequest) GetConsumerID() string {
+	if x != nil {
+		return x.ConsumerID
+	}
+	return ""
+}
+
+func (x *AckEventsRequest) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// Message for a consumer's committed position in the outbox.
+type EventConsumer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConsumerID string `protobuf:"bytes,1,opt,name=ConsumerID,proto3" json:"ConsumerID,omitempty"`
+	Offset     int64  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"` // last acknowledged sequence, 0 before the first ack
+	Lag        int64  `protobuf:"varint,3,opt,name=Lag,proto3" json:"Lag,omitempty"`       // events recorded after the offset
+	CreatedOn  string `protobuf:"bytes,4,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+	ModifiedOn string `protobuf:"bytes,5,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
+}
+
+func (x *EventConsumer) Reset() {
+	*x = EventConsumer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventConsumer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventConsumer) ProtoMessage() {}
+
+func (x *EventConsumer) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventConsumer.ProtoReflect.Descriptor instead.
+func (*EventConsumer) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *EventConsumer) GetConsumerID() string {
+	if x != nil {
+		return x.ConsumerID
+	}
+	return ""
+}
+
+func (x *EventConsumer) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *EventConsumer) GetLag() int64 {
+	if x != nil {
+		return x.Lag
+	}
+	return 0
+}
+
+func (x *EventConsumer) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+func (x *EventConsumer) GetModifiedOn() string {
+	if x != nil {
+		return x.ModifiedOn
+	}
+	return ""
+}
+
+type EventConsumers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Consumers      []*EventConsumer `protobuf:"bytes,1,rep,name=Consumers,proto3" json:"Consumers,omitempty"`
+	LatestSequence int64            `protobuf:"varint,2,opt,name=LatestSequence,proto3" json:"LatestSequence,omitempty"`
+}
+
+func (x *EventConsumers) Reset() {
+	*x = EventConsumers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventConsumers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventConsumers) ProtoMessage() {}
+
+func (x *EventConsumers) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventConsumers.ProtoReflect.Descriptor instead.
+func (*EventConsumers) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *EventConsumers) GetConsumers() []*EventConsumer {
+	if x != nil {
+		return x.Consumers
+	}
+	return nil
+}
+
+func (x *EventConsumers) GetLatestSequence() int64 {
+	if x != nil {
+		return x.LatestSequence
+	}
+	return 0
+}
+
+// Message for asking what the bookings were at a point in the past. Give At or Sequence;
+// the latest state is returned when both are empty.
+type StateAtRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	At       string `protobuf:"bytes,1,opt,name=At,proto3" json:"At,omitempty"`              // RFC 3339
+	Sequence int64  `protobuf:"varint,2,opt,name=Sequence,proto3" json:"Sequence,omitempty"` // the state right after this event
+}
+
+func (x *StateAtRequest) Reset() {
+	*x = StateAtRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateAtRequest) ProtoMessage() {}
+
+func (x *StateAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateAtRequest.ProtoReflect.Descriptor instead.
+func (*StateAtRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *StateAtRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *StateAtRequest) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+// Message for the users, sections and tickets as they were after an event.
+type BookingState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence int64      `protobuf:"varint,1,opt,name=Sequence,proto3" json:"Sequence,omitempty"` // last event applied, 0 before the first
+	At       string     `protobuf:"bytes,2,opt,name=At,proto3" json:"At,omitempty"`              // when that event occurred
+	Users    []*User    `protobuf:"bytes,3,rep,name=Users,proto3" json:"Users,omitempty"`
+	Sections []*Section `protobuf:"bytes,4,rep,name=Sections,proto3" json:"Sections,omitempty"` // AvailableSeats counts seats not allocated to a ticket
+	Tickets  []*Ticket  `protobuf:"bytes,5,rep,name=Tickets,proto3" json:"Tickets,omitempty"`
+}
+
+func (x *BookingState) Reset() {
+	*x = BookingState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookingState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingState) ProtoMessage() {}
+
+func (x *BookingState) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingState.ProtoReflect.Descriptor instead.
+func (*BookingState) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *BookingState) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *BookingState) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *BookingState) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BookingState) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *BookingState) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+type SeatOccupancyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	At         string `protobuf:"bytes,1,opt,name=At,proto3" json:"At,omitempty"`                  // RFC 3339, now when empty
+	SectionID  string `protobuf:"bytes,2,opt,name=SectionID,proto3" json:"SectionID,omitempty"`    // every section when empty
+	SeatNumber int32  `protobuf:"varint,3,opt,name=SeatNumber,proto3" json:"SeatNumber,omitempty"` // every seat when 0
+}
+
+func (x *SeatOccupancyRequest) Reset() {
+	*x = SeatOccupancyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatOccupancyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatOccupancyRequest) ProtoMessage() {}
+
+func (x *SeatOccupancyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatOccupancyRequest.ProtoReflect.Descriptor instead.
+func (*SeatOccupancyRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SeatOccupancyRequest) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *SeatOccupancyRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *SeatOccupancyRequest) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+type SeatOccupant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID  string `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+	Section    string `protobuf:"bytes,2,opt,name=Section,proto3" json:"Section,omitempty"`
+	SeatNumber int32  `protobuf:"varint,3,opt,name=SeatNumber,proto3" json:"SeatNumber,omitempty"`
+	UserID     string `protobuf:"bytes,4,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	Passenger  string `protobuf:"bytes,5,opt,name=Passenger,proto3" json:"Passenger,omitempty"`
+	TicketId   string `protobuf:"bytes,6,opt,name=TicketId,proto3" json:"TicketId,omitempty"`
+	Companion  bool   `protobuf:"varint,7,opt,name=Companion,proto3" json:"Companion,omitempty"` // a companion seat booked with the ticket
+}
+
+func (x *SeatOccupant) Reset() {
+	*x = SeatOccupant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatOccupant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatOccupant) ProtoMessage() {}
+
+func (x *SeatOccupant) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatOccupant.ProtoReflect.Descriptor instead.
+func (*SeatOccupant) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SeatOccupant) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+func (x *SeatOccupant) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *SeatOccupant) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *SeatOccupant) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SeatOccupant) GetPassenger() string {
+	if x != nil {
+		return x.Passenger
+	}
+	return ""
+}
+
+func (x *SeatOccupant) GetTicketId() string {
+	if x != nil {
+		return x.TicketId
+	}
+	return ""
+}
+
+func (x *SeatOccupant) GetCompanion() bool {
+	if x != nil {
+		return x.Companion
+	}
+	return false
+}
+
+type SeatOccupancy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence  int64           `protobuf:"varint,1,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	At        string          `protobuf:"bytes,2,opt,name=At,proto3" json:"At,omitempty"`
+	Occupants []*SeatOccupant `protobuf:"bytes,3,rep,name=Occupants,proto3" json:"Occupants,omitempty"`
+}
+
+func (x *SeatOccupancy) Reset() {
+	*x = SeatOccupancy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatOccupancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatOccupancy) ProtoMessage() {}
+
+func (x *SeatOccupancy) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatOccupancy.ProtoReflect.Descriptor instead.
+func (*SeatOccupancy) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SeatOccupancy) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SeatOccupancy) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *SeatOccupancy) GetOccupants() []*SeatOccupant {
+	if x != nil {
+		return x.Occupants
+	}
+	return nil
+}
+
+// Message for one record of the server's write-ahead log: the events recorded and the other
+// state changed since the previous record. Not part of the API.
+type WALRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events  []*DomainEvent   `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
+	Entries []*StateEntry    `protobuf:"bytes,2,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	Base    *BookingSnapshot `protobuf:"bytes,3,opt,name=Base,proto3" json:"Base,omitempty"` // part of the booking state the retained events start from
+}
+
+func (x *WALRecord) Reset() {
+	*x = WALRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WALRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WALRecord) ProtoMessage() {}
+
+func (x *WALRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WALRecord.ProtoReflect.Descriptor instead.
+func (*WALRecord) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *WALRecord) GetEvents() []*DomainEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WALRecord) GetEntries() []*StateEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *WALRecord) GetBase() *BookingSnapshot {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+// Message for the users, sections and tickets right after the event with the given Sequence,
+// stored in place of the events before it that are no longer retained. A large snapshot is
+// split across the records of a compaction, each carrying the same Sequence. Not part of the API.
+type BookingSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sequence   int64      `protobuf:"varint,1,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	OccurredOn string     `protobuf:"bytes,2,opt,name=OccurredOn,proto3" json:"OccurredOn,omitempty"`
+	Users      []*User    `protobuf:"bytes,3,rep,name=Users,proto3" json:"Users,omitempty"`
+	Sections   []*Section `protobuf:"bytes,4,rep,name=Sections,proto3" json:"Sections,omitempty"`
+	Tickets    []*Ticket  `protobuf:"bytes,5,rep,name=Tickets,proto3" json:"Tickets,omitempty"`
+}
+
+func (x *BookingSnapshot) Reset() {
+	*x = BookingSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BookingSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BookingSnapshot) ProtoMessage() {}
+
+func (x *BookingSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BookingSnapshot.ProtoReflect.Descriptor instead.
+func (*BookingSnapshot) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *BookingSnapshot) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *BookingSnapshot) GetOccurredOn() string {
+	if x != nil {
+		return x.OccurredOn
+	}
+	return ""
+}
+
+func (x *BookingSnapshot) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *BookingSnapshot) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *BookingSnapshot) GetTickets() []*Ticket {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// Message for the new value of one entry of server state not described by domain events.
+// Exactly one of the value fields is set, unless Deleted.
+type StateEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// block, hold, promotion, redemption, ledger, exchange_rate, invoice, user_invoices,
+	// counter, consumer, webhook or dead_letter
+	Table          string               `protobuf:"bytes,1,opt,name=Table,proto3" json:"Table,omitempty"`
+	Key            string               `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	Deleted        bool                 `protobuf:"varint,3,opt,name=Deleted,proto3" json:"Deleted,omitempty"`
+	Block          *SeatBlock           `protobuf:"bytes,4,opt,name=Block,proto3" json:"Block,omitempty"`
+	Hold           *Hold                `protobuf:"bytes,5,opt,name=Hold,proto3" json:"Hold,omitempty"`
+	Promotion      *Promotion           `protobuf:"bytes,6,opt,name=Promotion,proto3" json:"Promotion,omitempty"`
+	Ledger         []*LedgerEntry       `protobuf:"bytes,7,rep,name=Ledger,proto3" json:"Ledger,omitempty"`
+	ExchangeRate   *ExchangeRate        `protobuf:"bytes,8,opt,name=ExchangeRate,proto3" json:"ExchangeRate,omitempty"`
+	Invoice        *Invoice             `protobuf:"bytes,9,opt,name=Invoice,proto3" json:"Invoice,omitempty"`
+	Consumer       *EventConsumer       `protobuf:"bytes,10,opt,name=Consumer,proto3" json:"Consumer,omitempty"`
+	UserID         string               `protobuf:"bytes,11,opt,name=UserID,proto3" json:"UserID,omitempty"`                 // for redemption, with Count
+	Count          int64                `protobuf:"varint,12,opt,name=Count,proto3" json:"Count,omitempty"`                  // for redemption, counter and dead_letter
+	InvoiceNumbers []string             `protobuf:"bytes,13,rep,name=InvoiceNumbers,proto3" json:"InvoiceNumbers,omitempty"` // for user_invoices, in the order issued
+	Webhook        *WebhookSubscription `protobuf:"bytes,14,opt,name=Webhook,proto3" json:"Webhook,omitempty"`
+	WebhookSecret  string               `protobuf:"bytes,15,opt,name=WebhookSecret,proto3" json:"WebhookSecret,omitempty"` // for webhook, to sign deliveries after a restart
+	DeadLetter     *DeadLetter          `protobuf:"bytes,16,opt,name=DeadLetter,proto3" json:"DeadLetter,omitempty"`       // with Count, its position in the dead-letter queue
+}
+
+func (x *StateEntry) Reset() {
+	*x = StateEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateEntry) ProtoMessage() {}
+
+func (x *StateEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateEntry.ProtoReflect.Descriptor instead.
+func (*StateEntry) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StateEntry) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *StateEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StateEntry) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *StateEntry) GetBlock() *SeatBlock {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *StateEntry) GetHold() *Hold {
+	if x != nil {
+		return x.Hold
+	}
+	return nil
+}
+
+func (x *StateEntry) GetPromotion() *Promotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+func (x *StateEntry) GetLedger() []*LedgerEntry {
+	if x != nil {
+		return x.Ledger
+	}
+	return nil
+}
+
+func (x *StateEntry) GetExchangeRate() *ExchangeRate {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return nil
+}
+
+func (x *StateEntry) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+func (x *StateEntry) GetConsumer() *EventConsumer {
+	if x != nil {
+		return x.Consumer
+	}
+	return nil
+}
+
+func (x *StateEntry) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *StateEntry) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StateEntry) GetInvoiceNumbers() []string {
+	if x != nil {
+		return x.InvoiceNumbers
+	}
+	return nil
+}
+
+func (x *StateEntry) GetWebhook() *WebhookSubscription {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *StateEntry) GetWebhookSecret() string {
+	if x != nil {
+		return x.WebhookSecret
+	}
+	return ""
+}
+
+func (x *StateEntry) GetDeadLetter() *DeadLetter {
+	if x != nil {
+		return x.DeadLetter
+	}
+	return nil
+}
+
+type Receipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From          string            `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string            `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	User          *User             `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	PricePaid     *Money            `protobuf:"bytes,16,opt,name=price_paid,json=pricePaid,proto3" json:"price_paid,omitempty"`
+	Section       string            `protobuf:"bytes,5,opt,name=section,proto3" json:"section,omitempty"`
+	SeatNumber    int32             `protobuf:"varint,6,opt,name=seat_number,json=seatNumber,proto3" json:"seat_number,omitempty"`
+	CreatedOn     string            `protobuf:"bytes,7,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+	ModifiedOn    string            `protobuf:"bytes,8,opt,name=ModifiedOn,proto3" json:"ModifiedOn,omitempty"`
+	Class         SeatClass         `protobuf:"varint,9,opt,name=Class,proto3,enum=train_ticketing.SeatClass" json:"Class,omitempty"`
+	Amenities     []Amenity         `protobuf:"varint,10,rep,packed,name=Amenities,proto3,enum=train_ticketing.Amenity" json:"Amenities,omitempty"`
+	CompanionSeat int32             `protobuf:"varint,11,opt,name=CompanionSeat,proto3" json:"CompanionSeat,omitempty"`
+	Category      PassengerCategory `protobuf:"varint,12,opt,name=Category,proto3,enum=train_ticketing.PassengerCategory" json:"Category,omitempty"`
+	FareBreakdown []*FareLine       `protobuf:"bytes,13,rep,name=FareBreakdown,proto3" json:"FareBreakdown,omitempty"`
+	// Set when a concession was applied and the passenger's ID must be checked.
+	CheckID       bool   `protobuf:"varint,14,opt,name=CheckID,proto3" json:"CheckID,omitempty"`
+	PromoCode     string `protobuf:"bytes,15,opt,name=PromoCode,proto3" json:"PromoCode,omitempty"`
+	InvoiceNumber string `protobuf:"bytes,17,opt,name=InvoiceNumber,proto3" json:"InvoiceNumber,omitempty"`
+	BoardingPass  string `protobuf:"bytes,18,opt,name=BoardingPass,proto3" json:"BoardingPass,omitempty"`
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Receipt) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Receipt) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *Receipt) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Receipt) GetPricePaid() *Money {
+	if x != nil {
+		return x.PricePaid
+	}
+	return nil
+}
+
+func (x *Receipt) GetSection() string {
+	if x != nil {
+		return x.Section
+	}
+	return ""
+}
+
+func (x *Receipt) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *Receipt) GetCreatedOn() string {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return ""
+}
+
+func (x *Receipt) GetModifiedOn() string {
+	if x != nil {
+		return x.ModifiedOn
+	}
+	return ""
+}
+
+func (x *Receipt) GetClass() SeatClass {
+	if x != nil {
+		return x.Class
+	}
+	return SeatClass_STANDARD
+}
+
+func (x *Receipt) GetAmenities() []Amenity {
+	if x != nil {
+		return x.Amenities
+	}
+	return nil
+}
+
+func (x *Receipt) GetCompanionSeat() int32 {
+	if x != nil {
+		return x.CompanionSeat
+	}
+	return 0
+}
+
+func (x *Receipt) GetCategory() PassengerCategory {
+	if x != nil {
+		return x.Category
+	}
+	return PassengerCategory_ADULT
+}
+
+func (x *Receipt) GetFareBreakdown() []*FareLine {
+	if x != nil {
+		return x.FareBreakdown
+	}
+	return nil
+}
+
+func (x *Receipt) GetCheckID() bool {
+	if x != nil {
+		return x.CheckID
+	}
+	return false
+}
+
+func (x *Receipt) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *Receipt) GetInvoiceNumber() string {
+	if x != nil {
+		return x.InvoiceNumber
+	}
+	return ""
+}
+
+func (x *Receipt) GetBoardingPass() string {
+	if x != nil {
+		return x.BoardingPass
+	}
+	return ""
+}
+
+type AllSections struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sections []*Section `protobuf:"bytes,1,rep,name=sections,proto3" json:"sections,omitempty"`
+}
+
+func (x *AllSections) Reset() {
+	*x = AllSections{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllSections) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllSections) ProtoMessage() {}
+
+func (x *AllSections) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllSections.ProtoReflect.Descriptor instead.
+func (*AllSections) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AllSections) GetSections() []*Section {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+type AllUsers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *AllUsers) Reset() {
+	*x = AllUsers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllUsers) ProtoMessage() {}
+
+func (x *AllUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllUsers.ProtoReflect.Descriptor instead.
+func (*AllUsers) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AllUsers) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Message for representing seat allocation
+type SeatDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserName    string `protobuf:"bytes,1,opt,name=UserName,proto3" json:"UserName,omitempty"`
+	Email       string `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	SeatNumber  int32  `protobuf:"varint,3,opt,name=SeatNumber,proto3" json:"SeatNumber,omitempty"`
+	Blocked     bool   `protobuf:"varint,4,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+	BlockReason string `protobuf:"bytes,5,opt,name=BlockReason,proto3" json:"BlockReason,omitempty"`
+}
+
+func (x *SeatDetails) Reset() {
+	*x = SeatDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatDetails) ProtoMessage() {}
+
+func (x *SeatDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatDetails.ProtoReflect.Descriptor instead.
+func (*SeatDetails) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SeatDetails) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *SeatDetails) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SeatDetails) GetSeatNumber() int32 {
+	if x != nil {
+		return x.SeatNumber
+	}
+	return 0
+}
+
+func (x *SeatDetails) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *SeatDetails) GetBlockReason() string {
+	if x != nil {
+		return x.BlockReason
+	}
+	return ""
+}
+
+type SeatAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tickets []*SeatDetails `protobuf:"bytes,1,rep,name=tickets,proto3" json:"tickets,omitempty"`
+}
+
+func (x *SeatAllocation) Reset() {
+	*x = SeatAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeatAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeatAllocation) ProtoMessage() {}
+
+func (x *SeatAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeatAllocation.ProtoReflect.Descriptor instead.
+func (*SeatAllocation) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SeatAllocation) GetTickets() []*SeatDetails {
+	if x != nil {
+		return x.Tickets
+	}
+	return nil
+}
+
+// Boolean message type
+type Bool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Bool) Reset() {
+	*x = Bool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bool) ProtoMessage() {}
+
+func (x *Bool) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bool.ProtoReflect.Descriptor instead.
+func (*Bool) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *Bool) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+type UseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+}
+
+func (x *UseRequest) Reset() {
+	*x = UseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UseRequest) ProtoMessage() {}
+
+func (x *UseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UseRequest.ProtoReflect.Descriptor instead.
+func (*UseRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UseRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type CancelReceiptRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         string `protobuf:"bytes,1,opt,name=UserID,proto3" json:"UserID,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=IdempotencyKey,proto3" json:"IdempotencyKey,omitempty"`
+}
+
+func (x *CancelReceiptRequest) Reset() {
+	*x = CancelReceiptRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReceiptRequest) ProtoMessage() {}
+
+func (x *CancelReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReceiptRequest.ProtoReflect.Descriptor instead.
+func (*CancelReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CancelReceiptRequest) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CancelReceiptRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type SectionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SectionID string `protobuf:"bytes,1,opt,name=SectionID,proto3" json:"SectionID,omitempty"`
+}
+
+func (x *SectionRequest) Reset() {
+	*x = SectionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SectionRequest) ProtoMessage() {}
+
+func (x *SectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SectionRequest.ProtoReflect.Descriptor instead.
+func (*SectionRequest) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SectionRequest) GetSectionID() string {
+	if x != nil {
+		return x.SectionID
+	}
+	return ""
+}
+
+// Empty response message.
+type EmptyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyResponse) Reset() {
+	*x = EmptyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ticket_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyResponse) ProtoMessage() {}
+
+func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ticket_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return file_ticket_proto_rawDescGZIP(), []int{81}
+}
+
+var File_ticket_proto protoreflect.FileDescriptor
+
+var file_ticket_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22,
+	0xd4, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x65,
+	0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
+	0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6f,
+	0x6e, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x43, 0x61, 0x72, 0x64, 0x49, 0x44, 0x22, 0x99, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x0e,
+	0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x4f, 0x66, 0x42, 0x69,
+	0x72, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x4f,
+	0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x72, 0x64, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x72, 0x64,
+	0x49, 0x44, 0x22, 0x4b, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x22,
+	0x62, 0x0a, 0x08, 0x46, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08,
+	0x02, 0x10, 0x03, 0x22, 0xb3, 0x06, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
+	0x70, 0x61, 0x69, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65,
+	0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x4f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x65, 0x65, 0x64,
+	0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65,
+	0x6e, 0x67, 0x65, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x0d, 0x46, 0x61, 0x72, 0x65, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x46, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0d, 0x46, 0x61, 0x72, 0x65, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x61, 0x72, 0x6e, 0x65,
+	0x64, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0xac, 0x03, 0x0a, 0x0d, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x49, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x65, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x52, 0x65,
+	0x64, 0x65, 0x65, 0x6d, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x6f,
+	0x6c, 0x64, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x6f, 0x6c, 0x64,
+	0x49, 0x44, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0xca, 0x03, 0x0a, 0x04, 0x48, 0x6f, 0x6c,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x48, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x30, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x52, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x4e, 0x65, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x46, 0x61, 0x72, 0x65, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x46, 0x61,
+	0x72, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x46, 0x61, 0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x61, 0x72, 0x65,
+	0x4c, 0x69, 0x6e, 0x65, 0x52, 0x0d, 0x46, 0x61, 0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64,
+	0x6f, 0x77, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x4a,
+	0x04, 0x08, 0x0a, 0x10, 0x0b, 0x22, 0x3d, 0x0a, 0x0b, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x6f, 0x6c, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x22, 0x81, 0x04, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x41, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x52, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x41, 0x6d,
+	0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x09, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x61,
+	0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x43,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x36, 0x0a,
+	0x09, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x09, 0x41, 0x6d, 0x65, 0x6e,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9a, 0x02,
+	0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x52, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x41, 0x6d,
+	0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x52, 0x09, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0f, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x14, 0x52,
+	0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xa8, 0x02, 0x0a,
+	0x0e, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x38, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54,
+	0x6f, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x54, 0x6f, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x6f, 0x53,
+	0x65, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x6f, 0x53, 0x65, 0x61,
+	0x74, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e,
+	0x64, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x22, 0x84, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x32, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x54,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xa9,
+	0x01, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65,
+	0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x22, 0x95, 0x01, 0x0a, 0x11, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0b, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x22, 0x7f, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x35, 0x0a, 0x09,
+	0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x13, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x74,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0b, 0x53,
+	0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xc6, 0x03, 0x0a, 0x09, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x2e, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x1e, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4d, 0x61, 0x78,
+	0x55, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x4a, 0x04, 0x08,
+	0x03, 0x10, 0x04, 0x22, 0xf7, 0x02, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x42, 0x61, 0x73, 0x69,
+	0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x06, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x46, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x54,
+	0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x22, 0x26, 0x0a,
+	0x10, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x4b, 0x0a, 0x0d, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x85, 0x02, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x53,
+	0x70, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x4f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x4f, 0x6e, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0xee, 0x01, 0x0a, 0x0e, 0x4c,
+	0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x0a,
+	0x04, 0x54, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x6f,
+	0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x65, 0x72, 0x52, 0x04, 0x54, 0x69, 0x65, 0x72, 0x12,
+	0x3c, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d,
+	0x54, 0x72, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x36, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x64, 0x0a, 0x0c, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4f,
+	0x6e, 0x22, 0x35, 0x0a, 0x0f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x68, 0x0a, 0x0d, 0x45, 0x78, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x42, 0x61, 0x73,
+	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x42, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x33, 0x0a,
+	0x05, 0x72, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x05, 0x72, 0x61, 0x74,
+	0x65, 0x73, 0x22, 0x4c, 0x0a, 0x06, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61,
+	0x78, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x78, 0x49, 0x44,
+	0x22, 0xb1, 0x01, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4c, 0x69, 0x6e, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x03, 0x4e, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x4e, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x03,
+	0x54, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x03, 0x54, 0x61, 0x78, 0x12, 0x2c, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x47,
+	0x72, 0x6f, 0x73, 0x73, 0x22, 0x77, 0x0a, 0x07, 0x54, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x52, 0x61, 0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x52, 0x61,
+	0x74, 0x65, 0x42, 0x61, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x03, 0x54, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x03, 0x54, 0x61, 0x78, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0xbc, 0x04,
+	0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x30, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x34, 0x0a, 0x15, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x4f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65,
+	0x64, 0x4f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x06, 0x53, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x42, 0x75, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x42, 0x75, 0x79, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x42, 0x75,
+	0x79, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x42, 0x75, 0x79, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4c,
+	0x69, 0x6e, 0x65, 0x52, 0x05, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x54, 0x61,
+	0x78, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x61, 0x78, 0x4c,
+	0x69, 0x6e, 0x65, 0x52, 0x05, 0x54, 0x61, 0x78, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x03, 0x4e, 0x65,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x03, 0x4e, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x54, 0x61, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x03, 0x54, 0x61, 0x78, 0x12, 0x2c,
+	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x05, 0x47, 0x72, 0x6f, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x0e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x0b,
+	0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x22, 0x66, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x36, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x36, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa7, 0x01,
+	0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x49,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x0f, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65,
+	0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44,
+	0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x10, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x4b, 0x65,
+	0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x4b, 0x65, 0x79, 0x73,
+	0x22, 0x31, 0x0a, 0x19, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xb9, 0x02, 0x0a, 0x18, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x73, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4b,
+	0x65, 0x79, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x44,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xbe, 0x01, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x4f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x22, 0x51, 0x0a, 0x0f, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x6e,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xdc, 0x02, 0x0a, 0x0d, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50,
+	0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61,
+	0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x74, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x4c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x4e, 0x65, 0x65, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x08, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x45, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12,
+	0x3e, 0x0a, 0x0a, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0a, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x22,
+	0xfa, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x33, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x62,
+	0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xa7, 0x03, 0x0a,
+	0x13, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x73, 0x73,
+	0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x73,
+	0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x3c, 0x0a, 0x07,
+	0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x52, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72,
+	0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x72, 0x6f, 0x6d,
+	0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x54, 0x6f, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x54, 0x6f, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x54, 0x6f, 0x53, 0x65, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x54, 0x6f,
+	0x53, 0x65, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x6f, 0x44, 0x65, 0x70,
+	0x61, 0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x22, 0xad, 0x02, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x72, 0x75,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x72,
+	0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44,
+	0x0a, 0x0a, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x44, 0x69,
+	0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22, 0xf3, 0x02, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x22, 0x58, 0x0a, 0x0f,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x12,
+	0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x0a, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
+	0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x4f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0xa8, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a,
+	0x17, 0x41, 0x6c, 0x6c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x44, 0x0a, 0x1a, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xf8, 0x01, 0x0a, 0x0a, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a,
+	0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x4c,
+	0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x4c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x4f, 0x6e, 0x22, 0x4c, 0x0a, 0x0b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x68, 0x0a, 0x18, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x37, 0x0a,
+	0x19, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x22, 0xf1, 0x02, 0x0a, 0x0b, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x4f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x4f, 0x6e, 0x12, 0x29, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65,
+	0x66, 0x75, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x06, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x22, 0x7c, 0x0a, 0x16, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x46, 0x72, 0x6f, 0x6d,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x4e, 0x0a, 0x10, 0x41, 0x63, 0x6b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x4c, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x4f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x4f, 0x6e, 0x22, 0x76, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x09, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65,
+	0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x4c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x42, 0x6f, 0x6f,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x14, 0x53,
+	0x65, 0x61, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x22, 0xd6, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65,
+	0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x22, 0x78, 0x0a, 0x0d, 0x53, 0x65,
+	0x61, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x41, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x09, 0x4f, 0x63, 0x63, 0x75, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61,
+	0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x74, 0x52, 0x09, 0x4f, 0x63, 0x63, 0x75, 0x70,
+	0x61, 0x6e, 0x74, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x09, 0x57, 0x41, 0x4c, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x34, 0x0a, 0x04, 0x42, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x04, 0x42, 0x61, 0x73, 0x65, 0x22, 0xe3, 0x01, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x2b, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x07, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xc7, 0x05, 0x0a, 0x0a,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65,
+	0x61, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x29,
+	0x0a, 0x04, 0x48, 0x6f, 0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x48,
+	0x6f, 0x6c, 0x64, 0x52, 0x04, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0c, 0x45, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x52, 0x0c,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x07,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x12, 0x3a, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d,
+	0x65, 0x72, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x12, 0x24, 0x0a, 0x0d, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x22, 0xa1, 0x05, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e,
+	0x12, 0x30, 0x0a, 0x05, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x05, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x52,
+	0x09, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x61, 0x74,
+	0x12, 0x3e, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x12, 0x3f, 0x0a, 0x0d, 0x46, 0x61, 0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x61, 0x72, 0x65, 0x4c, 0x69,
+	0x6e, 0x65, 0x52, 0x0d, 0x46, 0x61, 0x72, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x64, 0x6f, 0x77,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x44, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x22, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x73, 0x73, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x22, 0x43, 0x0a, 0x0b, 0x41, 0x6c, 0x6c,
+	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x37,
+	0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x05, 0x75, 0x73,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x9b, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x74,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x61,
+	0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53,
+	0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x48, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x74, 0x41, 0x6c, 0x6c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22,
+	0x1c, 0x0a, 0x04, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2a, 0x0a,
+	0x0a, 0x55, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x56, 0x0a, 0x14, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x22, 0x2e, 0x0a, 0x0e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x22, 0x0f, 0x0a, 0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2a, 0x50, 0x0a, 0x11, 0x50, 0x61, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x55, 0x4c, 0x54,
+	0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x49, 0x4c, 0x44, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x45, 0x4e, 0x49, 0x4f, 0x52, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x55,
+	0x44, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x2a, 0x3b, 0x0a, 0x09, 0x53, 0x65, 0x61, 0x74, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x46, 0x49, 0x52, 0x53, 0x54, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x51, 0x55,
+	0x49, 0x45, 0x54, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x4d, 0x49, 0x4c, 0x59, 0x10,
+	0x03, 0x2a, 0x4b, 0x0a, 0x07, 0x41, 0x6d, 0x65, 0x6e, 0x69, 0x74, 0x79, 0x12, 0x11, 0x0a, 0x0d,
+	0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x00, 0x12,
+	0x08, 0x0a, 0x04, 0x57, 0x49, 0x46, 0x49, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x48, 0x45,
+	0x45, 0x4c, 0x43, 0x48, 0x41, 0x49, 0x52, 0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x02, 0x12,
+	0x0d, 0x0a, 0x09, 0x42, 0x49, 0x4b, 0x45, 0x5f, 0x52, 0x41, 0x43, 0x4b, 0x10, 0x03, 0x2a, 0x34,
+	0x0a, 0x0d, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x09, 0x0a, 0x05, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45,
+	0x41, 0x53, 0x53, 0x49, 0x47, 0x4e, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x4e, 0x43,
+	0x45, 0x4c, 0x10, 0x02, 0x2a, 0x2e, 0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x4f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x41, 0x53, 0x53, 0x49, 0x47,
+	0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x01, 0x2a, 0x26, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x49, 0x58, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x2f, 0x0a, 0x0b,
+	0x4c, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x79, 0x54, 0x69, 0x65, 0x72, 0x12, 0x0a, 0x0a, 0x06, 0x42,
+	0x52, 0x4f, 0x4e, 0x5a, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4c, 0x56, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x4f, 0x4c, 0x44, 0x10, 0x02, 0x2a, 0x35, 0x0a,
+	0x0f, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x08, 0x0a, 0x04, 0x45, 0x41, 0x52, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45,
+	0x44, 0x45, 0x45, 0x4d, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x56, 0x45, 0x52, 0x53,
+	0x41, 0x4c, 0x10, 0x02, 0x2a, 0x2b, 0x0a, 0x0b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x00,
+	0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x45, 0x10,
+	0x01, 0x2a, 0x2c, 0x0a, 0x0d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x44, 0x46, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48,
+	0x54, 0x4d, 0x4c, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x2a,
+	0x92, 0x01, 0x0a, 0x0f, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x13, 0x0a, 0x0f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x42, 0x4f, 0x41, 0x52,
+	0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x53,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x52, 0x4f, 0x4e,
+	0x47, 0x5f, 0x53, 0x45, 0x41, 0x54, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x52, 0x4f, 0x4e,
+	0x47, 0x5f, 0x54, 0x52, 0x41, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x4f, 0x54,
+	0x5f, 0x52, 0x45, 0x43, 0x4f, 0x47, 0x4e, 0x49, 0x53, 0x45, 0x44, 0x10, 0x05, 0x12, 0x15, 0x0a,
+	0x11, 0x53, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x06, 0x2a, 0x2d, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x4c, 0x41,
+	0x59, 0x10, 0x01, 0x2a, 0x41, 0x0a, 0x0f, 0x52, 0x65, 0x62, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x43,
+	0x4c, 0x41, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4e, 0x59, 0x5f, 0x43, 0x4c,
+	0x41, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x5f,
+	0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x2a, 0x3c, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x42, 0x4f, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x41, 0x59,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0x30, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45,
+	0x52, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x44, 0x45, 0x4c, 0x49, 0x56,
+	0x45, 0x52, 0x45, 0x44, 0x10, 0x01, 0x32, 0xd0, 0x1c, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x69, 0x6e,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0c, 0x56,
+	0x69, 0x65, 0x77, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41,
+	0x6c, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x0e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c, 0x0a,
+	0x0d, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x52, 0x65, 0x73, 0x69, 0x7a, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5c, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x42, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1b,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x55, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x15, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x49, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x0e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x56,
+	0x0a, 0x12, 0x56, 0x69, 0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49,
+	0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x12, 0x22, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x55, 0x0a, 0x0a, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x0c, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73,
+	0x12, 0x24, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53,
+	0x0a, 0x0e, 0x56, 0x69, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x51, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x79, 0x61, 0x6c,
+	0x74, 0x79, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x48, 0x6f, 0x6c,
+	0x64, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x4b, 0x0a, 0x0b,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x48, 0x6f, 0x6c, 0x64, 0x12, 0x1c, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0f, 0x53, 0x65, 0x74,
+	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45,
+	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x1a, 0x1d, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x56, 0x69,
+	0x65, 0x77, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x20, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x6c, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x58, 0x0a, 0x0d, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12,
+	0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74,
+	0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x73, 0x73, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x6b, 0x0a, 0x12, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x12,
+	0x2a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x42, 0x6f,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x73, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x05, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x1d, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x4b, 0x0a, 0x0c, 0x56, 0x69, 0x65, 0x77, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x59, 0x0a, 0x10,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
+	0x6e, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x69, 0x73, 0x72, 0x75, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x52, 0x0a, 0x11, 0x56, 0x69, 0x65, 0x77, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x12, 0x74, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x74, 0x72,
+	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x64, 0x0a, 0x18, 0x56, 0x69, 0x65, 0x77, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x28, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x6c, 0x6c, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x68, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x56, 0x69, 0x65, 0x77, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x2b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x6a, 0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61,
+	0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0f, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x41, 0x63, 0x6b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x12, 0x56, 0x69, 0x65, 0x77, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x1f, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x73, 0x12, 0x4d,
+	0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1f, 0x2e,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x5a, 0x0a,
+	0x11, 0x56, 0x69, 0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e,
+	0x63, 0x79, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74,
+	0x4f, 0x63, 0x63, 0x75, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ticket_proto_rawDescOnce sync.Once
+	file_ticket_proto_rawDescData = file_ticket_proto_rawDesc
+)
+
+func file_ticket_proto_rawDescGZIP() []byte {
+	file_ticket_proto_rawDescOnce.Do(func() {
+		file_ticket_proto_rawDescData = protoimpl.X.CompressGZIP(file_ticket_proto_rawDescData)
+	})
+	return file_ticket_proto_rawDescData
+}
+
+var file_ticket_proto_enumTypes = make([]protoimpl.EnumInfo, 15)
+var file_ticket_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_ticket_proto_goTypes = []interface{}{
+	(PassengerCategory)(0),                   // 0: train_ticketing.PassengerCategory
+	(SeatClass)(0),                           // 1: train_ticketing.SeatClass
+	(Amenity)(0),                             // 2: train_ticketing.Amenity
+	(BookingPolicy)(0),                       // 3: train_ticketing.BookingPolicy
+	(TicketOutcome)(0),                       // 4: train_ticketing.TicketOutcome
+	(DiscountType)(0),                        // 5: train_ticketing.DiscountType
+	(LoyaltyTier)(0),                         // 6: train_ticketing.LoyaltyTier
+	(LedgerEntryType)(0),                     // 7: train_ticketing.LedgerEntryType
+	(InvoiceType)(0),                         // 8: train_ticketing.InvoiceType
+	(ReceiptFormat)(0),                       // 9: train_ticketing.ReceiptFormat
+	(BoardingOutcome)(0),                     // 10: train_ticketing.BoardingOutcome
+	(DisruptionType)(0),                      // 11: train_ticketing.DisruptionType
+	(RebookingPolicy)(0),                     // 12: train_ticketing.RebookingPolicy
+	(DisruptionOutcome)(0),                   // 13: train_ticketing.DisruptionOutcome
+	(DeliveryStatus)(0),                      // 14: train_ticketing.DeliveryStatus
+	(*User)(nil),                             // 15: train_ticketing.User
+	(*CreateUserRequest)(nil),                // 16: train_ticketing.CreateUserRequest
+	(*Money)(nil),                            // 17: train_ticketing.Money
+	(*FareLine)(nil),                         // 18: train_ticketing.FareLine
+	(*Ticket)(nil),                           // 19: train_ticketing.Ticket
+	(*TicketRequest)(nil),                    // 20: train_ticketing.TicketRequest
+	(*Hold)(nil),                             // 21: train_ticketing.Hold
+	(*HoldRequest)(nil),                      // 22: train_ticketing.HoldRequest
+	(*Section)(nil),                          // 23: train_ticketing.Section
+	(*CreateSectionRequest)(nil),             // 24: train_ticketing.CreateSectionRequest
+	(*ModifySectionRequest)(nil),             // 25: train_ticketing.ModifySectionRequest
+	(*ResizeSectionRequest)(nil),             // 26: train_ticketing.ResizeSectionRequest
+	(*DeleteSectionRequest)(nil),             // 27: train_ticketing.DeleteSectionRequest
+	(*AffectedTicket)(nil),                   // 28: train_ticketing.AffectedTicket
+	(*SectionChangeReport)(nil),              // 29: train_ticketing.SectionChangeReport
+	(*SeatBlock)(nil),                        // 30: train_ticketing.SeatBlock
+	(*BlockSeatsRequest)(nil),                // 31: train_ticketing.BlockSeatsRequest
+	(*BlockSeatsResponse)(nil),               // 32: train_ticketing.BlockSeatsResponse
+	(*UnblockSeatsRequest)(nil),              // 33: train_ticketing.UnblockSeatsRequest
+	(*Promotion)(nil),                        // 34: train_ticketing.Promotion
+	(*CreatePromotionRequest)(nil),           // 35: train_ticketing.CreatePromotionRequest
+	(*PromotionRequest)(nil),                 // 36: train_ticketing.PromotionRequest
+	(*AllPromotions)(nil),                    // 37: train_ticketing.AllPromotions
+	(*LedgerEntry)(nil),                      // 38: train_ticketing.LedgerEntry
+	(*LoyaltyBalance)(nil),                   // 39: train_ticketing.LoyaltyBalance
+	(*ExchangeRate)(nil),                     // 40: train_ticketing.ExchangeRate
+	(*CurrencyRequest)(nil),                  // 41: train_ticketing.CurrencyRequest
+	(*ExchangeRates)(nil),                    // 42: train_ticketing.ExchangeRates
+	(*Seller)(nil),                           // 43: train_ticketing.Seller
+	(*InvoiceLine)(nil),                      // 44: train_ticketing.InvoiceLine
+	(*TaxLine)(nil),                          // 45: train_ticketing.TaxLine
+	(*Invoice)(nil),                          // 46: train_ticketing.Invoice
+	(*InvoiceRequest)(nil),                   // 47: train_ticketing.InvoiceRequest
+	(*AllInvoices)(nil),                      // 48: train_ticketing.AllInvoices
+	(*RenderReceiptRequest)(nil),             // 49: train_ticketing.RenderReceiptRequest
+	(*RenderedReceipt)(nil),                  // 50: train_ticketing.RenderedReceipt
+	(*ModifySeatRequest)(nil),                // 51: train_ticketing.ModifySeatRequest
+	(*BoardingPassKey)(nil),                  // 52: train_ticketing.BoardingPassKey
+	(*BoardingPassKeys)(nil),                 // 53: train_ticketing.BoardingPassKeys
+	(*VerifyBoardingPassRequest)(nil),        // 54: train_ticketing.VerifyBoardingPassRequest
+	(*BoardingPassVerification)(nil),         // 55: train_ticketing.BoardingPassVerification
+	(*BoardRequest)(nil),                     // 56: train_ticketing.BoardRequest
+	(*BoardingResult)(nil),                   // 57: train_ticketing.BoardingResult
+	(*ManifestRequest)(nil),                  // 58: train_ticketing.ManifestRequest
+	(*ManifestEntry)(nil),                    // 59: train_ticketing.ManifestEntry
+	(*Manifest)(nil),                         // 60: train_ticketing.Manifest
+	(*DisruptionRequest)(nil),                // 61: train_ticketing.DisruptionRequest
+	(*PassengerDisruption)(nil),              // 62: train_ticketing.PassengerDisruption
+	(*DisruptionReport)(nil),                 // 63: train_ticketing.DisruptionReport
+	(*NotificationDelivery)(nil),             // 64: train_ticketing.NotificationDelivery
+	(*NotificationLog)(nil),                  // 65: train_ticketing.NotificationLog
+	(*WebhookSubscription)(nil),              // 66: train_ticketing.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil), // 67: train_ticketing.CreateWebhookSubscriptionRequest
+	(*AllWebhookSubscriptions)(nil),          // 68: train_ticketing.AllWebhookSubscriptions
+	(*WebhookSubscriptionRequest)(nil),       // 69: train_ticketing.WebhookSubscriptionRequest
+	(*DeadLetter)(nil),                       // 70: train_ticketing.DeadLetter
+	(*DeadLetters)(nil),                      // 71: train_ticketing.DeadLetters
+	(*ReplayDeadLettersRequest)(nil),         // 72: train_ticketing.ReplayDeadLettersRequest
+	(*ReplayDeadLettersResponse)(nil),        // 73: train_ticketing.ReplayDeadLettersResponse
+	(*DomainEvent)(nil),                      // 74: train_ticketing.DomainEvent
+	(*SubscribeEventsRequest)(nil),           // 75: train_ticketing.SubscribeEventsRequest
+	(*AckEventsRequest)(nil),                 // 76: train_ticketing.AckEventsRequest
+	(*EventConsumer)(nil),                    // 77: train_ticketing.EventConsumer
+	(*EventConsumers)(nil),                   // 78: train_ticketing.EventConsumers
+	(*StateAtRequest)(nil),                   // 79: train_ticketing.StateAtRequest
+	(*BookingState)(nil),                     // 80: train_ticketing.BookingState
+	(*SeatOccupancyRequest)(nil),             // 81: train_ticketing.SeatOccupancyRequest
+	(*SeatOccupant)(nil),                     // 82: train_ticketing.SeatOccupant
+	(*SeatOccupancy)(nil),                    // 83: train_ticketing.SeatOccupancy
+	(*WALRecord)(nil),                        // 84: train_ticketing.WALRecord
+	(*BookingSnapshot)(nil),                  // 85: train_ticketing.BookingSnapshot
+	(*StateEntry)(nil),                       // 86: train_ticketing.StateEntry
+	(*Receipt)(nil),                          // 87: train_ticketing.Receipt
+	(*AllSections)(nil),                      // 88: train_ticketing.AllSections
+	(*AllUsers)(nil),                         // 89: train_ticketing.AllUsers
+	(*SeatDetails)(nil),                      // 90: train_ticketing.SeatDetails
+	(*SeatAllocation)(nil),                   // 91: train_ticketing.SeatAllocation
+	(*Bool)(nil),                             // 92: train_ticketing.Bool
+	(*UseRequest)(nil),                       // 93: train_ticketing.UseRequest
+	(*CancelReceiptRequest)(nil),             // 94: train_ticketing.CancelReceiptRequest
+	(*SectionRequest)(nil),                   // 95: train_ticketing.SectionRequest
+	(*EmptyResponse)(nil),                    // 96: train_ticketing.EmptyResponse
+}
+var file_ticket_proto_depIdxs = []int32{
+	0,   // 0: train_ticketing.User.Category:type_name -> train_ticketing.PassengerCategory
+	0,   // 1: train_ticketing.CreateUserRequest.Category:type_name -> train_ticketing.PassengerCategory
+	17,  // 2: train_ticketing.FareLine.Amount:type_name -> train_ticketing.Money
+	17,  // 3: train_ticketing.Ticket.price_paid:type_name -> train_ticketing.Money
+	1,   // 4: train_ticketing.Ticket.Class:type_name -> train_ticketing.SeatClass
+	0,   // 5: train_ticketing.Ticket.Category:type_name -> train_ticketing.PassengerCategory
+	18,  // 6: train_ticketing.Ticket.FareBreakdown:type_name -> train_ticketing.FareLine
+	17,  // 7: train_ticketing.TicketRequest.price_paid:type_name -> train_ticketing.Money
+	1,   // 8: train_ticketing.TicketRequest.Class:type_name -> train_ticketing.SeatClass
+	1,   // 9: train_ticketing.Hold.Class:type_name -> train_ticketing.SeatClass
+	17,  // 10: train_ticketing.Hold.Fare:type_name -> train_ticketing.Money
+	18,  // 11: train_ticketing.Hold.FareBreakdown:type_name -> train_ticketing.FareLine
+	1,   // 12: train_ticketing.Section.Class:type_name -> train_ticketing.SeatClass
+	2,   // 13: train_ticketing.Section.Amenities:type_name -> train_ticketing.Amenity
+	1,   // 14: train_ticketing.CreateSectionRequest.Class:type_name -> train_ticketing.SeatClass
+	2,   // 15: train_ticketing.CreateSectionRequest.Amenities:type_name -> train_ticketing.Amenity
+	1,   // 16: train_ticketing.ModifySectionRequest.Class:type_name -> train_ticketing.SeatClass
+	2,   // 17: train_ticketing.ModifySectionRequest.Amenities:type_name -> train_ticketing.Amenity
+	3,   // 18: train_ticketing.ResizeSectionRequest.Policy:type_name -> train_ticketing.BookingPolicy
+	3,   // 19: train_ticketing.DeleteSectionRequest.Policy:type_name -> train_ticketing.BookingPolicy
+	4,   // 20: train_ticketing.AffectedTicket.Outcome:type_name -> train_ticketing.TicketOutcome
+	17,  // 21: train_ticketing.AffectedTicket.Refund:type_name -> train_ticketing.Money
+	23,  // 22: train_ticketing.SectionChangeReport.section:type_name -> train_ticketing.Section
+	28,  // 23: train_ticketing.SectionChangeReport.tickets:type_name -> train_ticketing.AffectedTicket
+	30,  // 24: train_ticketing.BlockSeatsResponse.blocks:type_name -> train_ticketing.SeatBlock
+	19,  // 25: train_ticketing.BlockSeatsResponse.conflicts:type_name -> train_ticketing.Ticket
+	5,   // 26: train_ticketing.Promotion.Type:type_name -> train_ticketing.DiscountType
+	17,  // 27: train_ticketing.Promotion.Amount:type_name -> train_ticketing.Money
+	5,   // 28: train_ticketing.CreatePromotionRequest.Type:type_name -> train_ticketing.DiscountType
+	17,  // 29: train_ticketing.CreatePromotionRequest.Amount:type_name -> train_ticketing.Money
+	34,  // 30: train_ticketing.AllPromotions.promotions:type_name -> train_ticketing.Promotion
+	7,   // 31: train_ticketing.LedgerEntry.Type:type_name -> train_ticketing.LedgerEntryType
+	17,  // 32: train_ticketing.LedgerEntry.Spend:type_name -> train_ticketing.Money
+	6,   // 33: train_ticketing.LoyaltyBalance.Tier:type_name -> train_ticketing.LoyaltyTier
+	17,  // 34: train_ticketing.LoyaltyBalance.TrailingSpend:type_name -> train_ticketing.Money
+	38,  // 35: train_ticketing.LoyaltyBalance.entries:type_name -> train_ticketing.LedgerEntry
+	40,  // 36: train_ticketing.ExchangeRates.rates:type_name -> train_ticketing.ExchangeRate
+	17,  // 37: train_ticketing.InvoiceLine.Net:type_name -> train_ticketing.Money
+	17,  // 38: train_ticketing.InvoiceLine.Tax:type_name -> train_ticketing.Money
+	17,  // 39: train_ticketing.InvoiceLine.Gross:type_name -> train_ticketing.Money
+	17,  // 40: train_ticketing.TaxLine.Tax:type_name -> train_ticketing.Money
+	8,   // 41: train_ticketing.Invoice.Type:type_name -> train_ticketing.InvoiceType
+	43,  // 42: train_ticketing.Invoice.Seller:type_name -> train_ticketing.Seller
+	44,  // 43: train_ticketing.Invoice.Lines:type_name -> train_ticketing.InvoiceLine
+	45,  // 44: train_ticketing.Invoice.Taxes:type_name -> train_ticketing.TaxLine
+	17,  // 45: train_ticketing.Invoice.Net:type_name -> train_ticketing.Money
+	17,  // 46: train_ticketing.Invoice.Tax:type_name -> train_ticketing.Money
+	17,  // 47: train_ticketing.Invoice.Gross:type_name -> train_ticketing.Money
+	46,  // 48: train_ticketing.AllInvoices.invoices:type_name -> train_ticketing.Invoice
+	9,   // 49: train_ticketing.RenderReceiptRequest.Format:type_name -> train_ticketing.ReceiptFormat
+	9,   // 50: train_ticketing.RenderedReceipt.Format:type_name -> train_ticketing.ReceiptFormat
+	52,  // 51: train_ticketing.BoardingPassKeys.Keys:type_name -> train_ticketing.BoardingPassKey
+	10,  // 52: train_ticketing.BoardingResult.Outcome:type_name -> train_ticketing.BoardingOutcome
+	19,  // 53: train_ticketing.BoardingResult.ticket:type_name -> train_ticketing.Ticket
+	59,  // 54: train_ticketing.Manifest.Passengers:type_name -> train_ticketing.ManifestEntry
+	11,  // 55: train_ticketing.DisruptionRequest.Type:type_name -> train_ticketing.DisruptionType
+	12,  // 56: train_ticketing.DisruptionRequest.Policy:type_name -> train_ticketing.RebookingPolicy
+	13,  // 57: train_ticketing.PassengerDisruption.Outcome:type_name -> train_ticketing.DisruptionOutcome
+	17,  // 58: train_ticketing.PassengerDisruption.Refund:type_name -> train_ticketing.Money
+	11,  // 59: train_ticketing.DisruptionReport.Type:type_name -> train_ticketing.DisruptionType
+	23,  // 60: train_ticketing.DisruptionReport.Sections:type_name -> train_ticketing.Section
+	62,  // 61: train_ticketing.DisruptionReport.Passengers:type_name -> train_ticketing.PassengerDisruption
+	14,  // 62: train_ticketing.NotificationDelivery.Status:type_name -> train_ticketing.DeliveryStatus
+	64,  // 63: train_ticketing.NotificationLog.Deliveries:type_name -> train_ticketing.NotificationDelivery
+	66,  // 64: train_ticketing.AllWebhookSubscriptions.Subscriptions:type_name -> train_ticketing.WebhookSubscription
+	70,  // 65: train_ticketing.DeadLetters.DeadLetters:type_name -> train_ticketing.DeadLetter
+	15,  // 66: train_ticketing.DomainEvent.User:type_name -> train_ticketing.User
+	19,  // 67: train_ticketing.DomainEvent.Ticket:type_name -> train_ticketing.Ticket
+	23,  // 68: train_ticketing.DomainEvent.Section:type_name -> train_ticketing.Section
+	17,  // 69: train_ticketing.DomainEvent.Refund:type_name -> train_ticketing.Money
+	77,  // 70: train_ticketing.EventConsumers.Consumers:type_name -> train_ticketing.EventConsumer
+	15,  // 71: train_ticketing.BookingState.Users:type_name -> train_ticketing.User
+	23,  // 72: train_ticketing.BookingState.Sections:type_name -> train_ticketing.Section
+	19,  // 73: train_ticketing.BookingState.Tickets:type_name -> train_ticketing.Ticket
+	82,  // 74: train_ticketing.SeatOccupancy.Occupants:type_name -> train_ticketing.SeatOccupant
+	74,  // 75: train_ticketing.WALRecord.Events:type_name -> train_ticketing.DomainEvent
+	86,  // 76: train_ticketing.WALRecord.Entries:type_name -> train_ticketing.StateEntry
+	85,  // 77: train_ticketing.WALRecord.Base:type_name -> train_ticketing.BookingSnapshot
+	15,  // 78: train_ticketing.BookingSnapshot.Users:type_name -> train_ticketing.User
+	23,  // 79: train_ticketing.BookingSnapshot.Sections:type_name -> train_ticketing.Section
+	19,  // 80: train_ticketing.BookingSnapshot.Tickets:type_name -> train_ticketing.Ticket
+	30,  // 81: train_ticketing.StateEntry.Block:type_name -> train_ticketing.SeatBlock
+	21,  // 82: train_ticketing.StateEntry.Hold:type_name -> train_ticketing.Hold
+	34,  // 83: train_ticketing.StateEntry.Promotion:type_name -> train_ticketing.Promotion
+	38,  // 84: train_ticketing.StateEntry.Ledger:type_name -> train_ticketing.LedgerEntry
+	40,  // 85: train_ticketing.StateEntry.ExchangeRate:type_name -> train_ticketing.ExchangeRate
+	46,  // 86: train_ticketing.StateEntry.Invoice:type_name -> train_ticketing.Invoice
+	77,  // 87: train_ticketing.StateEntry.Consumer:type_name -> train_ticketing.EventConsumer
+	66,  // 88: train_ticketing.StateEntry.Webhook:type_name -> train_ticketing.WebhookSubscription
+	70,  // 89: train_ticketing.StateEntry.DeadLetter:type_name -> train_ticketing.DeadLetter
+	15,  // 90: train_ticketing.Receipt.user:type_name -> train_ticketing.User
+	17,  // 91: train_ticketing.Receipt.price_paid:type_name -> train_ticketing.Money
+	1,   // 92: train_ticketing.Receipt.Class:type_name -> train_ticketing.SeatClass
+	2,   // 93: train_ticketing.Receipt.Amenities:type_name -> train_ticketing.Amenity
+	0,   // 94: train_ticketing.Receipt.Category:type_name -> train_ticketing.PassengerCategory
+	18,  // 95: train_ticketing.Receipt.FareBreakdown:type_name -> train_ticketing.FareLine
+	23,  // 96: train_ticketing.AllSections.sections:type_name -> train_ticketing.Section
+	15,  // 97: train_ticketing.AllUsers.users:type_name -> train_ticketing.User
+	90,  // 98: train_ticketing.SeatAllocation.tickets:type_name -> train_ticketing.SeatDetails
+	24,  // 99: train_ticketing.TrainTicketing.CreateSection:input_type -> train_ticketing.CreateSectionRequest
+	95,  // 100: train_ticketing.TrainTicketing.ViewSections:input_type -> train_ticketing.SectionRequest
+	25,  // 101: train_ticketing.TrainTicketing.ModifySections:input_type -> train_ticketing.ModifySectionRequest
+	26,  // 102: train_ticketing.TrainTicketing.ResizeSection:input_type -> train_ticketing.ResizeSectionRequest
+	27,  // 103: train_ticketing.TrainTicketing.DeleteSection:input_type -> train_ticketing.DeleteSectionRequest
+	16,  // 104: train_ticketing.TrainTicketing.CreateUser:input_type -> train_ticketing.CreateUserRequest
+	93,  // 105: train_ticketing.TrainTicketing.GetUsers:input_type -> train_ticketing.UseRequest
+	15,  // 106: train_ticketing.TrainTicketing.ModifyUser:input_type -> train_ticketing.User
+	93,  // 107: train_ticketing.TrainTicketing.RemoveUser:input_type -> train_ticketing.UseRequest
+	20,  // 108: train_ticketing.TrainTicketing.PurchaseTicket:input_type -> train_ticketing.TicketRequest
+	93,  // 109: train_ticketing.TrainTicketing.ViewReceipt:input_type -> train_ticketing.UseRequest
+	95,  // 110: train_ticketing.TrainTicketing.ViewSeatsBySection:input_type -> train_ticketing.SectionRequest
+	94,  // 111: train_ticketing.TrainTicketing.CancelReceipt:input_type -> train_ticketing.CancelReceiptRequest
+	51,  // 112: train_ticketing.TrainTicketing.ModifySeat:input_type -> train_ticketing.ModifySeatRequest
+	31,  // 113: train_ticketing.TrainTicketing.BlockSeats:input_type -> train_ticketing.BlockSeatsRequest
+	33,  // 114: train_ticketing.TrainTicketing.UnblockSeats:input_type -> train_ticketing.UnblockSeatsRequest
+	35,  // 115: train_ticketing.TrainTicketing.CreatePromotion:input_type -> train_ticketing.CreatePromotionRequest
+	36,  // 116: train_ticketing.TrainTicketing.ViewPromotions:input_type -> train_ticketing.PromotionRequest
+	36,  // 117: train_ticketing.TrainTicketing.DisablePromotion:input_type -> train_ticketing.PromotionRequest
+	93,  // 118: train_ticketing.TrainTicketing.GetLoyaltyBalance:input_type -> train_ticketing.UseRequest
+	20,  // 119: train_ticketing.TrainTicketing.HoldSeat:input_type -> train_ticketing.TicketRequest
+	22,  // 120: train_ticketing.TrainTicketing.ReleaseHold:input_type -> train_ticketing.HoldRequest
+	40,  // 121: train_ticketing.TrainTicketing.SetExchangeRate:input_type -> train_ticketing.ExchangeRate
+	41,  // 122: train_ticketing.TrainTicketing.ViewExchangeRates:input_type -> train_ticketing.CurrencyRequest
+	47,  // 123: train_ticketing.TrainTicketing.GetInvoice:input_type -> train_ticketing.InvoiceRequest
+	93,  // 124: train_ticketing.TrainTicketing.ListInvoices:input_type -> train_ticketing.UseRequest
+	49,  // 125: train_ticketing.TrainTicketing.RenderReceipt:input_type -> train_ticketing.RenderReceiptRequest
+	96,  // 126: train_ticketing.TrainTicketing.GetBoardingPassKeys:input_type -> train_ticketing.EmptyResponse
+	54,  // 127: train_ticketing.TrainTicketing.VerifyBoardingPass:input_type -> train_ticketing.VerifyBoardingPassRequest
+	56,  // 128: train_ticketing.TrainTicketing.Board:input_type -> train_ticketing.BoardRequest
+	58,  // 129: train_ticketing.TrainTicketing.ViewManifest:input_type -> train_ticketing.ManifestRequest
+	61,  // 130: train_ticketing.TrainTicketing.ReportDisruption:input_type -> train_ticketing.DisruptionRequest
+	93,  // 131: train_ticketing.TrainTicketing.ViewNotifications:input_type -> train_ticketing.UseRequest
+	67,  // 132: train_ticketing.TrainTicketing.CreateWebhookSubscription:input_type -> train_ticketing.CreateWebhookSubscriptionRequest
+	96,  // 133: train_ticketing.TrainTicketing.ViewWebhookSubscriptions:input_type -> train_ticketing.EmptyResponse
+	69,  // 134: train_ticketing.TrainTicketing.DeleteWebhookSubscription:input_type -> train_ticketing.WebhookSubscriptionRequest
+	69,  // 135: train_ticketing.TrainTicketing.ViewDeadLetters:input_type -> train_ticketing.WebhookSubscriptionRequest
+	72,  // 136: train_ticketing.TrainTicketing.ReplayDeadLetters:input_type -> train_ticketing.ReplayDeadLettersRequest
+	75,  // 137: train_ticketing.TrainTicketing.SubscribeEvents:input_type -> train_ticketing.SubscribeEventsRequest
+	76,  // 138: train_ticketing.TrainTicketing.AckEvents:input_type -> train_ticketing.AckEventsRequest
+	96,  // 139: train_ticketing.TrainTicketing.ViewEventConsumers:input_type -> train_ticketing.EmptyResponse
+	79,  // 140: train_ticketing.TrainTicketing.ViewStateAt:input_type -> train_ticketing.StateAtRequest
+	81,  // 141: train_ticketing.TrainTicketing.ViewSeatOccupancy:input_type -> train_ticketing.SeatOccupancyRequest
+	23,  // 142: train_ticketing.TrainTicketing.CreateSection:output_type -> train_ticketing.Section
+	88,  // 143: train_ticketing.TrainTicketing.ViewSections:output_type -> train_ticketing.AllSections
+	23,  // 144: train_ticketing.TrainTicketing.ModifySections:output_type -> train_ticketing.Section
+	29,  // 145: train_ticketing.TrainTicketing.ResizeSection:output_type -> train_ticketing.SectionChangeReport
+	29,  // 146: train_ticketing.TrainTicketing.DeleteSection:output_type -> train_ticketing.SectionChangeReport
+	15,  // 147: train_ticketing.TrainTicketing.CreateUser:output_type -> train_ticketing.User
+	89,  // 148: train_ticketing.TrainTicketing.GetUsers:output_type -> train_ticketing.AllUsers
+	15,  // 149: train_ticketing.TrainTicketing.ModifyUser:output_type -> train_ticketing.User
+	96,  // 150: train_ticketing.TrainTicketing.RemoveUser:output_type -> train_ticketing.EmptyResponse
+	19,  // 151: train_ticketing.TrainTicketing.PurchaseTicket:output_type -> train_ticketing.Ticket
+	87,  // 152: train_ticketing.TrainTicketing.ViewReceipt:output_type -> train_ticketing.Receipt
+	91,  // 153: train_ticketing.TrainTicketing.ViewSeatsBySection:output_type -> train_ticketing.SeatAllocation
+	96,  // 154: train_ticketing.TrainTicketing.CancelReceipt:output_type -> train_ticketing.EmptyResponse
+	19,  // 155: train_ticketing.TrainTicketing.ModifySeat:output_type -> train_ticketing.Ticket
+	32,  // 156: train_ticketing.TrainTicketing.BlockSeats:output_type -> train_ticketing.BlockSeatsResponse
+	96,  // 157: train_ticketing.TrainTicketing.UnblockSeats:output_type -> train_ticketing.EmptyResponse
+	34,  // 158: train_ticketing.TrainTicketing.CreatePromotion:output_type -> train_ticketing.Promotion
+	37,  // 159: train_ticketing.TrainTicketing.ViewPromotions:output_type -> train_ticketing.AllPromotions
+	34,  // 160: train_ticketing.TrainTicketing.DisablePromotion:output_type -> train_ticketing.Promotion
+	39,  // 161: train_ticketing.TrainTicketing.GetLoyaltyBalance:output_type -> train_ticketing.LoyaltyBalance
+	21,  // 162: train_ticketing.TrainTicketing.HoldSeat:output_type -> train_ticketing.Hold
+	96,  // 163: train_ticketing.TrainTicketing.ReleaseHold:output_type -> train_ticketing.EmptyResponse
+	40,  // 164: train_ticketing.TrainTicketing.SetExchangeRate:output_type -> train_ticketing.ExchangeRate
+	42,  // 165: train_ticketing.TrainTicketing.ViewExchangeRates:output_type -> train_ticketing.ExchangeRates
+	46,  // 166: train_ticketing.TrainTicketing.GetInvoice:output_type -> train_ticketing.Invoice
+	48,  // 167: train_ticketing.TrainTicketing.ListInvoices:output_type -> train_ticketing.AllInvoices
+	50,  // 168: train_ticketing.TrainTicketing.RenderReceipt:output_type -> train_ticketing.RenderedReceipt
+	53,  // 169: train_ticketing.TrainTicketing.GetBoardingPassKeys:output_type -> train_ticketing.BoardingPassKeys
+	55,  // 170: train_ticketing.TrainTicketing.VerifyBoardingPass:output_type -> train_ticketing.BoardingPassVerification
+	57,  // 171: train_ticketing.TrainTicketing.Board:output_type -> train_ticketing.BoardingResult
+	60,  // 172: train_ticketing.TrainTicketing.ViewManifest:output_type -> train_ticketing.Manifest
+	63,  // 173: train_ticketing.TrainTicketing.ReportDisruption:output_type -> train_ticketing.DisruptionReport
+	65,  // 174: train_ticketing.TrainTicketing.ViewNotifications:output_type -> train_ticketing.NotificationLog
+	66,  // 175: train_ticketing.TrainTicketing.CreateWebhookSubscription:output_type -> train_ticketing.WebhookSubscription
+	68,  // 176: train_ticketing.TrainTicketing.ViewWebhookSubscriptions:output_type -> train_ticketing.AllWebhookSubscriptions
+	96,  // 177: train_ticketing.TrainTicketing.DeleteWebhookSubscription:output_type -> train_ticketing.EmptyResponse
+	71,  // 178: train_ticketing.TrainTicketing.ViewDeadLetters:output_type -> train_ticketing.DeadLetters
+	73,  // 179: train_ticketing.TrainTicketing.ReplayDeadLetters:output_type -> train_ticketing.ReplayDeadLettersResponse
+	74,  // 180: train_ticketing.TrainTicketing.SubscribeEvents:output_type -> train_ticketing.DomainEvent
+	77,  // 181: train_ticketing.TrainTicketing.AckEvents:output_type -> train_ticketing.EventConsumer
+	78,  // 182: train_ticketing.TrainTicketing.ViewEventConsumers:output_type -> train_ticketing.EventConsumers
+	80,  // 183: train_ticketing.TrainTicketing.ViewStateAt:output_type -> train_ticketing.BookingState
+	83,  // 184: train_ticketing.TrainTicketing.ViewSeatOccupancy:output_type -> train_ticketing.SeatOccupancy
+	142, // [142:185] is the sub-list for method output_type
+	99,  // [99:142] is the sub-list for method input_type
+	99,  // [99:99] is the sub-list for extension type_name
+	99,  // [99:99] is the sub-list for extension extendee
+	0,   // [0:99] is the sub-list for field type_name
+}
+
+func init() { file_ticket_proto_init() }
+func file_ticket_proto_init() {
+	if File_ticket_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ticket_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Money); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FareLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ticket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TicketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hold); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Section); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifySectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResizeSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSectionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AffectedTicket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SectionChangeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeatBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockSeatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockSeatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnblockSeatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Promotion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePromotionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromotionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllPromotions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoyaltyBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExchangeRates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Seller); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaxLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvoiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllInvoices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderedReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifySeatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardingPassKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardingPassKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyBoardingPassRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardingPassVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardingResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Manifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisruptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PassengerDisruption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisruptionReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationDelivery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWebhookSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllWebhookSubscriptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLettersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DomainEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SectionRequest.ProtoReflect.Descriptor instead.
-func (*SectionRequest) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *SectionRequest) GetSectionID() string {
-	if x != nil {
-		return x.SectionID
-	}
-	return ""
-}
-
-// Empty response message.
-type EmptyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *EmptyResponse) Reset() {
-	*x = EmptyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_ticket_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *EmptyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*EmptyResponse) ProtoMessage() {}
-
-func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_ticket_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_ticket_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
-func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_ticket_proto_rawDescGZIP(), []int{16}
-}
-
-var File_ticket_proto protoreflect.FileDescriptor
-
-var file_ticket_proto_rawDesc = []byte{
-	0x0a, 0x0c, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x22,
-	0xac, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x22, 0x63,
-	0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x46, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x22, 0xf8, 0x01, 0x0a, 0x06, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16,
-	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
-	0x70, 0x61, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x22, 0x6a,
-	0x0a, 0x0d, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
-	0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
-	0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x50, 0x61, 0x69, 0x64, 0x22, 0xc7, 0x01, 0x0a, 0x07, 0x53,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x26,
-	0x0a, 0x0e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x61, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
-	0x65, 0x53, 0x65, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x4f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x4f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x4f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x64, 0x4f, 0x6e, 0x22, 0x50, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61,
-	0x6c, 0x53, 0x65, 0x61, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x11, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
-	0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xf0, 0x01,
-	0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
-	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x69, 0x63,
-	0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x50, 0x61, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x4f, 0x6e,
-	0x22, 0x43, 0x0a, 0x0b, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x34, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x37, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x2b, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x5f,
-	0x0a, 0x0b, 0x53, 0x65, 0x61, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x65, 0x61, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
-	0x48, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x36, 0x0a, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x07, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x1c, 0x0a, 0x04, 0x42, 0x6f, 0x6f,
-	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x24, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x2e, 0x0a,
-	0x0e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x0f, 0x0a,
-	0x0d, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x9a,
-	0x07, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x4d, 0x0a, 0x0c, 0x56, 0x69, 0x65, 0x77, 0x53, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x6c, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x51, 0x0a, 0x0e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63,
-	0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x42,
-	0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61,
-	0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f,
-	0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x49,
-	0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69,
-	0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0e, 0x50, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x72,
-	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x69,
-	0x63, 0x6b, 0x65, 0x74, 0x12, 0x44, 0x0a, 0x0b, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x56, 0x0a, 0x12, 0x56, 0x69,
-	0x65, 0x77, 0x53, 0x65, 0x61, 0x74, 0x73, 0x42, 0x79, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74,
-	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x4c, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x70, 0x74, 0x12, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b,
-	0x65, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x49, 0x0a, 0x0a, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x12, 0x22,
-	0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x6e, 0x67,
-	0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x53, 0x65, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x0d, 0x5a, 0x0b, 0x2e,
-	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
-}
-
-var (
-	file_ticket_proto_rawDescOnce sync.Once
-	file_ticket_proto_rawDescData = file_ticket_proto_rawDesc
-)
-
-func file_ticket_proto_rawDescGZIP() []byte {
-	file_ticket_proto_rawDescOnce.Do(func() {
-		file_ticket_proto_rawDescData = protoimpl.X.CompressGZIP(file_ticket_proto_rawDescData)
-	})
-	return file_ticket_proto_rawDescData
-}
-
-var file_ticket_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
-var file_ticket_proto_goTypes = []interface{}{
-	(*User)(nil),                 // 0: train_ticketing.User
-	(*CreateUserRequest)(nil),    // 1: train_ticketing.CreateUserRequest
-	(*Ticket)(nil),               // 2: train_ticketing.Ticket
-	(*TicketRequest)(nil),        // 3: train_ticketing.TicketRequest
-	(*Section)(nil),              // 4: train_ticketing.Section
-	(*CreateSectionRequest)(nil), // 5: train_ticketing.CreateSectionRequest
-	(*ModifySectionRequest)(nil), // 6: train_ticketing.ModifySectionRequest
-	(*ModifySeatRequest)(nil),    // 7: train_ticketing.ModifySeatRequest
-	(*Receipt)(nil),              // 8: train_ticketing.Receipt
-	(*AllSections)(nil),          // 9: train_ticketing.AllSections
-	(*AllUsers)(nil),             // 10: train_ticketing.AllUsers
-	(*SeatDetails)(nil),          // 11: train_ticketing.SeatDetails
-	(*SeatAllocation)(nil),       // 12: train_ticketing.SeatAllocation
-	(*Bool)(nil),                 // 13: train_ticketing.Bool
-	(*UseRequest)(nil),           // 14: train_ticketing.UseRequest
-	(*SectionRequest)(nil),       // 15: train_ticketing.SectionRequest
-	(*EmptyResponse)(nil),        // 16: train_ticketing.EmptyResponse
-}
-var file_ticket_proto_depIdxs = []int32{
-	0,  // 0: train_ticketing.Receipt.user:type_name -> train_ticketing.User
-	4,  // 1: train_ticketing.AllSections.sections:type_name -> train_ticketing.Section
-	0,  // 2: train_ticketing.AllUsers.users:type_name -> train_ticketing.User
-	11, // 3: train_ticketing.SeatAllocation.tickets:type_name -> train_ticketing.SeatDetails
-	5,  // 4: train_ticketing.TrainTicketing.CreateSection:input_type -> train_ticketing.CreateSectionRequest
-	15, // 5: train_ticketing.TrainTicketing.ViewSections:input_type -> train_ticketing.SectionRequest
-	6,  // 6: train_ticketing.TrainTicketing.ModifySections:input_type -> train_ticketing.ModifySectionRequest
-	1,  // 7: train_ticketing.TrainTicketing.CreateUser:input_type -> train_ticketing.CreateUserRequest
-	14, // 8: train_ticketing.TrainTicketing.GetUsers:input_type -> train_ticketing.UseRequest
-	0,  // 9: train_ticketing.TrainTicketing.ModifyUser:input_type -> train_ticketing.User
-	14, // 10: train_ticketing.TrainTicketing.RemoveUser:input_type -> train_ticketing.UseRequest
-	3,  // 11: train_ticketing.TrainTicketing.PurchaseTicket:input_type -> train_ticketing.TicketRequest
-	14, // 12: train_ticketing.TrainTicketing.ViewReceipt:input_type -> train_ticketing.UseRequest
-	15, // 13: train_ticketing.TrainTicketing.ViewSeatsBySection:input_type -> train_ticketing.SectionRequest
-	14, // 14: train_ticketing.TrainTicketing.CancelReceipt:input_type -> train_ticketing.UseRequest
-	7,  // 15: train_ticketing.TrainTicketing.ModifySeat:input_type -> train_ticketing.ModifySeatRequest
-	4,  // 16: train_ticketing.TrainTicketing.CreateSection:output_type -> train_ticketing.Section
-	9,  // 17: train_ticketing.TrainTicketing.ViewSections:output_type -> train_ticketing.AllSections
-	4,  // 18: train_ticketing.TrainTicketing.ModifySections:output_type -> train_ticketing.Section
-	0,  // 19: train_ticketing.TrainTicketing.CreateUser:output_type -> train_ticketing.User
-	10, // 20: train_ticketing.TrainTicketing.GetUsers:output_type -> train_ticketing.AllUsers
-	0,  // 21: train_ticketing.TrainTicketing.ModifyUser:output_type -> train_ticketing.User
-	16, // 22: train_ticketing.TrainTicketing.RemoveUser:output_type -> train_ticketing.EmptyResponse
-	2,  // 23: train_ticketing.TrainTicketing.PurchaseTicket:output_type -> train_ticketing.Ticket
-	8,  // 24: train_ticketing.TrainTicketing.ViewReceipt:output_type -> train_ticketing.Receipt
-	12, // 25: train_ticketing.TrainTicketing.ViewSeatsBySection:output_type -> train_ticketing.SeatAllocation
-	16, // 26: train_ticketing.TrainTicketing.CancelReceipt:output_type -> train_ticketing.EmptyResponse
-	2,  // 27: train_ticketing.TrainTicketing.ModifySeat:output_type -> train_ticketing.Ticket
-	16, // [16:28] is the sub-list for method output_type
-	4,  // [4:16] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
-}
-
-func init() { file_ticket_proto_init() }
-func file_ticket_proto_init() {
-	if File_ticket_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_ticket_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_ticket_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AckEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1367,8 +9765,8 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRequest); i {
+		file_ticket_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventConsumer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1379,8 +9777,8 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Ticket); i {
+		file_ticket_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventConsumers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1391,8 +9789,8 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TicketRequest); i {
+		file_ticket_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateAtRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1403,8 +9801,8 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Section); i {
+		file_ticket_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookingState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1415,8 +9813,8 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSectionRequest); i {
+		file_ticket_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeatOccupancyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1427,8 +9825,8 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifySectionRequest); i {
+		file_ticket_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeatOccupant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1439,8 +9837,8 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ModifySeatRequest); i {
+		file_ticket_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeatOccupancy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1451,7 +9849,43 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WALRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BookingSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Receipt); i {
 			case 0:
 				return &v.state
@@ -1463,7 +9897,7 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllSections); i {
 			case 0:
 				return &v.state
@@ -1475,7 +9909,7 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AllUsers); i {
 			case 0:
 				return &v.state
@@ -1487,7 +9921,7 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SeatDetails); i {
 			case 0:
 				return &v.state
@@ -1499,7 +9933,7 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SeatAllocation); i {
 			case 0:
 				return &v.state
@@ -1511,7 +9945,7 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Bool); i {
 			case 0:
 				return &v.state
@@ -1523,7 +9957,7 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UseRequest); i {
 			case 0:
 				return &v.state
@@ -1535,7 +9969,19 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ticket_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SectionRequest); i {
 			case 0:
 				return &v.state
@@ -1547,7 +9993,7 @@ func file_ticket_proto_init() {
 				return nil
 			}
 		}
-		file_ticket_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_ticket_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EmptyResponse); i {
 			case 0:
 				return &v.state
@@ -1565,13 +10011,14 @@ func file_ticket_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_ticket_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      15,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_ticket_proto_goTypes,
 		DependencyIndexes: file_ticket_proto_depIdxs,
+		EnumInfos:         file_ticket_proto_enumTypes,
 		MessageInfos:      file_ticket_proto_msgTypes,
 	}.Build()
 	File_ticket_proto = out.File
@@ -19,18 +19,49 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	TrainTicketing_CreateSection_FullMethodName      = "/train_ticketing.TrainTicketing/CreateSection"
-	TrainTicketing_ViewSections_FullMethodName       = "/train_ticketing.TrainTicketing/ViewSections"
-	TrainTicketing_ModifySections_FullMethodName     = "/train_ticketing.TrainTicketing/ModifySections"
-	TrainTicketing_CreateUser_FullMethodName         = "/train_ticketing.TrainTicketing/CreateUser"
-	TrainTicketing_GetUsers_FullMethodName           = "/train_ticketing.TrainTicketing/GetUsers"
-	TrainTicketing_ModifyUser_FullMethodName         = "/train_ticketing.TrainTicketing/ModifyUser"
-	TrainTicketing_RemoveUser_FullMethodName         = "/train_ticketing.TrainTicketing/RemoveUser"
-	TrainTicketing_PurchaseTicket_FullMethodName     = "/train_ticketing.TrainTicketing/PurchaseTicket"
-	TrainTicketing_ViewReceipt_FullMethodName        = "/train_ticketing.TrainTicketing/ViewReceipt"
-	TrainTicketing_ViewSeatsBySection_FullMethodName = "/train_ticketing.TrainTicketing/ViewSeatsBySection"
-	TrainTicketing_CancelReceipt_FullMethodName      = "/train_ticketing.TrainTicketing/CancelReceipt"
-	TrainTicketing_ModifySeat_FullMethodName         = "/train_ticketing.TrainTicketing/ModifySeat"
+	TrainTicketing_CreateSection_FullMethodName             = "/train_ticketing.TrainTicketing/CreateSection"
+	TrainTicketing_ViewSections_FullMethodName              = "/train_ticketing.TrainTicketing/ViewSections"
+	TrainTicketing_ModifySections_FullMethodName            = "/train_ticketing.TrainTicketing/ModifySections"
+	TrainTicketing_ResizeSection_FullMethodName             = "/train_ticketing.TrainTicketing/ResizeSection"
+	TrainTicketing_DeleteSection_FullMethodName             = "/train_ticketing.TrainTicketing/DeleteSection"
+	TrainTicketing_CreateUser_FullMethodName                = "/train_ticketing.TrainTicketing/CreateUser"
+	TrainTicketing_GetUsers_FullMethodName                  = "/train_ticketing.TrainTicketing/GetUsers"
+	TrainTicketing_ModifyUser_FullMethodName                = "/train_ticketing.TrainTicketing/ModifyUser"
+	TrainTicketing_RemoveUser_FullMethodName                = "/train_ticketing.TrainTicketing/RemoveUser"
+	TrainTicketing_PurchaseTicket_FullMethodName            = "/train_ticketing.TrainTicketing/PurchaseTicket"
+	TrainTicketing_ViewReceipt_FullMethodName               = "/train_ticketing.TrainTicketing/ViewReceipt"
+	TrainTicketing_ViewSeatsBySection_FullMethodName        = "/train_ticketing.TrainTicketing/ViewSeatsBySection"
+	TrainTicketing_CancelReceipt_FullMethodName             = "/train_ticketing.TrainTicketing/CancelReceipt"
+	TrainTicketing_ModifySeat_FullMethodName                = "/train_ticketing.TrainTicketing/ModifySeat"
+	TrainTicketing_BlockSeats_FullMethodName                = "/train_ticketing.TrainTicketing/BlockSeats"
+	TrainTicketing_UnblockSeats_FullMethodName              = "/train_ticketing.TrainTicketing/UnblockSeats"
+	TrainTicketing_CreatePromotion_FullMethodName           = "/train_ticketing.TrainTicketing/CreatePromotion"
+	TrainTicketing_ViewPromotions_FullMethodName            = "/train_ticketing.TrainTicketing/ViewPromotions"
+	TrainTicketing_DisablePromotion_FullMethodName          = "/train_ticketing.TrainTicketing/DisablePromotion"
+	TrainTicketing_GetLoyaltyBalance_FullMethodName         = "/train_ticketing.TrainTicketing/GetLoyaltyBalance"
+	TrainTicketing_HoldSeat_FullMethodName                  = "/train_ticketing.TrainTicketing/HoldSeat"
+	TrainTicketing_ReleaseHold_FullMethodName               = "/train_ticketing.TrainTicketing/ReleaseHold"
+	TrainTicketing_SetExchangeRate_FullMethodName           = "/train_ticketing.TrainTicketing/SetExchangeRate"
+	TrainTicketing_ViewExchangeRates_FullMethodName         = "/train_ticketing.TrainTicketing/ViewExchangeRates"
+	TrainTicketing_GetInvoice_FullMethodName                = "/train_ticketing.TrainTicketing/GetInvoice"
+	TrainTicketing_ListInvoices_FullMethodName              = "/train_ticketing.TrainTicketing/ListInvoices"
+	TrainTicketing_RenderReceipt_FullMethodName             = "/train_ticketing.TrainTicketing/RenderReceipt"
+	TrainTicketing_GetBoardingPassKeys_FullMethodName       = "/train_ticketing.TrainTicketing/GetBoardingPassKeys"
+	TrainTicketing_VerifyBoardingPass_FullMethodName        = "/train_ticketing.TrainTicketing/VerifyBoardingPass"
+	TrainTicketing_Board_FullMethodName                     = "/train_ticketing.TrainTicketing/Board"
+	TrainTicketing_ViewManifest_FullMethodName              = "/train_ticketing.TrainTicketing/ViewManifest"
+	TrainTicketing_ReportDisruption_FullMethodName          = "/train_ticketing.TrainTicketing/ReportDisruption"
+	TrainTicketing_ViewNotifications_FullMethodName         = "/train_ticketing.TrainTicketing/ViewNotifications"
+	TrainTicketing_CreateWebhookSubscription_FullMethodName = "/train_ticketing.TrainTicketing/CreateWebhookSubscription"
+	TrainTicketing_ViewWebhookSubscriptions_FullMethodName  = "/train_ticketing.TrainTicketing/ViewWebhookSubscriptions"
+	TrainTicketing_DeleteWebhookSubscription_FullMethodName = "/train_ticketing.TrainTicketing/DeleteWebhookSubscription"
+	TrainTicketing_ViewDeadLetters_FullMethodName           = "/train_ticketing.TrainTicketing/ViewDeadLetters"
+	TrainTicketing_ReplayDeadLetters_FullMethodName         = "/train_ticketing.TrainTicketing/ReplayDeadLetters"
+	TrainTicketing_SubscribeEvents_FullMethodName           = "/train_ticketing.TrainTicketing/SubscribeEvents"
+	TrainTicketing_AckEvents_FullMethodName                 = "/train_ticketing.TrainTicketing/AckEvents"
+	TrainTicketing_ViewEventConsumers_FullMethodName        = "/train_ticketing.TrainTicketing/ViewEventConsumers"
+	TrainTicketing_ViewStateAt_FullMethodName               = "/train_ticketing.TrainTicketing/ViewStateAt"
+	TrainTicketing_ViewSeatOccupancy_FullMethodName         = "/train_ticketing.TrainTicketing/ViewSeatOccupancy"
 )
 
 // TrainTicketingClient is the client API for TrainTicketing service.
@@ -40,6 +71,8 @@ type TrainTicketingClient interface {
 	CreateSection(ctx context.Context, in *CreateSectionRequest, opts ...grpc.CallOption) (*Section, error)
 	ViewSections(ctx context.Context, in *SectionRequest, opts ...grpc.CallOption) (*AllSections, error)
 	ModifySections(ctx context.Context, in *ModifySectionRequest, opts ...grpc.CallOption) (*Section, error)
+	ResizeSection(ctx context.Context, in *ResizeSectionRequest, opts ...grpc.CallOption) (*SectionChangeReport, error)
+	DeleteSection(ctx context.Context, in *DeleteSectionRequest, opts ...grpc.CallOption) (*SectionChangeReport, error)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
 	GetUsers(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*AllUsers, error)
 	ModifyUser(ctx context.Context, in *User, opts ...grpc.CallOption) (*User, error)
@@ -47,8 +80,37 @@ type TrainTicketingClient interface {
 	PurchaseTicket(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*Ticket, error)
 	ViewReceipt(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*Receipt, error)
 	ViewSeatsBySection(ctx context.Context, in *SectionRequest, opts ...grpc.CallOption) (*SeatAllocation, error)
-	CancelReceipt(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	CancelReceipt(ctx context.Context, in *CancelReceiptRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
 	ModifySeat(ctx context.Context, in *ModifySeatRequest, opts ...grpc.CallOption) (*Ticket, error)
+	BlockSeats(ctx context.Context, in *BlockSeatsRequest, opts ...grpc.CallOption) (*BlockSeatsResponse, error)
+	UnblockSeats(ctx context.Context, in *UnblockSeatsRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*Promotion, error)
+	ViewPromotions(ctx context.Context, in *PromotionRequest, opts ...grpc.CallOption) (*AllPromotions, error)
+	DisablePromotion(ctx context.Context, in *PromotionRequest, opts ...grpc.CallOption) (*Promotion, error)
+	GetLoyaltyBalance(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*LoyaltyBalance, error)
+	HoldSeat(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*Hold, error)
+	ReleaseHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	SetExchangeRate(ctx context.Context, in *ExchangeRate, opts ...grpc.CallOption) (*ExchangeRate, error)
+	ViewExchangeRates(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*ExchangeRates, error)
+	GetInvoice(ctx context.Context, in *InvoiceRequest, opts ...grpc.CallOption) (*Invoice, error)
+	ListInvoices(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*AllInvoices, error)
+	RenderReceipt(ctx context.Context, in *RenderReceiptRequest, opts ...grpc.CallOption) (*RenderedReceipt, error)
+	GetBoardingPassKeys(ctx context.Context, in *EmptyResponse, opts ...grpc.CallOption) (*BoardingPassKeys, error)
+	VerifyBoardingPass(ctx context.Context, in *VerifyBoardingPassRequest, opts ...grpc.CallOption) (*BoardingPassVerification, error)
+	Board(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*BoardingResult, error)
+	ViewManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*Manifest, error)
+	ReportDisruption(ctx context.Context, in *DisruptionRequest, opts ...grpc.CallOption) (*DisruptionReport, error)
+	ViewNotifications(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*NotificationLog, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	ViewWebhookSubscriptions(ctx context.Context, in *EmptyResponse, opts ...grpc.CallOption) (*AllWebhookSubscriptions, error)
+	DeleteWebhookSubscription(ctx context.Context, in *WebhookSubscriptionRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ViewDeadLetters(ctx context.Context, in *WebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeadLetters, error)
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (TrainTicketing_SubscribeEventsClient, error)
+	AckEvents(ctx context.Context, in *AckEventsRequest, opts ...grpc.CallOption) (*EventConsumer, error)
+	ViewEventConsumers(ctx context.Context, in *EmptyResponse, opts ...grpc.CallOption) (*EventConsumers, error)
+	ViewStateAt(ctx context.Context, in *StateAtRequest, opts ...grpc.CallOption) (*BookingState, error)
+	ViewSeatOccupancy(ctx context.Context, in *SeatOccupancyRequest, opts ...grpc.CallOption) (*SeatOccupancy, error)
 }
 
 type trainTicketingClient struct {
@@ -86,6 +148,24 @@ func (c *trainTicketingClient) ModifySections(ctx context.Context, in *ModifySec
 	return out, nil
 }
 
+func (c *trainTicketingClient) ResizeSection(ctx context.Context, in *ResizeSectionRequest, opts ...grpc.CallOption) (*SectionChangeReport, error) {
+	out := new(SectionChangeReport)
+	err := c.cc.Invoke(ctx, TrainTicketing_ResizeSection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) DeleteSection(ctx context.Context, in *DeleteSectionRequest, opts ...grpc.CallOption) (*SectionChangeReport, error) {
+	out := new(SectionChangeReport)
+	err := c.cc.Invoke(ctx, TrainTicketing_DeleteSection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *trainTicketingClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
 	out := new(User)
 	err := c.cc.Invoke(ctx, TrainTicketing_CreateUser_FullMethodName, in, out, opts...)
@@ -149,7 +229,7 @@ func (c *trainTicketingClient) ViewSeatsBySection(ctx context.Context, in *Secti
 	return out, nil
 }
 
-func (c *trainTicketingClient) CancelReceipt(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+func (c *trainTicketingClient) CancelReceipt(ctx context.Context, in *CancelReceiptRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := c.cc.Invoke(ctx, TrainTicketing_CancelReceipt_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -167,6 +247,290 @@ func (c *trainTicketingClient) ModifySeat(ctx context.Context, in *ModifySeatReq
 	return out, nil
 }
 
+func (c *trainTicketingClient) BlockSeats(ctx context.Context, in *BlockSeatsRequest, opts ...grpc.CallOption) (*BlockSeatsResponse, error) {
+	out := new(BlockSeatsResponse)
+	err := c.cc.Invoke(ctx, TrainTicketing_BlockSeats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) UnblockSeats(ctx context.Context, in *UnblockSeatsRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, TrainTicketing_UnblockSeats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) CreatePromotion(ctx context.Context, in *CreatePromotionRequest, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, TrainTicketing_CreatePromotion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewPromotions(ctx context.Context, in *PromotionRequest, opts ...grpc.CallOption) (*AllPromotions, error) {
+	out := new(AllPromotions)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewPromotions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) DisablePromotion(ctx context.Context, in *PromotionRequest, opts ...grpc.CallOption) (*Promotion, error) {
+	out := new(Promotion)
+	err := c.cc.Invoke(ctx, TrainTicketing_DisablePromotion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) GetLoyaltyBalance(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*LoyaltyBalance, error) {
+	out := new(LoyaltyBalance)
+	err := c.cc.Invoke(ctx, TrainTicketing_GetLoyaltyBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) HoldSeat(ctx context.Context, in *TicketRequest, opts ...grpc.CallOption) (*Hold, error) {
+	out := new(Hold)
+	err := c.cc.Invoke(ctx, TrainTicketing_HoldSeat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ReleaseHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, TrainTicketing_ReleaseHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) SetExchangeRate(ctx context.Context, in *ExchangeRate, opts ...grpc.CallOption) (*ExchangeRate, error) {
+	out := new(ExchangeRate)
+	err := c.cc.Invoke(ctx, TrainTicketing_SetExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewExchangeRates(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*ExchangeRates, error) {
+	out := new(ExchangeRates)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) GetInvoice(ctx context.Context, in *InvoiceRequest, opts ...grpc.CallOption) (*Invoice, error) {
+	out := new(Invoice)
+	err := c.cc.Invoke(ctx, TrainTicketing_GetInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ListInvoices(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*AllInvoices, error) {
+	out := new(AllInvoices)
+	err := c.cc.Invoke(ctx, TrainTicketing_ListInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) RenderReceipt(ctx context.Context, in *RenderReceiptRequest, opts ...grpc.CallOption) (*RenderedReceipt, error) {
+	out := new(RenderedReceipt)
+	err := c.cc.Invoke(ctx, TrainTicketing_RenderReceipt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) GetBoardingPassKeys(ctx context.Context, in *EmptyResponse, opts ...grpc.CallOption) (*BoardingPassKeys, error) {
+	out := new(BoardingPassKeys)
+	err := c.cc.Invoke(ctx, TrainTicketing_GetBoardingPassKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) VerifyBoardingPass(ctx context.Context, in *VerifyBoardingPassRequest, opts ...grpc.CallOption) (*BoardingPassVerification, error) {
+	out := new(BoardingPassVerification)
+	err := c.cc.Invoke(ctx, TrainTicketing_VerifyBoardingPass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) Board(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*BoardingResult, error) {
+	out := new(BoardingResult)
+	err := c.cc.Invoke(ctx, TrainTicketing_Board_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*Manifest, error) {
+	out := new(Manifest)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ReportDisruption(ctx context.Context, in *DisruptionRequest, opts ...grpc.CallOption) (*DisruptionReport, error) {
+	out := new(DisruptionReport)
+	err := c.cc.Invoke(ctx, TrainTicketing_ReportDisruption_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewNotifications(ctx context.Context, in *UseRequest, opts ...grpc.CallOption) (*NotificationLog, error) {
+	out := new(NotificationLog)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, TrainTicketing_CreateWebhookSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewWebhookSubscriptions(ctx context.Context, in *EmptyResponse, opts ...grpc.CallOption) (*AllWebhookSubscriptions, error) {
+	out := new(AllWebhookSubscriptions)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewWebhookSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) DeleteWebhookSubscription(ctx context.Context, in *WebhookSubscriptionRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, TrainTicketing_DeleteWebhookSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewDeadLetters(ctx context.Context, in *WebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeadLetters, error) {
+	out := new(DeadLetters)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	out := new(ReplayDeadLettersResponse)
+	err := c.cc.Invoke(ctx, TrainTicketing_ReplayDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (TrainTicketing_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TrainTicketing_ServiceDesc.Streams[0], TrainTicketing_SubscribeEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &trainTicketingSubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TrainTicketing_SubscribeEventsClient interface {
+	Recv() (*DomainEvent, error)
+	grpc.ClientStream
+}
+
+type trainTicketingSubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *trainTicketingSubscribeEventsClient) Recv() (*DomainEvent, error) {
+	m := new(DomainEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *trainTicketingClient) AckEvents(ctx context.Context, in *AckEventsRequest, opts ...grpc.CallOption) (*EventConsumer, error) {
+	out := new(EventConsumer)
+	err := c.cc.Invoke(ctx, TrainTicketing_AckEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewEventConsumers(ctx context.Context, in *EmptyResponse, opts ...grpc.CallOption) (*EventConsumers, error) {
+	out := new(EventConsumers)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewEventConsumers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewStateAt(ctx context.Context, in *StateAtRequest, opts ...grpc.CallOption) (*BookingState, error) {
+	out := new(BookingState)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewStateAt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trainTicketingClient) ViewSeatOccupancy(ctx context.Context, in *SeatOccupancyRequest, opts ...grpc.CallOption) (*SeatOccupancy, error) {
+	out := new(SeatOccupancy)
+	err := c.cc.Invoke(ctx, TrainTicketing_ViewSeatOccupancy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TrainTicketingServer is the server API for TrainTicketing service.
 // All implementations must embed UnimplementedTrainTicketingServer
 // for forward compatibility
@@ -174,6 +538,8 @@ type TrainTicketingServer interface {
 	CreateSection(context.Context, *CreateSectionRequest) (*Section, error)
 	ViewSections(context.Context, *SectionRequest) (*AllSections, error)
 	ModifySections(context.Context, *ModifySectionRequest) (*Section, error)
+	ResizeSection(context.Context, *ResizeSectionRequest) (*SectionChangeReport, error)
+	DeleteSection(context.Context, *DeleteSectionRequest) (*SectionChangeReport, error)
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
 	GetUsers(context.Context, *UseRequest) (*AllUsers, error)
 	ModifyUser(context.Context, *User) (*User, error)
@@ -181,8 +547,37 @@ type TrainTicketingServer interface {
 	PurchaseTicket(context.Context, *TicketRequest) (*Ticket, error)
 	ViewReceipt(context.Context, *UseRequest) (*Receipt, error)
 	ViewSeatsBySection(context.Context, *SectionRequest) (*SeatAllocation, error)
-	CancelReceipt(context.Context, *UseRequest) (*EmptyResponse, error)
+	CancelReceipt(context.Context, *CancelReceiptRequest) (*EmptyResponse, error)
 	ModifySeat(context.Context, *ModifySeatRequest) (*Ticket, error)
+	BlockSeats(context.Context, *BlockSeatsRequest) (*BlockSeatsResponse, error)
+	UnblockSeats(context.Context, *UnblockSeatsRequest) (*EmptyResponse, error)
+	CreatePromotion(context.Context, *CreatePromotionRequest) (*Promotion, error)
+	ViewPromotions(context.Context, *PromotionRequest) (*AllPromotions, error)
+	DisablePromotion(context.Context, *PromotionRequest) (*Promotion, error)
+	GetLoyaltyBalance(context.Context, *UseRequest) (*LoyaltyBalance, error)
+	HoldSeat(context.Context, *TicketRequest) (*Hold, error)
+	ReleaseHold(context.Context, *HoldRequest) (*EmptyResponse, error)
+	SetExchangeRate(context.Context, *ExchangeRate) (*ExchangeRate, error)
+	ViewExchangeRates(context.Context, *CurrencyRequest) (*ExchangeRates, error)
+	GetInvoice(context.Context, *InvoiceRequest) (*Invoice, error)
+	ListInvoices(context.Context, *UseRequest) (*AllInvoices, error)
+	RenderReceipt(context.Context, *RenderReceiptRequest) (*RenderedReceipt, error)
+	GetBoardingPassKeys(context.Context, *EmptyResponse) (*BoardingPassKeys, error)
+	VerifyBoardingPass(context.Context, *VerifyBoardingPassRequest) (*BoardingPassVerification, error)
+	Board(context.Context, *BoardRequest) (*BoardingResult, error)
+	ViewManifest(context.Context, *ManifestRequest) (*Manifest, error)
+	ReportDisruption(context.Context, *DisruptionRequest) (*DisruptionReport, error)
+	ViewNotifications(context.Context, *UseRequest) (*NotificationLog, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error)
+	ViewWebhookSubscriptions(context.Context, *EmptyResponse) (*AllWebhookSubscriptions, error)
+	DeleteWebhookSubscription(context.Context, *WebhookSubscriptionRequest) (*EmptyResponse, error)
+	ViewDeadLetters(context.Context, *WebhookSubscriptionRequest) (*DeadLetters, error)
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error)
+	SubscribeEvents(*SubscribeEventsRequest, TrainTicketing_SubscribeEventsServer) error
+	AckEvents(context.Context, *AckEventsRequest) (*EventConsumer, error)
+	ViewEventConsumers(context.Context, *EmptyResponse) (*EventConsumers, error)
+	ViewStateAt(context.Context, *StateAtRequest) (*BookingState, error)
+	ViewSeatOccupancy(context.Context, *SeatOccupancyRequest) (*SeatOccupancy, error)
 	mustEmbedUnimplementedTrainTicketingServer()
 }
 
@@ -199,6 +594,12 @@ func (UnimplementedTrainTicketingServer) ViewSections(context.Context, *SectionR
 func (UnimplementedTrainTicketingServer) ModifySections(context.Context, *ModifySectionRequest) (*Section, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifySections not implemented")
 }
+func (UnimplementedTrainTicketingServer) ResizeSection(context.Context, *ResizeSectionRequest) (*SectionChangeReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResizeSection not implemented")
+}
+func (UnimplementedTrainTicketingServer) DeleteSection(context.Context, *DeleteSectionRequest) (*SectionChangeReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSection not implemented")
+}
 func (UnimplementedTrainTicketingServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
@@ -220,12 +621,99 @@ func (UnimplementedTrainTicketingServer) ViewReceipt(context.Context, *UseReques
 func (UnimplementedTrainTicketingServer) ViewSeatsBySection(context.Context, *SectionRequest) (*SeatAllocation, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ViewSeatsBySection not implemented")
 }
-func (UnimplementedTrainTicketingServer) CancelReceipt(context.Context, *UseRequest) (*EmptyResponse, error) {
+func (UnimplementedTrainTicketingServer) CancelReceipt(context.Context, *CancelReceiptRequest) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelReceipt not implemented")
 }
 func (UnimplementedTrainTicketingServer) ModifySeat(context.Context, *ModifySeatRequest) (*Ticket, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ModifySeat not implemented")
 }
+func (UnimplementedTrainTicketingServer) BlockSeats(context.Context, *BlockSeatsRequest) (*BlockSeatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockSeats not implemented")
+}
+func (UnimplementedTrainTicketingServer) UnblockSeats(context.Context, *UnblockSeatsRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockSeats not implemented")
+}
+func (UnimplementedTrainTicketingServer) CreatePromotion(context.Context, *CreatePromotionRequest) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePromotion not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewPromotions(context.Context, *PromotionRequest) (*AllPromotions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewPromotions not implemented")
+}
+func (UnimplementedTrainTicketingServer) DisablePromotion(context.Context, *PromotionRequest) (*Promotion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisablePromotion not implemented")
+}
+func (UnimplementedTrainTicketingServer) GetLoyaltyBalance(context.Context, *UseRequest) (*LoyaltyBalance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoyaltyBalance not implemented")
+}
+func (UnimplementedTrainTicketingServer) HoldSeat(context.Context, *TicketRequest) (*Hold, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldSeat not implemented")
+}
+func (UnimplementedTrainTicketingServer) ReleaseHold(context.Context, *HoldRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedTrainTicketingServer) SetExchangeRate(context.Context, *ExchangeRate) (*ExchangeRate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeRate not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewExchangeRates(context.Context, *CurrencyRequest) (*ExchangeRates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewExchangeRates not implemented")
+}
+func (UnimplementedTrainTicketingServer) GetInvoice(context.Context, *InvoiceRequest) (*Invoice, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedTrainTicketingServer) ListInvoices(context.Context, *UseRequest) (*AllInvoices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
+}
+func (UnimplementedTrainTicketingServer) RenderReceipt(context.Context, *RenderReceiptRequest) (*RenderedReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderReceipt not implemented")
+}
+func (UnimplementedTrainTicketingServer) GetBoardingPassKeys(context.Context, *EmptyResponse) (*BoardingPassKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBoardingPassKeys not implemented")
+}
+func (UnimplementedTrainTicketingServer) VerifyBoardingPass(context.Context, *VerifyBoardingPassRequest) (*BoardingPassVerification, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBoardingPass not implemented")
+}
+func (UnimplementedTrainTicketingServer) Board(context.Context, *BoardRequest) (*BoardingResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Board not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewManifest(context.Context, *ManifestRequest) (*Manifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewManifest not implemented")
+}
+func (UnimplementedTrainTicketingServer) ReportDisruption(context.Context, *DisruptionRequest) (*DisruptionReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDisruption not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewNotifications(context.Context, *UseRequest) (*NotificationLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewNotifications not implemented")
+}
+func (UnimplementedTrainTicketingServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewWebhookSubscriptions(context.Context, *EmptyResponse) (*AllWebhookSubscriptions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewWebhookSubscriptions not implemented")
+}
+func (UnimplementedTrainTicketingServer) DeleteWebhookSubscription(context.Context, *WebhookSubscriptionRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewDeadLetters(context.Context, *WebhookSubscriptionRequest) (*DeadLetters, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewDeadLetters not implemented")
+}
+func (UnimplementedTrainTicketingServer) ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
+func (UnimplementedTrainTicketingServer) SubscribeEvents(*SubscribeEventsRequest, TrainTicketing_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
+func (UnimplementedTrainTicketingServer) AckEvents(context.Context, *AckEventsRequest) (*EventConsumer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckEvents not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewEventConsumers(context.Context, *EmptyResponse) (*EventConsumers, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewEventConsumers not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewStateAt(context.Context, *StateAtRequest) (*BookingState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewStateAt not implemented")
+}
+func (UnimplementedTrainTicketingServer) ViewSeatOccupancy(context.Context, *SeatOccupancyRequest) (*SeatOccupancy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewSeatOccupancy not implemented")
+}
 func (UnimplementedTrainTicketingServer) mustEmbedUnimplementedTrainTicketingServer() {}
 
 // UnsafeTrainTicketingServer may be embedded to opt out of forward compatibility for this service.
@@ -293,71 +781,107 @@ func _TrainTicketing_ModifySections_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrainTicketing_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _TrainTicketing_ResizeSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeSectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrainTicketingServer).CreateUser(ctx, in)
+		return srv.(TrainTicketingServer).ResizeSection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrainTicketing_CreateUser_FullMethodName,
+		FullMethod: TrainTicketing_ResizeSection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrainTicketingServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(TrainTicketingServer).ResizeSection(ctx, req.(*ResizeSectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrainTicketing_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UseRequest)
+func _TrainTicketing_DeleteSection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrainTicketingServer).GetUsers(ctx, in)
+		return srv.(TrainTicketingServer).DeleteSection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrainTicketing_GetUsers_FullMethodName,
+		FullMethod: TrainTicketing_DeleteSection_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrainTicketingServer).GetUsers(ctx, req.(*UseRequest))
+		return srv.(TrainTicketingServer).DeleteSection(ctx, req.(*DeleteSectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrainTicketing_ModifyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(User)
+func _TrainTicketing_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrainTicketingServer).ModifyUser(ctx, in)
+		return srv.(TrainTicketingServer).CreateUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrainTicketing_ModifyUser_FullMethodName,
+		FullMethod: TrainTicketing_CreateUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrainTicketingServer).ModifyUser(ctx, req.(*User))
+		return srv.(TrainTicketingServer).CreateUser(ctx, req.(*CreateUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TrainTicketing_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _TrainTicketing_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TrainTicketingServer).RemoveUser(ctx, in)
+		return srv.(TrainTicketingServer).GetUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TrainTicketing_RemoveUser_FullMethodName,
+		FullMethod: TrainTicketing_GetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).GetUsers(ctx, req.(*UseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ModifyUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ModifyUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ModifyUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ModifyUser(ctx, req.(*User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).RemoveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_RemoveUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(TrainTicketingServer).RemoveUser(ctx, req.(*UseRequest))
@@ -420,7 +944,7 @@ func _TrainTicketing_ViewSeatsBySection_Handler(srv interface{}, ctx context.Con
 }
 
 func _TrainTicketing_CancelReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UseRequest)
+	in := new(CancelReceiptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -432,7 +956,7 @@ func _TrainTicketing_CancelReceipt_Handler(srv interface{}, ctx context.Context,
 		FullMethod: TrainTicketing_CancelReceipt_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TrainTicketingServer).CancelReceipt(ctx, req.(*UseRequest))
+		return srv.(TrainTicketingServer).CancelReceipt(ctx, req.(*CancelReceiptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -455,6 +979,531 @@ func _TrainTicketing_ModifySeat_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TrainTicketing_BlockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).BlockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_BlockSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).BlockSeats(ctx, req.(*BlockSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_UnblockSeats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockSeatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).UnblockSeats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_UnblockSeats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).UnblockSeats(ctx, req.(*UnblockSeatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_CreatePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).CreatePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_CreatePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).CreatePromotion(ctx, req.(*CreatePromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewPromotions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewPromotions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewPromotions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewPromotions(ctx, req.(*PromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_DisablePromotion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromotionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).DisablePromotion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_DisablePromotion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).DisablePromotion(ctx, req.(*PromotionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_GetLoyaltyBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).GetLoyaltyBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_GetLoyaltyBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).GetLoyaltyBalance(ctx, req.(*UseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_HoldSeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TicketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).HoldSeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_HoldSeat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).HoldSeat(ctx, req.(*TicketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ReleaseHold(ctx, req.(*HoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_SetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).SetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_SetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).SetExchangeRate(ctx, req.(*ExchangeRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewExchangeRates(ctx, req.(*CurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).GetInvoice(ctx, req.(*InvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ListInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ListInvoices(ctx, req.(*UseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_RenderReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).RenderReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_RenderReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).RenderReceipt(ctx, req.(*RenderReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_GetBoardingPassKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).GetBoardingPassKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_GetBoardingPassKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).GetBoardingPassKeys(ctx, req.(*EmptyResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_VerifyBoardingPass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBoardingPassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).VerifyBoardingPass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_VerifyBoardingPass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).VerifyBoardingPass(ctx, req.(*VerifyBoardingPassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_Board_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).Board(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_Board_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).Board(ctx, req.(*BoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewManifest(ctx, req.(*ManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ReportDisruption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisruptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ReportDisruption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ReportDisruption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ReportDisruption(ctx, req.(*DisruptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewNotifications(ctx, req.(*UseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewWebhookSubscriptions(ctx, req.(*EmptyResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).DeleteWebhookSubscription(ctx, req.(*WebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewDeadLetters(ctx, req.(*WebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TrainTicketingServer).SubscribeEvents(m, &trainTicketingSubscribeEventsServer{stream})
+}
+
+type TrainTicketing_SubscribeEventsServer interface {
+	Send(*DomainEvent) error
+	grpc.ServerStream
+}
+
+type trainTicketingSubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *trainTicketingSubscribeEventsServer) Send(m *DomainEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TrainTicketing_AckEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).AckEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_AckEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).AckEvents(ctx, req.(*AckEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewEventConsumers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyResponse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewEventConsumers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewEventConsumers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewEventConsumers(ctx, req.(*EmptyResponse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewStateAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewStateAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewStateAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewStateAt(ctx, req.(*StateAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrainTicketing_ViewSeatOccupancy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SeatOccupancyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrainTicketingServer).ViewSeatOccupancy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TrainTicketing_ViewSeatOccupancy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrainTicketingServer).ViewSeatOccupancy(ctx, req.(*SeatOccupancyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TrainTicketing_ServiceDesc is the grpc.ServiceDesc for TrainTicketing service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -474,6 +1523,14 @@ var TrainTicketing_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModifySections",
 			Handler:    _TrainTicketing_ModifySections_Handler,
 		},
+		{
+			MethodName: "ResizeSection",
+			Handler:    _TrainTicketing_ResizeSection_Handler,
+		},
+		{
+			MethodName: "DeleteSection",
+			Handler:    _TrainTicketing_DeleteSection_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _TrainTicketing_CreateUser_Handler,
@@ -510,7 +1567,125 @@ var TrainTicketing_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ModifySeat",
 			Handler:    _TrainTicketing_ModifySeat_Handler,
 		},
+		{
+			MethodName: "BlockSeats",
+			Handler:    _TrainTicketing_BlockSeats_Handler,
+		},
+		{
+			MethodName: "UnblockSeats",
+			Handler:    _TrainTicketing_UnblockSeats_Handler,
+		},
+		{
+			MethodName: "CreatePromotion",
+			Handler:    _TrainTicketing_CreatePromotion_Handler,
+		},
+		{
+			MethodName: "ViewPromotions",
+			Handler:    _TrainTicketing_ViewPromotions_Handler,
+		},
+		{
+			MethodName: "DisablePromotion",
+			Handler:    _TrainTicketing_DisablePromotion_Handler,
+		},
+		{
+			MethodName: "GetLoyaltyBalance",
+			Handler:    _TrainTicketing_GetLoyaltyBalance_Handler,
+		},
+		{
+			MethodName: "HoldSeat",
+			Handler:    _TrainTicketing_HoldSeat_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _TrainTicketing_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "SetExchangeRate",
+			Handler:    _TrainTicketing_SetExchangeRate_Handler,
+		},
+		{
+			MethodName: "ViewExchangeRates",
+			Handler:    _TrainTicketing_ViewExchangeRates_Handler,
+		},
+		{
+			MethodName: "GetInvoice",
+			Handler:    _TrainTicketing_GetInvoice_Handler,
+		},
+		{
+			MethodName: "ListInvoices",
+			Handler:    _TrainTicketing_ListInvoices_Handler,
+		},
+		{
+			MethodName: "RenderReceipt",
+			Handler:    _TrainTicketing_RenderReceipt_Handler,
+		},
+		{
+			MethodName: "GetBoardingPassKeys",
+			Handler:    _TrainTicketing_GetBoardingPassKeys_Handler,
+		},
+		{
+			MethodName: "VerifyBoardingPass",
+			Handler:    _TrainTicketing_VerifyBoardingPass_Handler,
+		},
+		{
+			MethodName: "Board",
+			Handler:    _TrainTicketing_Board_Handler,
+		},
+		{
+			MethodName: "ViewManifest",
+			Handler:    _TrainTicketing_ViewManifest_Handler,
+		},
+		{
+			MethodName: "ReportDisruption",
+			Handler:    _TrainTicketing_ReportDisruption_Handler,
+		},
+		{
+			MethodName: "ViewNotifications",
+			Handler:    _TrainTicketing_ViewNotifications_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _TrainTicketing_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ViewWebhookSubscriptions",
+			Handler:    _TrainTicketing_ViewWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _TrainTicketing_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ViewDeadLetters",
+			Handler:    _TrainTicketing_ViewDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _TrainTicketing_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "AckEvents",
+			Handler:    _TrainTicketing_AckEvents_Handler,
+		},
+		{
+			MethodName: "ViewEventConsumers",
+			Handler:    _TrainTicketing_ViewEventConsumers_Handler,
+		},
+		{
+			MethodName: "ViewStateAt",
+			Handler:    _TrainTicketing_ViewStateAt_Handler,
+		},
+		{
+			MethodName: "ViewSeatOccupancy",
+			Handler:    _TrainTicketing_ViewSeatOccupancy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _TrainTicketing_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "ticket.proto",
 }
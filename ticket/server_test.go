@@ -224,7 +224,7 @@ func (t *trainServer) PurchaseTicket(ctx context.Context, req *pb.TicketRequest)
 		return nil, errors.New("To can not be blank")
 	} else if strings.TrimSpace(req.UserID) == "" {
 		return nil, errors.New("UserID can not be blank")
-	} else if req.PricePaid < 0 {
+	} else if req.PricePaid.GetMinorUnits() < 0 {
 		return nil, errors.New("Price paid can not be less than 0")
 	} else if strings.TrimSpace(req.From) == strings.TrimSpace(req.To) {
 		return nil, errors.New("From and to can not be same")
@@ -326,7 +326,7 @@ func (t *trainServer) ViewSeatsBySection(ctx context.Context, req *pb.SectionReq
 	}
 	return &pb.SeatAllocation{Tickets: seats}, nil
 }
-func (t *trainServer) CancelReceipt(ctx context.Context, req *pb.UseRequest) (*pb.EmptyResponse, error) {
+func (t *trainServer) CancelReceipt(ctx context.Context, req *pb.CancelReceiptRequest) (*pb.EmptyResponse, error) {
 	userid := strings.TrimSpace(req.UserID)
 	ticket, ok := t.tickets[userid]
 	if !ok {
@@ -622,7 +622,7 @@ func testPurchaseTicket(t *testing.T) {
 		From:      "Location 1",
 		To:        "Location 2",
 		UserID:    createdUser.UserID,
-		PricePaid: 100,
+		PricePaid: &pb.Money{CurrencyCode: "USD", MinorUnits: 10000},
 	}
 
 	ticket, err := s.PurchaseTicket(context.Background(), treq)
@@ -668,7 +668,7 @@ func testViewReceipt(t *testing.T) {
 		From:      "Location 1",
 		To:        "Location 2",
 		UserID:    createdUser.UserID,
-		PricePaid: 100,
+		PricePaid: &pb.Money{CurrencyCode: "USD", MinorUnits: 10000},
 	}
 
 	ticket, err := s.PurchaseTicket(context.Background(), treq)
@@ -726,7 +726,7 @@ func testViewSeatsBySection(t *testing.T) {
 		From:      "Location 1",
 		To:        "Location 2",
 		UserID:    createdUser.UserID,
-		PricePaid: 100,
+		PricePaid: &pb.Money{CurrencyCode: "USD", MinorUnits: 10000},
 	}
 
 	ticket, err := s.PurchaseTicket(context.Background(), treq)
@@ -784,7 +784,7 @@ func testCancelReceipt(t *testing.T) {
 		From:      "Location 1",
 		To:        "Location 2",
 		UserID:    createdUser.UserID,
-		PricePaid: 100,
+		PricePaid: &pb.Money{CurrencyCode: "USD", MinorUnits: 10000},
 	}
 
 	ticket, err := s.PurchaseTicket(context.Background(), treq)
@@ -795,7 +795,7 @@ func testCancelReceipt(t *testing.T) {
 	if ticket == nil || ticket.TicketId == "" {
 		t.Errorf("Expected TicketId to be set, got empty string")
 	}
-	cancelreq := &pb.UseRequest{
+	cancelreq := &pb.CancelReceiptRequest{
 		UserID: createdUser.UserID,
 	}
 
@@ -838,7 +838,7 @@ func testModifySeat(t *testing.T) {
 		From:      "Location 1",
 		To:        "Location 2",
 		UserID:    createdUser.UserID,
-		PricePaid: 100,
+		PricePaid: &pb.Money{CurrencyCode: "USD", MinorUnits: 10000},
 	}
 
 	ticket, err := s.PurchaseTicket(context.Background(), treq)
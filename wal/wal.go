@@ -0,0 +1,403 @@
+// Package wal implements a write-ahead log of opaque records with compacted snapshots.
+//
+// Records are framed with their length and a CRC-32C checksum and synced to disk before
+// Append returns, so after a crash every appended record is recovered whole. A record torn
+// by the crash is detected by its frame and cut off the end of the log when it is opened;
+// a bad frame followed by valid ones is corruption, not a crash, and fails the open.
+//
+// The log lives in a directory as generations: snapshot-N holds records describing the whole
+// state when generation N started and log-N the records appended since. Snapshot starts a new
+// generation; older generations are removed once the new snapshot is safely on disk.
+package wal
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	headerSize    = 8 // record length and CRC-32C, both little endian uint32
+	maxRecordSize = 64 << 20
+
+	snapshotPrefix = "snapshot-"
+	logPrefix      = "log-"
+	tmpSuffix      = ".tmp"
+)
+
+var (
+	// ErrCorrupt is returned by Open when a record that was fully synced fails its checksum,
+	// or a bad frame is followed by valid ones.
+	ErrCorrupt = errors.New("wal: corrupt record")
+	// ErrEmptyRecord is returned by Append for a zero length record, which is reserved so
+	// that zero-filled garbage at the end of a log is never mistaken for records.
+	ErrEmptyRecord = errors.New("wal: empty record")
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// Log is an open write-ahead log. It is safe for concurrent use.
+type Log struct {
+	dir string
+
+	mu      sync.Mutex
+	file    *os.File
+	gen     uint64
+	records int // appended since the last snapshot
+}
+
+// Recovery reports what Open found on disk.
+type Recovery struct {
+	Generation     uint64
+	Records        int   // records replayed, snapshot included
+	TruncatedBytes int64 // torn bytes cut off the end of the newest log
+}
+
+// Open opens the log in dir, creating it if needed, and calls replay with every record of
+// the latest snapshot and of the logs written since, in the order they were appended.
+func Open(dir string, replay func(record []byte) error) (*Log, Recovery, error) {
+	var rec Recovery
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, rec, err
+	}
+	snapshots, logs, err := generations(dir)
+	if err != nil {
+		return nil, rec, err
+	}
+	if len(snapshots) > 0 {
+		rec.Generation = snapshots[len(snapshots)-1]
+		n, _, err := readRecords(filepath.Join(dir, name(snapshotPrefix, rec.Generation)), replay)
+		if err == nil && n < 0 {
+			err = ErrCorrupt
+		}
+		if err != nil {
+			return nil, rec, fmt.Errorf("%w in %s", err, name(snapshotPrefix, rec.Generation))
+		}
+		rec.Records += n
+	}
+	l := &Log{dir: dir, gen: rec.Generation}
+	current := []uint64{}
+	for _, gen := range logs {
+		if gen >= rec.Generation {
+			current = append(current, gen)
+		}
+	}
+	for i, gen := range current {
+		path := filepath.Join(dir, name(logPrefix, gen))
+		n, valid, err := readRecords(path, replay)
+		if errors.Is(err, ErrCorrupt) {
+			return nil, rec, fmt.Errorf("%w in %s", err, name(logPrefix, gen))
+		} else if err != nil {
+			return nil, rec, err
+		}
+		if n < 0 {
+			if i != len(current)-1 {
+				return nil, rec, fmt.Errorf("%w in %s", ErrCorrupt, name(logPrefix, gen))
+			}
+			// Only the newest log can have been torn by a crash; cut the tail off.
+			info, err := os.Stat(path)
+			if err != nil {
+				return nil, rec, err
+			}
+			rec.TruncatedBytes = info.Size() - valid
+			if err := truncate(path, valid); err != nil {
+				return nil, rec, err
+			}
+			n, _, _ = readRecords(path, nil)
+		}
+		rec.Records += n
+		l.gen, l.records = gen, n
+	}
+	if err := l.openLog(); err != nil {
+		return nil, rec, err
+	}
+	rec.Generation = l.gen
+	l.removeBefore(l.gen)
+	return l, rec, nil
+}
+
+// Append writes the records to the log and syncs them to disk before returning. After a
+// failed write the log is closed, as what reached the disk is unknown, and later appends
+// return os.ErrClosed; reopen it to recover.
+func (l *Log) Append(records ...[]byte) error {
+	buf, err := frame(records)
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return os.ErrClosed
+	}
+	_, err = l.file.Write(buf)
+	if err == nil {
+		err = l.file.Sync()
+	}
+	if err != nil {
+		l.file.Close()
+		l.file = nil
+		return err
+	}
+	l.records += len(records)
+	return nil
+}
+
+// Records returns how many records were appended since the last snapshot.
+func (l *Log) Records() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.records
+}
+
+// Snapshot starts a new generation whose snapshot is the given records, which must describe
+// the whole state including everything appended so far; the caller must not append until
+// Snapshot returns. The previous generation is kept until the snapshot is durable.
+func (l *Log) Snapshot(records [][]byte) error {
+	buf, err := frame(records)
+	if err != nil {
+		return err
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return os.ErrClosed
+	}
+	next := l.gen + 1
+	path := filepath.Join(l.dir, name(snapshotPrefix, next))
+	if err := writeFile(path+tmpSuffix, buf); err != nil {
+		return err
+	}
+	if err := os.Rename(path+tmpSuffix, path); err != nil {
+		return err
+	}
+	if err := syncDir(l.dir); err != nil {
+		return err
+	}
+	old := l.file
+	l.gen, l.records = next, 0
+	err = l.openLog()
+	old.Close()
+	if err != nil {
+		// Records appended to the old log would be ignored after the new snapshot.
+		l.file = nil
+		return err
+	}
+	l.removeBefore(next)
+	return nil
+}
+
+// Writable reports whether records can be appended, which they can not once the log is
+// closed, such as after a failed write.
+func (l *Log) Writable() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file != nil
+}
+
+// Close closes the log file.
+func (l *Log) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// openLog opens the log of the current generation for appending.
+func (l *Log) openLog() error {
+	f, err := os.OpenFile(filepath.Join(l.dir, name(logPrefix, l.gen)), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	if err := syncDir(l.dir); err != nil {
+		f.Close()
+		return err
+	}
+	l.file = f
+	return nil
+}
+
+// removeBefore deletes the files of generations older than gen and unfinished snapshots.
+// Failures are ignored; leftovers are removed on the next open.
+func (l *Log) removeBefore(gen uint64) {
+	entries, _ := os.ReadDir(l.dir)
+	for _, e := range entries {
+		if _, g, ok := parseName(e.Name()); (ok && g < gen) || strings.HasSuffix(e.Name(), tmpSuffix) {
+			os.Remove(filepath.Join(l.dir, e.Name()))
+		}
+	}
+}
+
+func name(prefix string, gen uint64) string {
+	return fmt.Sprintf("%s%016x", prefix, gen)
+}
+
+// parseName splits a file name such as log-000000000000000a into its prefix and generation.
+func parseName(file string) (prefix string, gen uint64, ok bool) {
+	for _, prefix := range []string{snapshotPrefix, logPrefix} {
+		if hex, found := strings.CutPrefix(file, prefix); found && len(hex) == 16 {
+			gen, err := strconv.ParseUint(hex, 16, 64)
+			return prefix, gen, err == nil
+		}
+	}
+	return "", 0, false
+}
+
+// generations lists the snapshot and log generations in dir, oldest first.
+func generations(dir string) (snapshots, logs []uint64, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, e := range entries {
+		switch prefix, gen, ok := parseName(e.Name()); {
+		case ok && prefix == snapshotPrefix:
+			snapshots = append(snapshots, gen)
+		case ok && prefix == logPrefix:
+			logs = append(logs, gen)
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i] < snapshots[j] })
+	sort.Slice(logs, func(i, j int) bool { return logs[i] < logs[j] })
+	return snapshots, logs, nil
+}
+
+// frame encodes records for writing.
+func frame(records [][]byte) ([]byte, error) {
+	size := 0
+	for _, r := range records {
+		if len(r) == 0 {
+			return nil, ErrEmptyRecord
+		} else if len(r) > maxRecordSize {
+			return nil, fmt.Errorf("wal: record of %d bytes exceeds the %d byte limit", len(r), maxRecordSize)
+		}
+		size += headerSize + len(r)
+	}
+	buf := make([]byte, 0, size)
+	for _, r := range records {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(r)))
+		buf = binary.LittleEndian.AppendUint32(buf, crc32.Checksum(r, crcTable))
+		buf = append(buf, r...)
+	}
+	return buf, nil
+}
+
+// readRecords calls replay, when set, for each valid record in the file, and returns the
+// number of records and the length of the valid prefix. The count is -1 when the file ends
+// in a torn record or zero padding after that prefix. A bad frame followed by a valid record
+// ending the file is ErrCorrupt, as a crash only ever tears the end of the log.
+func readRecords(path string, replay func([]byte) error) (int, int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	n, offset := 0, int64(0)
+	for rest := data; len(rest) > 0; n++ {
+		record, ok := validFrame(rest)
+		if !ok {
+			if endsInRecord(rest[1:]) {
+				return 0, offset, fmt.Errorf("%w at offset %d", ErrCorrupt, offset)
+			}
+			return -1, offset, nil
+		}
+		if replay != nil {
+			if err := replay(record); err != nil {
+				return 0, 0, err
+			}
+		}
+		rest = rest[headerSize+len(record):]
+		offset += int64(headerSize + len(record))
+	}
+	return n, offset, nil
+}
+
+// validFrame returns the record framed at the start of data when its length fits and its
+// checksum matches.
+func validFrame(data []byte) ([]byte, bool) {
+	size, ok := frameSize(data)
+	if !ok {
+		return nil, false
+	}
+	record := data[headerSize : headerSize+size]
+	return record, crc32.Checksum(record, crcTable) == binary.LittleEndian.Uint32(data[4:])
+}
+
+// frameSize returns the record length in the frame header at the start of data when it is
+// one Append could have written and fits in data.
+func frameSize(data []byte) (int, bool) {
+	if len(data) < headerSize {
+		return 0, false
+	}
+	size := binary.LittleEndian.Uint32(data)
+	if size == 0 || size > maxRecordSize || int64(size) > int64(len(data)-headerSize) {
+		return 0, false
+	}
+	return int(size), true
+}
+
+// endsInRecord reports whether data, ignoring zero padding, ends in a valid record. Records
+// appended after a bad frame end the file this way, whereas a torn tail does not, as nothing
+// is appended after it. Only headers whose length reaches the end are checksummed, so the
+// scan is linear and garbage would need both a matching length and checksum to count.
+func endsInRecord(data []byte) bool {
+	end := len(data)
+	for end > 0 && data[end-1] == 0 {
+		end--
+	}
+	for i := 0; i < end; i++ {
+		size, ok := frameSize(data[i:])
+		if next := i + headerSize + size; ok && next >= end {
+			if _, ok := validFrame(data[i:]); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func truncate(path string, size int64) error {
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := f.Truncate(size); err != nil {
+		return err
+	}
+	return f.Sync()
+}
+
+func writeFile(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
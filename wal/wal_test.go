@@ -0,0 +1,258 @@
+package wal
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// open opens the log in dir and returns it with the records it replayed.
+func open(t *testing.T, dir string) (*Log, Recovery, []string) {
+	t.Helper()
+	records := []string{}
+	l, rec, err := Open(dir, func(r []byte) error {
+		records = append(records, string(r))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	return l, rec, records
+}
+
+func appendAll(t *testing.T, l *Log, records ...string) {
+	t.Helper()
+	for _, r := range records {
+		if err := l.Append([]byte(r)); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+}
+
+func TestLog(t *testing.T) {
+	t.Run("RoundTrip", testLogRoundTrip)
+	t.Run("TornTail", testLogTornTail)
+	t.Run("ZeroFilledTail", testLogZeroFilledTail)
+	t.Run("Corruption", testLogCorruption)
+	t.Run("Snapshot", testLogSnapshot)
+	t.Run("InterruptedSnapshot", testLogInterruptedSnapshot)
+	t.Run("Crash", testLogCrash)
+}
+func testLogRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	l, _, records := open(t, dir)
+	if len(records) != 0 {
+		t.Fatalf("Expected a new log to be empty, got %v", records)
+	}
+	appendAll(t, l, "one", "two")
+	if err := l.Append([]byte("three"), []byte("four")); err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if err := l.Append([]byte{}); !errors.Is(err, ErrEmptyRecord) {
+		t.Errorf("Expected an empty record to be rejected, got %v", err)
+	}
+	l.Close()
+	if err := l.Append([]byte("five")); !errors.Is(err, os.ErrClosed) || l.Writable() {
+		t.Errorf("Expected a closed log not to be writable, got %v", err)
+	}
+
+	l, rec, records := open(t, dir)
+	if got := strings.Join(records, " "); got != "one two three four" || rec.Records != 4 || l.Records() != 4 {
+		t.Errorf("Expected the 4 records back in order, got %q", got)
+	}
+}
+func testLogTornTail(t *testing.T) {
+	src := t.TempDir()
+	l, _, _ := open(t, src)
+	appendAll(t, l, "first record", "second record", "the record torn by the crash")
+	l.Close()
+	data, err := os.ReadFile(filepath.Join(src, name(logPrefix, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	intact := len(data) - headerSize - len("the record torn by the crash")
+
+	// Cut the last record at every possible byte, as a crash during its write would.
+	for cut := intact + 1; cut < len(data); cut++ {
+		dir := t.TempDir()
+		path := filepath.Join(dir, name(logPrefix, 0))
+		if err := os.WriteFile(path, data[:cut], 0o600); err != nil {
+			t.Fatal(err)
+		}
+		l, rec, records := open(t, dir)
+		if len(records) != 2 || rec.TruncatedBytes != int64(cut-intact) {
+			t.Fatalf("Cut at %d: expected 2 records and %d bytes truncated, got %v and %d", cut, cut-intact, records, rec.TruncatedBytes)
+		}
+		if info, _ := os.Stat(path); info.Size() != int64(intact) {
+			t.Fatalf("Cut at %d: expected the log truncated to %d bytes, got %d", cut, intact, info.Size())
+		}
+		appendAll(t, l, "after recovery")
+		l.Close()
+		if _, _, records := open(t, dir); strings.Join(records, "|") != "first record|second record|after recovery" {
+			t.Fatalf("Cut at %d: expected appends to follow the recovered records, got %v", cut, records)
+		}
+	}
+
+	// A flipped bit in the tail fails the checksum.
+	dir := t.TempDir()
+	corrupt := append([]byte{}, data...)
+	corrupt[len(corrupt)-1] ^= 1
+	os.WriteFile(filepath.Join(dir, name(logPrefix, 0)), corrupt, 0o600)
+	if _, _, records := open(t, dir); len(records) != 2 {
+		t.Errorf("Expected the corrupt record to be dropped, got %v", records)
+	}
+}
+func testLogZeroFilledTail(t *testing.T) {
+	dir := t.TempDir()
+	l, _, _ := open(t, dir)
+	appendAll(t, l, "kept")
+	l.Close()
+	f, _ := os.OpenFile(filepath.Join(dir, name(logPrefix, 0)), os.O_WRONLY|os.O_APPEND, 0)
+	f.Write(make([]byte, 4096))
+	f.Close()
+	if _, rec, records := open(t, dir); len(records) != 1 || rec.TruncatedBytes != 4096 {
+		t.Errorf("Expected zero-filled blocks after a crash to be truncated, got %v", records)
+	}
+}
+func testLogCorruption(t *testing.T) {
+	src := t.TempDir()
+	l, _, _ := open(t, src)
+	for i := 0; i < 100; i++ {
+		appendAll(t, l, fmt.Sprintf("record %d", i))
+	}
+	l.Close()
+	data, err := os.ReadFile(filepath.Join(src, name(logPrefix, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	middle := 10*(headerSize+len("record 0")) + 40*(headerSize+len("record 10")) // record 50
+
+	// A bad record, or a bad length, with valid records after it is not a torn tail.
+	for _, flip := range []int{headerSize, 0, middle + 1, middle + headerSize + 2} {
+		dir := t.TempDir()
+		path := filepath.Join(dir, name(logPrefix, 0))
+		corrupt := append([]byte{}, data...)
+		corrupt[flip] ^= 0x40
+		if flip == middle+1 {
+			// Zero padding from a later crash does not hide the valid records.
+			corrupt = append(corrupt, make([]byte, 4096)...)
+		}
+		os.WriteFile(path, corrupt, 0o600)
+		if _, _, err := Open(dir, func([]byte) error { return nil }); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("Flipped byte %d: expected ErrCorrupt, got %v", flip, err)
+		}
+		if info, _ := os.Stat(path); info.Size() != int64(len(corrupt)) {
+			t.Errorf("Flipped byte %d: expected the log to be left as it was, got %d bytes", flip, info.Size())
+		}
+	}
+}
+func testLogSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	l, _, _ := open(t, dir)
+	appendAll(t, l, "a=1", "b=1", "a=2")
+	if err := l.Snapshot([][]byte{[]byte("a=2"), []byte("b=1")}); err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+	if l.Records() != 0 {
+		t.Errorf("Expected the record count to restart after a snapshot, got %d", l.Records())
+	}
+	appendAll(t, l, "c=1")
+	l.Close()
+
+	l, rec, records := open(t, dir)
+	if got := strings.Join(records, " "); got != "a=2 b=1 c=1" || rec.Generation != 1 || l.Records() != 1 {
+		t.Errorf("Expected the snapshot followed by the newer records, got %q in generation %d", got, rec.Generation)
+	}
+	files, _ := os.ReadDir(dir)
+	if len(files) != 2 {
+		t.Errorf("Expected only the latest snapshot and log to be kept, got %d files", len(files))
+	}
+}
+func testLogInterruptedSnapshot(t *testing.T) {
+	dir := t.TempDir()
+	l, _, _ := open(t, dir)
+	appendAll(t, l, "a=1", "a=2")
+	l.Close()
+	// A crash while the next snapshot was written leaves it unnamed and incomplete.
+	os.WriteFile(filepath.Join(dir, name(snapshotPrefix, 1)+tmpSuffix), []byte("partial"), 0o600)
+
+	_, rec, records := open(t, dir)
+	if strings.Join(records, " ") != "a=1 a=2" || rec.Generation != 0 {
+		t.Errorf("Expected the unfinished snapshot to be ignored, got %v", records)
+	}
+	if _, err := os.Stat(filepath.Join(dir, name(snapshotPrefix, 1)+tmpSuffix)); !os.IsNotExist(err) {
+		t.Errorf("Expected the unfinished snapshot to be removed")
+	}
+}
+
+// crashDirEnv tells TestCrashWriter, run as a child process, where to write until it is killed.
+const crashDirEnv = "WAL_CRASH_DIR"
+
+// testLogCrash kills a process while it appends and checks that every record it saw
+// acknowledged is recovered, in order, with at most a torn tail dropped.
+func testLogCrash(t *testing.T) {
+	for round := 0; round < 3; round++ {
+		dir := t.TempDir()
+		cmd := exec.Command(os.Args[0], "-test.run=^TestCrashWriter$")
+		cmd.Env = append(os.Environ(), crashDirEnv+"="+dir)
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cmd.Start(); err != nil {
+			t.Fatal(err)
+		}
+		acked := -1
+		scanner := bufio.NewScanner(stdout)
+		for acked < 50+round*25 && scanner.Scan() {
+			acked, _ = strconv.Atoi(scanner.Text())
+		}
+		cmd.Process.Kill()
+		for scanner.Scan() {
+			acked, _ = strconv.Atoi(scanner.Text())
+		}
+		cmd.Wait()
+
+		_, rec, records := open(t, dir)
+		if len(records) < acked+1 {
+			t.Fatalf("Expected at least %d acknowledged records recovered, got %d", acked+1, len(records))
+		}
+		for i, r := range records {
+			if want := crashRecord(i); r != want {
+				t.Fatalf("Expected record %d to be intact and in order, got %d bytes", i, len(r))
+			}
+		}
+		t.Logf("round %d: %d acknowledged, %d recovered, %d torn bytes truncated", round, acked+1, len(records), rec.TruncatedBytes)
+	}
+}
+
+func crashRecord(i int) string {
+	return fmt.Sprintf("%06d:%s", i, strings.Repeat("x", 32<<10))
+}
+
+// TestCrashWriter appends records until killed when run by testLogCrash.
+func TestCrashWriter(t *testing.T) {
+	dir := os.Getenv(crashDirEnv)
+	if dir == "" {
+		t.Skip("only run as a child of TestLog/Crash")
+	}
+	l, _, err := Open(dir, func([]byte) error { return nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(time.Minute)
+	for i := 0; time.Now().Before(deadline); i++ {
+		if err := l.Append([]byte(crashRecord(i))); err != nil {
+			t.Fatal(err)
+		}
+		fmt.Println(i)
+	}
+}